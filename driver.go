@@ -141,6 +141,14 @@ func (b *balancerWithMeta) Close(ctx context.Context) error {
 	return b.close(ctx)
 }
 
+func (b *balancerWithMeta) NodeIDs() []uint32 {
+	if b.balancer == nil {
+		return nil
+	}
+
+	return b.balancer.NodeIDs()
+}
+
 // Close closes Driver and clear resources
 //
 //nolint:nonamedreturns
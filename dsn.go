@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
@@ -59,6 +60,36 @@ func queryModeFromString(s string) QueryMode {
 	return unknownQueryMode
 }
 
+var errUnknownQueryMode = errors.New("unknown query mode")
+
+func unknownQueryModeError(queryMode string) error {
+	supported := make([]string, 0, len(stringToType))
+	for s := range stringToType {
+		supported = append(supported, s)
+	}
+	sort.Strings(supported)
+
+	return fmt.Errorf("%w: %q (supported: %s)", errUnknownQueryMode, queryMode, strings.Join(supported, ", "))
+}
+
+// queryModeConnectorOptions selects query processor of database/sql driver from query mode.
+// Query mode from DSN overrides YDB_DATABASE_SQL_OVER_QUERY_SERVICE env but not options of ydb.Connector
+func queryModeConnectorOptions(queryMode string) ([]ConnectorOption, error) {
+	switch mode := queryModeFromString(queryMode); mode {
+	case QueryExecuteQueryMode:
+		return []ConnectorOption{
+			xsql.WithQueryService(true),
+		}, nil
+	case unknownQueryMode:
+		return nil, xerrors.WithStackTrace(unknownQueryModeError(queryMode))
+	default:
+		return []ConnectorOption{
+			xsql.WithQueryService(false),
+			xsql.WithDefaultQueryMode(modeToMode(mode)),
+		}, nil
+	}
+}
+
 //nolint:funlen
 func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 	info, err := dsn.Parse(dataSourceName)
@@ -74,30 +105,22 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 	} else if balancer := info.Params.Get("balancer"); balancer != "" {
 		opts = append(opts, WithBalancer(balancers.FromConfig(balancer)))
 	}
-	if queryMode := info.Params.Get("go_query_mode"); queryMode != "" {
-		switch mode := queryModeFromString(queryMode); mode {
-		case QueryExecuteQueryMode:
-			opts = append(opts, withConnectorOptions(xsql.WithQueryService(true)))
-		case unknownQueryMode:
-			return nil, xerrors.WithStackTrace(fmt.Errorf("unknown query mode: %s", queryMode))
-		default:
-			opts = append(opts, withConnectorOptions(xsql.WithDefaultQueryMode(modeToMode(mode))))
-		}
-	} else if queryMode := info.Params.Get("query_mode"); queryMode != "" {
-		switch mode := queryModeFromString(queryMode); mode {
-		case QueryExecuteQueryMode:
-			opts = append(opts, withConnectorOptions(xsql.WithQueryService(true)))
-		case unknownQueryMode:
-			return nil, xerrors.WithStackTrace(fmt.Errorf("unknown query mode: %s", queryMode))
-		default:
-			opts = append(opts, withConnectorOptions(xsql.WithDefaultQueryMode(modeToMode(mode))))
+	queryMode := info.Params.Get("go_query_mode")
+	if queryMode == "" {
+		queryMode = info.Params.Get("query_mode")
+	}
+	if queryMode != "" {
+		connectorOpts, err := queryModeConnectorOptions(queryMode)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
 		}
+		opts = append(opts, withConnectorOptions(connectorOpts...))
 	}
 	if fakeTx := info.Params.Get("go_fake_tx"); fakeTx != "" {
 		for _, queryMode := range strings.Split(fakeTx, ",") {
 			switch mode := queryModeFromString(queryMode); mode {
 			case unknownQueryMode:
-				return nil, xerrors.WithStackTrace(fmt.Errorf("unknown query mode: %s", queryMode))
+				return nil, xerrors.WithStackTrace(unknownQueryModeError(queryMode))
 			default:
 				opts = append(opts, withConnectorOptions(WithFakeTx(mode)))
 			}
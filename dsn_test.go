@@ -71,6 +71,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 			},
 			err: nil,
@@ -83,6 +84,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 				xsql.WithQueryBind(bind.TablePathPrefix("path/to/tables")),
 			},
@@ -96,6 +98,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 				xsql.WithQueryBind(bind.TablePathPrefix("path/to/tables")),
 				xsql.WithQueryBind(bind.NumericArgs{}),
@@ -110,6 +113,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 				xsql.WithQueryBind(bind.TablePathPrefix("path/to/tables")),
 				xsql.WithQueryBind(bind.PositionalArgs{}),
@@ -124,6 +128,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 				xsql.WithQueryBind(bind.TablePathPrefix("path/to/tables")),
 				xsql.WithQueryBind(bind.AutoDeclare{}),
@@ -138,6 +143,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 				xsql.WithQueryBind(bind.TablePathPrefix("path/to/tables")),
 			},
@@ -151,6 +157,7 @@ func TestParse(t *testing.T) {
 				config.WithDatabase("/local"),
 			},
 			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
 				xsql.WithDefaultQueryMode(legacy.ScriptingQueryMode),
 				xsql.WithQueryBind(bind.TablePathPrefix("path/to/tables")),
 				xsql.WithQueryBind(bind.PositionalArgs{}),
@@ -171,6 +178,77 @@ func TestParse(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_mode=query",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(true),
+			},
+			err: nil,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_mode=data",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
+				xsql.WithDefaultQueryMode(legacy.DataQueryMode),
+			},
+			err: nil,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_mode=scan",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
+				xsql.WithDefaultQueryMode(legacy.ScanQueryMode),
+			},
+			err: nil,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_mode=scheme",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(false),
+				xsql.WithDefaultQueryMode(legacy.SchemeQueryMode),
+			},
+			err: nil,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_mode=query&query_mode=scripting",
+			opts: []config.Option{
+				config.WithSecure(false),
+				config.WithEndpoint("localhost:2135"),
+				config.WithDatabase("/local"),
+			},
+			connectorOpts: []xsql.Option{
+				xsql.WithQueryService(true),
+			},
+			err: nil,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_query_mode=unknown",
+			err: errUnknownQueryMode,
+		},
+		{
+			dsn: "grpc://localhost:2135/local?go_fake_tx=unknown",
+			err: errUnknownQueryMode,
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			opts, err := parseConnectionString(tt.dsn)
@@ -237,3 +315,60 @@ func TestExtractTablePathPrefixFromBinderName(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryModePrecedence(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		env    string
+		dsn    string
+		opts   []ConnectorOption
+		engine xsql.Engine
+	}{
+		{
+			name:   "Default",
+			dsn:    "grpc://localhost:2135/local",
+			engine: xsql.LEGACY,
+		},
+		{
+			name:   "Env",
+			env:    "true",
+			dsn:    "grpc://localhost:2135/local",
+			engine: xsql.QUERY_SERVICE,
+		},
+		{
+			name:   "DSNOverridesEnv",
+			env:    "true",
+			dsn:    "grpc://localhost:2135/local?go_query_mode=data",
+			engine: xsql.LEGACY,
+		},
+		{
+			name:   "DSNOverridesDefault",
+			dsn:    "grpc://localhost:2135/local?go_query_mode=query",
+			engine: xsql.QUERY_SERVICE,
+		},
+		{
+			name:   "OptionOverridesDSN",
+			dsn:    "grpc://localhost:2135/local?go_query_mode=query",
+			opts:   []ConnectorOption{WithQueryService(false)},
+			engine: xsql.LEGACY,
+		},
+		{
+			name:   "OptionOverridesEnvAndDSN",
+			env:    "false",
+			dsn:    "grpc://localhost:2135/local?go_query_mode=scan",
+			opts:   []ConnectorOption{WithQueryService(true)},
+			engine: xsql.QUERY_SERVICE,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("YDB_DATABASE_SQL_OVER_QUERY_SERVICE", tt.env)
+			opts, err := parseConnectionString(tt.dsn)
+			require.NoError(t, err)
+			d, err := driverFromOptions(context.Background(), opts...)
+			require.NoError(t, err)
+			c, err := xsql.Open(d, nil, append(d.databaseSQLOptions, tt.opts...)...)
+			require.NoError(t, err)
+			require.Equal(t, tt.engine, c.Engine())
+		})
+	}
+}
@@ -40,6 +40,16 @@ func TransportError(err error) Error {
 	return xerrors.TransportError(err)
 }
 
+// StatusError is an error of failed call which exposes status of call with details.
+// Status of operation error contains issues of operation (Ydb.Issue.IssueMessage) as details.
+// StatusError is reachable with errors.As from errors of native clients and database/sql driver:
+//
+//	var statusErr ydb.StatusError
+//	if errors.As(err, &statusErr) {
+//		fmt.Println(statusErr.Status().Code(), statusErr.Status().Details())
+//	}
+type StatusError = xerrors.StatusError
+
 // IsYdbError reports when given error is and ydb error (transport, operation or internal driver error)
 func IsYdbError(err error) bool {
 	return xerrors.IsYdb(err)
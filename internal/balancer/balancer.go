@@ -344,6 +344,21 @@ func (b *Balancer) connections() *connectionsState {
 	return b.connectionsState.Load()
 }
 
+// NodeIDs returns node IDs of endpoints which balancer chooses from
+func (b *Balancer) NodeIDs() []uint32 {
+	state := b.connections()
+	if state == nil {
+		return nil
+	}
+
+	nodeIDs := make([]uint32, 0, len(state.all))
+	for _, cc := range state.all {
+		nodeIDs = append(nodeIDs, cc.Endpoint().NodeID())
+	}
+
+	return nodeIDs
+}
+
 func (b *Balancer) getConn(ctx context.Context) (c conn.Conn, err error) {
 	onDone := trace.DriverOnBalancerChooseEndpoint(
 		b.driverConfig.Trace(), &ctx,
@@ -14,6 +14,7 @@ const (
 	blockPragma = blockID(iota)
 	blockDeclare
 	blockYQL
+	blockArgs
 )
 
 type Bind interface {
@@ -57,6 +58,32 @@ func (bindings Bindings) ToYdb(sql string, args ...interface{}) (
 	return sql, params, nil
 }
 
+// WithTablePathPrefix returns copy of bindings with table path prefix instead of existing one
+func (bindings Bindings) WithTablePathPrefix(tablePathPrefix TablePathPrefix) Bindings {
+	// NOTE: table path prefix has the lowest block id, so it is the first of sorted bindings
+	overridden := append(make(Bindings, 0, len(bindings)+1), tablePathPrefix)
+	for _, b := range bindings {
+		if _, has := b.(TablePathPrefix); !has {
+			overridden = append(overridden, b)
+		}
+	}
+
+	return overridden
+}
+
+// WithTimeArgs returns copy of bindings with time args binding instead of existing one
+func (bindings Bindings) WithTimeArgs(timeArgs TimeArgs) Bindings {
+	// NOTE: time args binding has the highest block id, so it is the last of sorted bindings
+	overridden := make(Bindings, 0, len(bindings)+1)
+	for _, b := range bindings {
+		if _, has := b.(TimeArgs); !has {
+			overridden = append(overridden, b)
+		}
+	}
+
+	return append(overridden, timeArgs)
+}
+
 func Sort(bindings []Bind) []Bind {
 	sort.Slice(bindings, func(i, j int) bool {
 		return bindings[i].blockID() < bindings[j].blockID()
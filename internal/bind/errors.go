@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrInconsistentArgs         = errors.New("inconsistent args")
 	ErrUnexpectedNumericArgZero = errors.New("unexpected numeric arg $0. Allowed only $1 and greater")
+	ErrWrongTablePathPrefix     = errors.New("wrong table path prefix")
+	ErrMixedPlaceholders        = errors.New("mixed placeholder styles")
 )
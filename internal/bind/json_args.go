@@ -0,0 +1,86 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// JSONDocumentArgs binds json.RawMessage and json.Marshaler query args as YDB JsonDocument values.
+// Without JSONDocumentArgs such args are bound as YDB Json values
+type JSONDocumentArgs struct{}
+
+func (m JSONDocumentArgs) blockID() blockID {
+	return blockArgs
+}
+
+func (m JSONDocumentArgs) ToYdb(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	newArgs = make([]interface{}, len(args))
+	for i, arg := range args {
+		newArgs[i], err = m.convertArg(arg)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return sql, newArgs, nil
+}
+
+func (m JSONDocumentArgs) convertArg(arg interface{}) (_ interface{}, err error) {
+	switch x := arg.(type) {
+	case driver.NamedValue:
+		x.Value, err = m.convert(x.Value)
+
+		return x, err
+	case sql.NamedArg:
+		x.Value, err = m.convert(x.Value)
+
+		return x, err
+	default:
+		return m.convert(arg)
+	}
+}
+
+func (m JSONDocumentArgs) convert(v interface{}) (interface{}, error) {
+	switch v.(type) {
+	case value.Value:
+		return v, nil
+	case json.RawMessage, *json.RawMessage, json.Marshaler:
+	default:
+		return v, nil
+	}
+
+	vv, err := toValue(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	switch t := vv.Type(); {
+	case types.Equal(t, types.JSON):
+		var s string
+		if err := value.CastTo(vv, &s); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return value.JSONDocumentValue(s), nil
+	case types.Equal(t, types.NewOptional(types.JSON)):
+		var s *string
+		if err := value.CastTo(vv, &s); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if s == nil {
+			return value.NullValue(types.JSONDocument), nil
+		}
+
+		return value.OptionalValue(value.JSONDocumentValue(*s)), nil
+	default:
+		// json.Marshaler which is bound as another type (such as time.Time)
+		return vv, nil
+	}
+}
@@ -0,0 +1,58 @@
+package bind
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestJSONDocumentArgs(t *testing.T) {
+	doc := json.RawMessage(`{"a":1}`)
+
+	t.Run("Positional", func(t *testing.T) {
+		yql, params, err := Bindings{AutoDeclare{}, PositionalArgs{}, JSONDocumentArgs{}}.ToYdb(
+			"SELECT ?, ?, ?, ?, ?", doc, &doc, (*json.RawMessage)(nil), jsonMarshaler{A: 1}, time.Unix(0, 0),
+		)
+		require.NoError(t, err)
+		require.Contains(t, yql, "DECLARE $p0 AS JsonDocument;")
+		require.Contains(t, yql, "DECLARE $p1 AS Optional<JsonDocument>;")
+		require.Contains(t, yql, "DECLARE $p2 AS Optional<JsonDocument>;")
+		require.Contains(t, yql, "DECLARE $p3 AS JsonDocument;")
+		require.Contains(t, yql, "DECLARE $p4 AS Timestamp;")
+		require.Len(t, params, 5)
+		require.Equal(t, value.JSONDocumentValue(`{"a":1}`), params[0].Value())
+		require.Equal(t, value.OptionalValue(value.JSONDocumentValue(`{"a":1}`)), params[1].Value())
+		require.Equal(t, value.NullValue(types.JSONDocument), params[2].Value())
+		require.Equal(t, params[0].Value(), params[3].Value())
+	})
+	t.Run("Named", func(t *testing.T) {
+		yql, params, err := Bindings{AutoDeclare{}, JSONDocumentArgs{}}.ToYdb("SELECT $doc", sql.Named("doc", doc))
+		require.NoError(t, err)
+		require.Contains(t, yql, "DECLARE $doc AS JsonDocument;")
+		require.Equal(t, value.JSONDocumentValue(`{"a":1}`), params[0].Value())
+	})
+	t.Run("WithoutJSONDocumentArgs", func(t *testing.T) {
+		yql, params, err := Bindings{AutoDeclare{}}.ToYdb("SELECT $doc", sql.Named("doc", doc))
+		require.NoError(t, err)
+		require.Contains(t, yql, "DECLARE $doc AS Json;")
+		require.Equal(t, value.JSONValue(`{"a":1}`), params[0].Value())
+	})
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, bindings := range []Bindings{{}, {JSONDocumentArgs{}}} {
+		_, params, err := bindings.ToYdb("", sql.Named("doc", json.RawMessage(`{"a":[1,2]}`)))
+		require.NoError(t, err)
+		t.Run(params[0].Value().Type().Yql(), func(t *testing.T) {
+			var dst json.RawMessage
+			require.NoError(t, value.CastTo(params[0].Value(), &dst))
+			require.JSONEq(t, `{"a":[1,2]}`, string(dst))
+		})
+	}
+}
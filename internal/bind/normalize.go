@@ -0,0 +1,151 @@
+package bind
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// NormalizeQuery is a binding which replaces query text with normalized query text (see Normalize)
+type NormalizeQuery struct{}
+
+func (NormalizeQuery) blockID() blockID {
+	return blockYQL
+}
+
+func (NormalizeQuery) ToYdb(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	return Normalize(sql), args, nil
+}
+
+// Normalize makes query text independent of formatting: comments are removed and sequences of
+// whitespaces are replaced with single space. String literals, quoted identifiers and YQL hints
+// (one line comments with '--!' prefix and multiline comments with '/*+' prefix) are kept as is.
+// Queries which differ only in whitespaces and comments have the same normalized text.
+func Normalize(sql string) string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	space := false
+	writeSpace := func() {
+		// no space at start of query and at start of line after hint
+		if space && buffer.Len() > 0 && buffer.Bytes()[buffer.Len()-1] != '\n' {
+			buffer.WriteByte(' ')
+		}
+		space = false
+	}
+
+	for i := 0; i < len(sql); {
+		switch ch := sql[i]; {
+		case isSpace(ch):
+			space = true
+			i++
+		case strings.HasPrefix(sql[i:], "--!"):
+			writeSpace()
+			end := lineEnd(sql, i)
+			buffer.WriteString(strings.TrimRight(sql[i:end], " \t\f\v"))
+			// hint must be followed by line break, so it does not comment out the rest of query
+			buffer.WriteByte('\n')
+			i = end
+		case strings.HasPrefix(sql[i:], "--"):
+			space = true
+			i = lineEnd(sql, i)
+		case strings.HasPrefix(sql[i:], "/*+"):
+			writeSpace()
+			end := multilineCommentEnd(sql, i)
+			buffer.WriteString(sql[i:end])
+			i = end
+		case strings.HasPrefix(sql[i:], "/*"):
+			space = true
+			i = multilineCommentEnd(sql, i)
+		case strings.HasPrefix(sql[i:], "@@"):
+			writeSpace()
+			end := multilineStringEnd(sql, i)
+			buffer.WriteString(sql[i:end])
+			i = end
+		case ch == '\'' || ch == '"' || ch == '`':
+			writeSpace()
+			end := quotedEnd(sql, i)
+			buffer.WriteString(sql[i:end])
+			i = end
+		default:
+			writeSpace()
+			buffer.WriteByte(ch)
+			i++
+		}
+	}
+
+	return buffer.String()
+}
+
+func isSpace(ch byte) bool {
+	switch ch {
+	case ' ', '\t', '\n', '\r', '\f', '\v':
+		return true
+	default:
+		return false
+	}
+}
+
+// lineEnd returns position of line break after one line comment started at i
+func lineEnd(sql string, i int) int {
+	if end := strings.IndexAny(sql[i:], "\r\n"); end >= 0 {
+		return i + end
+	}
+
+	return len(sql)
+}
+
+// multilineCommentEnd returns position after (possibly nested) multiline comment started at i
+func multilineCommentEnd(sql string, i int) int {
+	nested := 0
+	for i += 2; i < len(sql); i++ {
+		switch {
+		case strings.HasPrefix(sql[i:], "/*"):
+			nested++
+			i++
+		case strings.HasPrefix(sql[i:], "*/"):
+			if nested == 0 {
+				return i + 2
+			}
+			nested--
+			i++
+		}
+	}
+
+	return len(sql)
+}
+
+// multilineStringEnd returns position after YQL multiline string literal (@@...@@) started at i.
+// Repeated @@@@ inside literal is an escaped @@
+func multilineStringEnd(sql string, i int) int {
+	for i += 2; i < len(sql); {
+		end := strings.Index(sql[i:], "@@")
+		if end < 0 {
+			break
+		}
+		i += end + 2
+		if !strings.HasPrefix(sql[i:], "@@") {
+			return i
+		}
+		i += 2
+	}
+
+	return len(sql)
+}
+
+// quotedEnd returns position after string literal or quoted identifier started at i
+func quotedEnd(sql string, i int) int {
+	quote := sql[i]
+	for i++; i < len(sql); i++ {
+		switch sql[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		}
+	}
+
+	return len(sql)
+}
@@ -0,0 +1,98 @@
+package bind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		sql        []string
+		normalized string
+	}{
+		{
+			name: "Whitespaces",
+			sql: []string{
+				"SELECT id, title FROM series WHERE id = $id",
+				"  SELECT id,  title\n\tFROM series\r\nWHERE id = $id  \n",
+				"\nSELECT\n\tid,\n\ttitle\nFROM\n\tseries\nWHERE\n\tid = $id\n",
+			},
+			normalized: "SELECT id, title FROM series WHERE id = $id",
+		},
+		{
+			name: "Comments",
+			sql: []string{
+				"SELECT 1 AS a",
+				"-- comment\nSELECT 1 AS a -- trailing comment",
+				"SELECT /* comment */ 1 AS a",
+				"SELECT/* comment */1 AS a",
+				"SELECT /* outer /* nested */ comment */ 1\n-- comment\r\nAS a",
+			},
+			normalized: "SELECT 1 AS a",
+		},
+		{
+			name: "StringLiterals",
+			sql: []string{
+				"SELECT 'a  b -- c /* d */', \"e\n\tf\"",
+				"SELECT\n  'a  b -- c /* d */',\n  \"e\n\tf\" -- comment",
+			},
+			normalized: "SELECT 'a  b -- c /* d */', \"e\n\tf\"",
+		},
+		{
+			name: "EscapedQuotes",
+			sql: []string{
+				`SELECT 'it\'s  ok',  "a\"  b"`,
+			},
+			normalized: `SELECT 'it\'s  ok', "a\"  b"`,
+		},
+		{
+			name: "QuotedIdentifiers",
+			sql: []string{
+				"SELECT * FROM `my  table` -- comment",
+			},
+			normalized: "SELECT * FROM `my  table`",
+		},
+		{
+			name: "MultilineStringLiterals",
+			sql: []string{
+				"SELECT  @@a\n  -- b @@@@ c@@",
+			},
+			normalized: "SELECT @@a\n  -- b @@@@ c@@",
+		},
+		{
+			name: "Hints",
+			sql: []string{
+				"--!syntax_v1\nSELECT /*+ hint() */ 1",
+				"  --!syntax_v1  \n\n  SELECT  /*+ hint() */  1  -- comment",
+			},
+			normalized: "--!syntax_v1\nSELECT /*+ hint() */ 1",
+		},
+		{
+			name: "Unterminated",
+			sql: []string{
+				"SELECT  'a  b",
+			},
+			normalized: "SELECT 'a  b",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, sql := range tt.sql {
+				require.Equal(t, tt.normalized, Normalize(sql), sql)
+			}
+		})
+	}
+	t.Run("DifferentLiterals", func(t *testing.T) {
+		require.NotEqual(t, Normalize("SELECT 'a  b'"), Normalize("SELECT 'a b'"))
+	})
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	yql, params, err := Bindings{NormalizeQuery{}, PositionalArgs{}}.ToYdb(
+		"SELECT\n\t'a  b' -- comment\nWHERE id = ?", 1,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT 'a  b' WHERE id = $p0", yql)
+	require.Len(t, params, 1)
+}
@@ -1,10 +1,15 @@
 package bind
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"math"
 	"net/url"
 	"reflect"
 	"sort"
@@ -12,6 +17,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
@@ -47,6 +53,8 @@ func asUUID(v interface{}) (value.Value, bool) {
 
 func toType(v interface{}) (_ types.Type, err error) { //nolint:funlen
 	switch x := v.(type) {
+	case nil:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("nil interface: %w", errUnsupportedType))
 	case bool:
 		return types.Bool, nil
 	case int:
@@ -83,6 +91,11 @@ func toType(v interface{}) (_ types.Type, err error) { //nolint:funlen
 		return types.Timestamp, nil
 	case time.Duration:
 		return types.Interval, nil
+	case decimal.Decimal:
+		// precision and scale of nil decimal are unknown, so default YDB decimal type is used
+		return types.NewDecimal(22, 9), nil
+	case json.RawMessage, json.Marshaler:
+		return types.JSON, nil
 	default:
 		kind := reflect.TypeOf(x).Kind()
 		switch kind {
@@ -101,16 +114,16 @@ func toType(v interface{}) (_ types.Type, err error) { //nolint:funlen
 		case reflect.Map:
 			v := reflect.ValueOf(x)
 
-			keyType, err := toType(reflect.New(v.Type().Key()).Interface())
+			keyType, err := toType(reflect.New(v.Type().Key()).Elem().Interface())
 			if err != nil {
-				return nil, fmt.Errorf("cannot parse %T map key: %w",
-					reflect.New(v.Type().Key()).Interface(), err,
+				return nil, fmt.Errorf("cannot parse %v map key: %w",
+					v.Type().Key(), err,
 				)
 			}
-			valueType, err := toType(reflect.New(v.Type().Elem()).Interface())
+			valueType, err := toType(reflect.New(v.Type().Elem()).Elem().Interface())
 			if err != nil {
-				return nil, fmt.Errorf("cannot parse %T map value: %w",
-					v.MapKeys()[0].Interface(), err,
+				return nil, fmt.Errorf("cannot parse %v map value: %w",
+					v.Type().Elem(), err,
 				)
 			}
 
@@ -155,6 +168,55 @@ func toType(v interface{}) (_ types.Type, err error) { //nolint:funlen
 	}
 }
 
+// marshalJSON binds value which marshals itself to JSON (such as struct with MarshalJSON method) as Json value
+func marshalJSON(m json.Marshaler) (value.Value, error) {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("cannot marshal %T to json: %w", m, err))
+	}
+
+	return value.JSONValue(string(b)), nil
+}
+
+// readerValue reads r to the end into Bytes value. YDB requires whole value of parameter in request,
+// so content of reader is copied into buffer before execution of query. Buffer is preallocated by size
+// of r if size is known (Len method as in bytes.Reader and strings.Reader or Stat method as in os.File),
+// so content is copied once without grow of buffer. Reader is consumed by bind, so retries of query
+// require new reader
+func readerValue(r io.Reader) (value.Value, error) {
+	var buf bytes.Buffer
+	if size := readerSize(r); size > 0 {
+		// bytes.Buffer.ReadFrom requires free space of bytes.MinRead for detect EOF without grow
+		buf.Grow(size + bytes.MinRead)
+	}
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("cannot read %T: %w", r, err))
+	}
+
+	return value.BytesValue(buf.Bytes()), nil
+}
+
+// readerSize returns known size of r for preallocation of buffer. Zero means unknown size,
+// including sizes which don't fit into buffer of readerValue
+func readerSize(r io.Reader) int {
+	var size int64
+	switch x := r.(type) {
+	case interface{ Len() int }:
+		size = int64(x.Len())
+	case interface{ Stat() (fs.FileInfo, error) }:
+		if info, err := x.Stat(); err == nil && info.Mode().IsRegular() {
+			size = info.Size()
+		}
+	}
+
+	if size <= 0 || uint64(size) > math.MaxInt-bytes.MinRead {
+		return 0
+	}
+
+	return int(size)
+}
+
 //nolint:gocyclo,funlen
 func toValue(v interface{}) (_ value.Value, err error) {
 	if x, ok := asUUID(v); ok {
@@ -177,6 +239,8 @@ func toValue(v interface{}) (_ value.Value, err error) {
 		return value.VoidValue(), nil
 	case value.Value:
 		return x, nil
+	case io.Reader:
+		return readerValue(x)
 	}
 
 	if vv := reflect.ValueOf(v); vv.Kind() == reflect.Pointer {
@@ -255,6 +319,12 @@ func toValue(v interface{}) (_ value.Value, err error) {
 		return value.TimestampValueFromTime(x), nil
 	case time.Duration:
 		return value.IntervalValueFromDuration(x), nil
+	case decimal.Decimal:
+		return value.DecimalValue(x.Bytes, x.Precision, x.Scale), nil
+	case json.RawMessage:
+		return value.JSONValue(string(x)), nil
+	case json.Marshaler:
+		return marshalJSON(x)
 	default:
 		kind := reflect.TypeOf(x).Kind()
 		switch kind {
@@ -273,6 +343,13 @@ func toValue(v interface{}) (_ value.Value, err error) {
 				}
 			}
 
+			if len(list) == 0 {
+				// type of empty list is defined by type of slice items if it is known
+				if t, err := toType(x); err == nil {
+					return value.ZeroValue(t), nil
+				}
+			}
+
 			return value.ListValue(list...), nil
 		case reflect.Map:
 			v := reflect.ValueOf(x)
@@ -297,6 +374,13 @@ func toValue(v interface{}) (_ value.Value, err error) {
 				})
 			}
 
+			if len(fields) == 0 {
+				// type of empty dict is defined by types of map keys and values if it is known
+				if t, err := toType(x); err == nil {
+					return value.ZeroValue(t), nil
+				}
+			}
+
 			return value.DictValue(fields...), nil
 		case reflect.Struct:
 			v := reflect.ValueOf(x)
@@ -315,8 +399,8 @@ func toValue(v interface{}) (_ value.Value, err error) {
 				vv, err := toValue(v.Field(i).Interface())
 				if err != nil {
 					return nil, xerrors.WithStackTrace(
-						fmt.Errorf("cannot parse %v as values of dict: %w",
-							v.Index(i).Interface(), err,
+						fmt.Errorf("cannot parse %q field of struct: %w",
+							v.Type().Field(i).Name, err,
 						),
 					)
 				}
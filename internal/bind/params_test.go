@@ -1,8 +1,17 @@
 package bind
 
 import (
+	"bytes"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -10,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
@@ -17,6 +27,15 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 )
 
+// jsonMarshaler is a struct which marshals itself to JSON
+type jsonMarshaler struct {
+	A int `sql:"a"`
+}
+
+func (m jsonMarshaler) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]int{"a": m.A})
+}
+
 type testValuer struct {
 	value driver.Value
 }
@@ -394,6 +413,64 @@ func TestToValue(t *testing.T) {
 			dst:  value.NullValue(types.Interval),
 			err:  nil,
 		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: decimal.Decimal{
+				Bytes:     [16]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x30, 0x39},
+				Precision: 35,
+				Scale:     10,
+			},
+			dst: value.DecimalValue([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x30, 0x39}, 35, 10),
+			err: nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src: &decimal.Decimal{
+				Bytes:     [16]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x30, 0x39},
+				Precision: 35,
+				Scale:     10,
+			},
+			dst: value.OptionalValue(
+				value.DecimalValue([16]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x30, 0x39}, 35, 10),
+			),
+			err: nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  func() *decimal.Decimal { return nil }(),
+			dst:  value.NullValue(types.NewDecimal(22, 9)),
+			err:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  json.RawMessage(`{"a":1}`),
+			dst:  value.JSONValue(`{"a":1}`),
+			err:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  func(v json.RawMessage) *json.RawMessage { return &v }(json.RawMessage(`{"a":1}`)),
+			dst:  value.OptionalValue(value.JSONValue(`{"a":1}`)),
+			err:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  func() *json.RawMessage { return nil }(),
+			dst:  value.NullValue(types.JSON),
+			err:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  jsonMarshaler{A: 1},
+			dst:  value.JSONValue(`{"a":1}`),
+			err:  nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			src:  func() *jsonMarshaler { return nil }(),
+			dst:  value.NullValue(types.JSON),
+			err:  nil,
+		},
 		{
 			name: xtest.CurrentFileLine(),
 			src: &struct {
@@ -988,3 +1065,137 @@ func BenchmarkAsUUIDUsingReflect(b *testing.B) {
 		require.Equal(b, expUUIDValue, v)
 	}
 }
+
+func TestDecimalRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"0.0000000001",
+		"-1234567890123456789012345.6789012345",
+		"9999999999999999999999999.9999999999",
+	} {
+		t.Run(s, func(t *testing.T) {
+			x, err := decimal.Parse(s, 35, 10)
+			require.NoError(t, err)
+			src := decimal.Decimal{
+				Bytes:     decimal.BigIntToByte(x, 35, 10),
+				Precision: 35,
+				Scale:     10,
+			}
+			v, err := toValue(src)
+			require.NoError(t, err)
+			require.Equal(t, "Decimal(35,10)", v.Type().Yql())
+			var dst decimal.Decimal
+			require.NoError(t, dst.Scan(v))
+			require.Equal(t, src, dst)
+			require.Equal(t, s, dst.String())
+		})
+	}
+}
+
+// onlyReader hides size of underlying reader
+type onlyReader struct {
+	io.Reader
+}
+
+// statReader reports size of file which differs from size of underlying reader
+type statReader struct {
+	io.Reader
+
+	size int64
+}
+
+func (r statReader) Stat() (fs.FileInfo, error) {
+	return fileInfo{size: r.size}, nil
+}
+
+type fileInfo struct {
+	fs.FileInfo
+
+	size int64
+}
+
+func (info fileInfo) Size() int64 {
+	return info.size
+}
+
+func (info fileInfo) Mode() fs.FileMode {
+	return 0
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read error")
+}
+
+func TestReaderValue(t *testing.T) {
+	blob := make([]byte, 8<<20)
+	_, _ = rand.New(rand.NewSource(0)).Read(blob) //nolint:gosec
+
+	file, err := os.Create(filepath.Join(t.TempDir(), "blob"))
+	require.NoError(t, err)
+	defer file.Close()
+	_, err = file.Write(blob)
+	require.NoError(t, err)
+	_, err = file.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+	}{
+		{
+			name: "bytes.Reader",
+			src:  bytes.NewReader(blob),
+		},
+		{
+			name: "os.File",
+			src:  file,
+		},
+		{
+			name: "UnknownSize",
+			src:  onlyReader{bytes.NewReader(blob)},
+		},
+		{
+			name: "OverflowSize",
+			src:  statReader{Reader: bytes.NewReader(blob), size: math.MaxInt64},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := toValue(tt.src)
+			require.NoError(t, err)
+
+			var dst []byte
+			require.NoError(t, value.CastTo(v, &dst))
+			require.Equal(t, blob, dst)
+
+			p, err := Params(sql.Named("blob", tt.src))
+			require.NoError(t, err)
+			require.Len(t, p, 1)
+			require.Equal(t, types.Bytes, p[0].Value().Type())
+		})
+	}
+	t.Run("ReadError", func(t *testing.T) {
+		_, err := toValue(errReader{})
+		require.ErrorContains(t, err, "read error")
+	})
+}
+
+func BenchmarkReaderValue(b *testing.B) {
+	blob := make([]byte, 8<<20)
+	b.Run("ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data, err := io.ReadAll(onlyReader{bytes.NewReader(blob)})
+			require.NoError(b, err)
+			_, err = toValue(data)
+			require.NoError(b, err)
+		}
+	})
+	b.Run("Reader", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := toValue(bytes.NewReader(blob))
+			require.NoError(b, err)
+		}
+	})
+}
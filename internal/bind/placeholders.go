@@ -0,0 +1,290 @@
+package bind
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// PlaceholderStyle defines syntax of query args placeholders
+type PlaceholderStyle int
+
+const (
+	// PlaceholderDollar is a style of numeric placeholders $1, $2, ... (as in lib/pq and pgx)
+	PlaceholderDollar = PlaceholderStyle(iota + 1)
+	// PlaceholderQuestion is a style of positional placeholders ?
+	PlaceholderQuestion
+	// PlaceholderNamed is a style of named placeholders @name (sql.Named args) and @p1, @p2, ...
+	// (positional args)
+	PlaceholderNamed
+)
+
+func (style PlaceholderStyle) String() string {
+	switch style {
+	case PlaceholderDollar:
+		return "dollar"
+	case PlaceholderQuestion:
+		return "question"
+	case PlaceholderNamed:
+		return "named"
+	default:
+		return "unknown"
+	}
+}
+
+type (
+	// Placeholders is a binding which rewrites placeholders of style into YDB parameters.
+	// Placeholders of other styles in the same query produce ErrMixedPlaceholders
+	Placeholders struct {
+		Style PlaceholderStyle
+	}
+	namedArg string
+)
+
+func (m Placeholders) blockID() blockID {
+	return blockYQL
+}
+
+//nolint:funlen
+func (m Placeholders) ToYdb(sql string, args ...interface{}) (yql string, newArgs []interface{}, err error) {
+	l := &sqlLexer{
+		src:        sql,
+		stateFn:    placeholdersStateFn,
+		rawStateFn: placeholdersStateFn,
+	}
+
+	for l.stateFn != nil {
+		l.stateFn = l.stateFn(l)
+	}
+
+	var (
+		parameters = make([]*params.Parameter, len(args))
+		named      = make(map[string]*params.Parameter)
+	)
+	for i, arg := range args {
+		parameters[i], err = toYdbParam("$p"+strconv.Itoa(i), arg)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+		if name := parameters[i].Name(); name != "$p"+strconv.Itoa(i) {
+			named[name] = parameters[i]
+		}
+	}
+
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+
+	var (
+		position = 0
+		replaced = false
+	)
+	for _, p := range l.parts {
+		if s, ok := p.(string); ok {
+			buffer.WriteString(s)
+
+			continue
+		}
+
+		if style := placeholderStyle(p); style != m.Style {
+			return "", nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: %s placeholder in query with %s placeholders", ErrMixedPlaceholders, style, m.Style),
+			)
+		}
+
+		var param *params.Parameter
+		switch p := p.(type) {
+		case numericArg:
+			if p == 0 {
+				return "", nil, xerrors.WithStackTrace(ErrUnexpectedNumericArgZero)
+			}
+			if int(p) > len(args) {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: $%d, len(args) = %d", ErrInconsistentArgs, p, len(args)),
+				)
+			}
+			param = parameters[p-1]
+		case positionalArg:
+			if position > len(args)-1 {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: position %d, len(args) = %d", ErrInconsistentArgs, position, len(args)),
+				)
+			}
+			param = parameters[position]
+			position++
+		case namedArg:
+			param = namedParameter(parameters, named, string(p))
+			if param == nil {
+				return "", nil, xerrors.WithStackTrace(
+					fmt.Errorf("%w: @%s, len(args) = %d", ErrInconsistentArgs, p, len(args)),
+				)
+			}
+		}
+		buffer.WriteString(param.Name())
+		replaced = true
+	}
+
+	if m.Style == PlaceholderQuestion && position != len(args) {
+		return "", nil, xerrors.WithStackTrace(
+			fmt.Errorf("%w: (positional args %d, query args %d)", ErrInconsistentArgs, position, len(args)),
+		)
+	}
+
+	newArgs = make([]interface{}, len(parameters))
+	for i := range parameters {
+		newArgs[i] = parameters[i]
+	}
+
+	if replaced {
+		return "-- origin query with " + m.Style.String() + " placeholders replacement\n" + buffer.String(),
+			newArgs, nil
+	}
+
+	return buffer.String(), newArgs, nil
+}
+
+func placeholderStyle(part interface{}) PlaceholderStyle {
+	switch part.(type) {
+	case numericArg:
+		return PlaceholderDollar
+	case positionalArg:
+		return PlaceholderQuestion
+	case namedArg:
+		return PlaceholderNamed
+	default:
+		return 0
+	}
+}
+
+// namedParameter returns parameter for @name placeholder: named arg with the same name or
+// positional arg for @p1, @p2, ... placeholders
+func namedParameter(parameters []*params.Parameter, named map[string]*params.Parameter, name string) *params.Parameter {
+	if p, has := named["$"+name]; has {
+		return p
+	}
+
+	if len(name) > 1 && name[0] == 'p' {
+		if n, err := strconv.Atoi(name[1:]); err == nil && n >= 1 && n <= len(parameters) {
+			return parameters[n-1]
+		}
+	}
+
+	return nil
+}
+
+func isIdentifierRune(r rune) bool {
+	return isLetter(r) || isNumber(r) || r == '_'
+}
+
+//nolint:funlen,gocyclo
+func placeholdersStateFn(l *sqlLexer) stateFn {
+	for {
+		r, width := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += width
+
+		switch r {
+		case '`':
+			return backtickState
+		case '\'':
+			return singleQuoteState
+		case '"':
+			return doubleQuoteState
+		case '$':
+			nextRune, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+			if isNumber(nextRune) {
+				if l.pos-l.start > 0 {
+					l.parts = append(l.parts, l.src[l.start:l.pos-width])
+				}
+				l.start = l.pos
+
+				return numericArgState
+			}
+		case '?':
+			// question mark after type name (such as Int32? or List<Int32>?) is an optional type
+			prevRune, _ := utf8.DecodeLastRuneInString(l.src[:l.pos-width])
+			if isIdentifierRune(prevRune) || prevRune == '>' {
+				continue
+			}
+			l.parts = append(l.parts, l.src[l.start:l.pos-width], positionalArg{})
+			l.start = l.pos
+		case '@':
+			nextRune, nextWidth := utf8.DecodeRuneInString(l.src[l.pos:])
+			switch {
+			case nextRune == '@':
+				l.pos += nextWidth
+
+				return rawStringState
+			case isLetter(nextRune) || nextRune == '_':
+				end := l.pos
+				for {
+					r, w := utf8.DecodeRuneInString(l.src[end:])
+					if !isIdentifierRune(r) {
+						break
+					}
+					end += w
+				}
+				l.parts = append(l.parts, l.src[l.start:l.pos-width], namedArg(l.src[l.pos:end]))
+				l.pos = end
+				l.start = l.pos
+			}
+		case '-':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '-' {
+				l.pos += width
+
+				return oneLineCommentState
+			}
+		case '/':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune == '*' {
+				l.pos += width
+
+				return multilineCommentState
+			}
+		case utf8.RuneError:
+			if l.pos-l.start > 0 {
+				l.parts = append(l.parts, l.src[l.start:l.pos])
+				l.start = l.pos
+			}
+
+			return nil
+		}
+	}
+}
+
+// rawStringState skips YQL raw string literal @@...@@ (@@@@ inside literal is an escaped @@)
+func rawStringState(l *sqlLexer) stateFn {
+	for {
+		r, width := utf8.DecodeRuneInString(l.src[l.pos:])
+		l.pos += width
+
+		switch r {
+		case '@':
+			nextRune, width := utf8.DecodeRuneInString(l.src[l.pos:])
+			if nextRune != '@' {
+				continue
+			}
+			l.pos += width
+			if next, w := utf8.DecodeRuneInString(l.src[l.pos:]); next == '@' {
+				nextNext, _ := utf8.DecodeRuneInString(l.src[l.pos+w:])
+				if nextNext == '@' {
+					l.pos += 2 * w
+
+					continue
+				}
+			}
+
+			return l.rawStateFn
+		case utf8.RuneError:
+			if l.pos-l.start > 0 {
+				l.parts = append(l.parts, l.src[l.start:l.pos])
+				l.start = l.pos
+			}
+
+			return nil
+		}
+	}
+}
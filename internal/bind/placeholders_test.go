@@ -0,0 +1,150 @@
+package bind
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestPlaceholdersBindRewriteQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		style  PlaceholderStyle
+		sql    string
+		args   []interface{}
+		yql    string
+		params []interface{}
+		err    error
+	}{
+		{
+			name:  "Dollar",
+			style: PlaceholderDollar,
+			sql:   `SELECT $2, $1, $2, $nativeParam FROM t WHERE s = '$3'`,
+			args:  []interface{}{100, 200},
+			yql: `-- origin query with dollar placeholders replacement
+SELECT $p1, $p0, $p1, $nativeParam FROM t WHERE s = '$3'`,
+			params: []interface{}{
+				table.ValueParam("$p0", types.Int32Value(100)),
+				table.ValueParam("$p1", types.Int32Value(200)),
+			},
+		},
+		{
+			name:   "DollarWithoutPlaceholders",
+			style:  PlaceholderDollar,
+			sql:    `SELECT 1`,
+			yql:    `SELECT 1`,
+			params: []interface{}{},
+		},
+		{
+			name:  "DollarZero",
+			style: PlaceholderDollar,
+			sql:   `SELECT $0`,
+			args:  []interface{}{100},
+			err:   ErrUnexpectedNumericArgZero,
+		},
+		{
+			name:  "DollarOutOfRange",
+			style: PlaceholderDollar,
+			sql:   `SELECT $1, $2`,
+			args:  []interface{}{100},
+			err:   ErrInconsistentArgs,
+		},
+		{
+			name:  "DollarMixed",
+			style: PlaceholderDollar,
+			sql:   `SELECT $1, ?`,
+			args:  []interface{}{100, 200},
+			err:   ErrMixedPlaceholders,
+		},
+		{
+			name:  "Question",
+			style: PlaceholderQuestion,
+			sql:   `DECLARE $x AS Int32?; SELECT ?, ? FROM t WHERE a = ? AND s = "?" -- ?`,
+			args:  []interface{}{100, 200, 300},
+			yql: `-- origin query with question placeholders replacement
+DECLARE $x AS Int32?; SELECT $p0, $p1 FROM t WHERE a = $p2 AND s = "?" -- ?`,
+			params: []interface{}{
+				table.ValueParam("$p0", types.Int32Value(100)),
+				table.ValueParam("$p1", types.Int32Value(200)),
+				table.ValueParam("$p2", types.Int32Value(300)),
+			},
+		},
+		{
+			name:  "QuestionTooFewArgs",
+			style: PlaceholderQuestion,
+			sql:   `SELECT ?, ?`,
+			args:  []interface{}{100},
+			err:   ErrInconsistentArgs,
+		},
+		{
+			name:  "QuestionTooManyArgs",
+			style: PlaceholderQuestion,
+			sql:   `SELECT ?`,
+			args:  []interface{}{100, 200},
+			err:   ErrInconsistentArgs,
+		},
+		{
+			name:  "QuestionMixed",
+			style: PlaceholderQuestion,
+			sql:   `SELECT ?, @p2`,
+			args:  []interface{}{100, 200},
+			err:   ErrMixedPlaceholders,
+		},
+		{
+			name:  "NamedPositional",
+			style: PlaceholderNamed,
+			sql:   `SELECT @p2, @p1, @@raw @p1 string@@`,
+			args:  []interface{}{100, 200},
+			yql: `-- origin query with named placeholders replacement
+SELECT $p1, $p0, @@raw @p1 string@@`,
+			params: []interface{}{
+				table.ValueParam("$p0", types.Int32Value(100)),
+				table.ValueParam("$p1", types.Int32Value(200)),
+			},
+		},
+		{
+			name:  "NamedArgs",
+			style: PlaceholderNamed,
+			sql:   `SELECT @id, @name`,
+			args: []interface{}{
+				driver.NamedValue{Name: "name", Value: "test"},
+				driver.NamedValue{Name: "id", Value: 100},
+			},
+			yql: `-- origin query with named placeholders replacement
+SELECT $id, $name`,
+			params: []interface{}{
+				table.ValueParam("$name", types.TextValue("test")),
+				table.ValueParam("$id", types.Int32Value(100)),
+			},
+		},
+		{
+			name:  "NamedUnknown",
+			style: PlaceholderNamed,
+			sql:   `SELECT @id, @p2`,
+			args:  []interface{}{100},
+			err:   ErrInconsistentArgs,
+		},
+		{
+			name:  "NamedMixed",
+			style: PlaceholderNamed,
+			sql:   `SELECT @p1, $2`,
+			args:  []interface{}{100, 200},
+			err:   ErrMixedPlaceholders,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			yql, params, err := Placeholders{Style: tt.style}.ToYdb(tt.sql, tt.args...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.yql, yql)
+				require.Equal(t, tt.params, params)
+			}
+		})
+	}
+}
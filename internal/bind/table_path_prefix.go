@@ -1,9 +1,12 @@
 package bind
 
 import (
+	"fmt"
 	"path"
 	"strings"
+	"unicode"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
@@ -38,3 +41,24 @@ func (tablePathPrefix TablePathPrefix) ToYdb(sql string, args ...interface{}) (
 
 	return buffer.String(), args, nil
 }
+
+// Validate checks that table path prefix is an absolute YDB path which elements contains
+// only letters, digits and '-', '_', '.' symbols
+func (tablePathPrefix TablePathPrefix) Validate() error {
+	p := string(tablePathPrefix)
+	if !strings.HasPrefix(p, "/") {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %q is not absolute path", ErrWrongTablePathPrefix, p))
+	}
+
+	for _, name := range strings.Split(strings.TrimSuffix(p[1:], "/"), "/") {
+		if name == "" || name == "." || name == ".." || strings.IndexFunc(name, isWrongPathRune) >= 0 {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %q has wrong path element %q", ErrWrongTablePathPrefix, p, name))
+		}
+	}
+
+	return nil
+}
+
+func isWrongPathRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' && r != '.'
+}
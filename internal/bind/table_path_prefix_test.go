@@ -0,0 +1,64 @@
+package bind
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTablePathPrefixValidate(t *testing.T) {
+	for _, tt := range []struct {
+		tablePathPrefix TablePathPrefix
+		valid           bool
+	}{
+		{tablePathPrefix: "/local", valid: true},
+		{tablePathPrefix: "/local/", valid: true},
+		{tablePathPrefix: "/local/tenant-1/sub_dir.v2", valid: true},
+		{tablePathPrefix: "/", valid: false},
+		{tablePathPrefix: "", valid: false},
+		{tablePathPrefix: "local", valid: false},
+		{tablePathPrefix: "/local//tenant", valid: false},
+		{tablePathPrefix: "/local/../tenant", valid: false},
+		{tablePathPrefix: "/local/./tenant", valid: false},
+		{tablePathPrefix: "/local/ten ant", valid: false},
+		{tablePathPrefix: `/local/tenant");`, valid: false},
+	} {
+		t.Run(string(tt.tablePathPrefix), func(t *testing.T) {
+			err := tt.tablePathPrefix.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrWrongTablePathPrefix)
+			}
+		})
+	}
+}
+
+func TestBindingsWithTablePathPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		bindings   Bindings
+		overridden Bindings
+	}{
+		{
+			name:       "Empty",
+			overridden: Bindings{TablePathPrefix("/tenant")},
+		},
+		{
+			name:       "Add",
+			bindings:   Bindings{AutoDeclare{}, NumericArgs{}},
+			overridden: Bindings{TablePathPrefix("/tenant"), AutoDeclare{}, NumericArgs{}},
+		},
+		{
+			name:       "Replace",
+			bindings:   Bindings{TablePathPrefix("/local"), AutoDeclare{}},
+			overridden: Bindings{TablePathPrefix("/tenant"), AutoDeclare{}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			bindings := append(Bindings(nil), tt.bindings...)
+			require.Equal(t, tt.overridden, tt.bindings.WithTablePathPrefix("/tenant"))
+			require.Equal(t, bindings, tt.bindings)
+		})
+	}
+}
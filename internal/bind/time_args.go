@@ -0,0 +1,76 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// TimeArgs defines YDB type of time.Time query args.
+//
+// Datetime keeps only whole seconds and Date keeps only UTC calendar day of time,
+// so the rest of time is truncated towards the past
+type TimeArgs uint8
+
+const (
+	TimeArgsTimestamp = TimeArgs(iota)
+	TimeArgsDatetime
+	TimeArgsDate
+)
+
+func (timeArgs TimeArgs) blockID() blockID {
+	return blockArgs
+}
+
+func (timeArgs TimeArgs) ToYdb(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	newArgs = make([]interface{}, len(args))
+	for i, arg := range args {
+		newArgs[i] = timeArgs.convertArg(arg)
+	}
+
+	return sql, newArgs, nil
+}
+
+func (timeArgs TimeArgs) convertArg(arg interface{}) interface{} {
+	switch x := arg.(type) {
+	case driver.NamedValue:
+		x.Value = timeArgs.convert(x.Value)
+
+		return x
+	case sql.NamedArg:
+		x.Value = timeArgs.convert(x.Value)
+
+		return x
+	default:
+		return timeArgs.convert(arg)
+	}
+}
+
+func (timeArgs TimeArgs) convert(v interface{}) interface{} {
+	switch x := v.(type) {
+	case time.Time:
+		switch timeArgs {
+		case TimeArgsDatetime:
+			return value.DatetimeValueFromTime(x)
+		case TimeArgsDate:
+			return value.DateValueFromTime(x)
+		default:
+			return value.TimestampValueFromTime(x)
+		}
+	case *time.Time:
+		switch timeArgs {
+		case TimeArgsDatetime:
+			return value.NullableDatetimeValueFromTime(x)
+		case TimeArgsDate:
+			return value.NullableDateValueFromTime(x)
+		default:
+			return value.NullableTimestampValueFromTime(x)
+		}
+	default:
+		return v
+	}
+}
@@ -0,0 +1,113 @@
+package bind
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func timeArgsRef(timeArgs TimeArgs) *TimeArgs {
+	return &timeArgs
+}
+
+func TestTimeArgs(t *testing.T) {
+	v := time.Date(2024, 5, 17, 23, 59, 58, 123456789, time.FixedZone("UTC+3", 3*60*60))
+
+	for _, tt := range []struct {
+		name     string
+		timeArgs *TimeArgs
+		yqlType  string
+		value    value.Value
+		time     time.Time
+	}{
+		{
+			name:    "Default",
+			yqlType: "Timestamp",
+			value:   value.TimestampValueFromTime(v),
+			time:    time.Date(2024, 5, 17, 20, 59, 58, 123456000, time.UTC),
+		},
+		{
+			name:     "Timestamp",
+			timeArgs: timeArgsRef(TimeArgsTimestamp),
+			yqlType:  "Timestamp",
+			value:    value.TimestampValueFromTime(v),
+			time:     time.Date(2024, 5, 17, 20, 59, 58, 123456000, time.UTC),
+		},
+		{
+			name:     "Datetime",
+			timeArgs: timeArgsRef(TimeArgsDatetime),
+			yqlType:  "Datetime",
+			value:    value.DatetimeValueFromTime(v),
+			time:     time.Date(2024, 5, 17, 20, 59, 58, 0, time.UTC),
+		},
+		{
+			name:     "Date",
+			timeArgs: timeArgsRef(TimeArgsDate),
+			yqlType:  "Date",
+			value:    value.DateValueFromTime(v),
+			time:     time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			bindings := Bindings{AutoDeclare{}, PositionalArgs{}}
+			if tt.timeArgs != nil {
+				bindings = append(bindings, *tt.timeArgs)
+			}
+
+			yql, params, err := bindings.ToYdb("SELECT ?, ?", v, &v)
+			require.NoError(t, err)
+			require.Contains(t, yql, "DECLARE $p0 AS "+tt.yqlType+";")
+			require.Contains(t, yql, "DECLARE $p1 AS Optional<"+tt.yqlType+">;")
+			require.Len(t, params, 2)
+			require.Equal(t, tt.value, params[0].Value())
+
+			var truncated time.Time
+			require.NoError(t, value.CastTo(params[0].Value(), &truncated))
+			require.Equal(t, tt.time, truncated.UTC())
+
+			bindings = Bindings{AutoDeclare{}}
+			if tt.timeArgs != nil {
+				bindings = bindings.WithTimeArgs(*tt.timeArgs)
+			}
+
+			yql, params, err = bindings.ToYdb("SELECT $t", sql.Named("t", v))
+			require.NoError(t, err)
+			require.Contains(t, yql, "DECLARE $t AS "+tt.yqlType+";")
+			require.Equal(t, tt.value, params[0].Value())
+		})
+	}
+}
+
+func TestBindingsWithTimeArgs(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		bindings   Bindings
+		overridden Bindings
+	}{
+		{
+			name:       "Empty",
+			overridden: Bindings{TimeArgsDate},
+		},
+		{
+			name:       "Add",
+			bindings:   Bindings{AutoDeclare{}, NumericArgs{}},
+			overridden: Bindings{AutoDeclare{}, NumericArgs{}, TimeArgsDate},
+		},
+		{
+			name:       "Replace",
+			bindings:   Bindings{AutoDeclare{}, TimeArgsDatetime},
+			overridden: Bindings{AutoDeclare{}, TimeArgsDate},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			bindings := append(Bindings(nil), tt.bindings...)
+			require.Equal(t, tt.overridden, tt.bindings.WithTimeArgs(TimeArgsDate))
+			require.Equal(t, bindings, tt.bindings)
+			require.Equal(t, tt.overridden, Bindings(Sort(tt.overridden)))
+		})
+	}
+}
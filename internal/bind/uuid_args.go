@@ -0,0 +1,61 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// UUIDArgs converts [16]byte query args to YDB UUID values. Bytes must be in RFC 4122 order,
+// same as in uuid.UUID. Without UUIDArgs [16]byte args are rejected because of issue
+// https://github.com/ydb-platform/ydb-go-sdk/issues/1501
+type UUIDArgs struct{}
+
+func (m UUIDArgs) blockID() blockID {
+	return blockArgs
+}
+
+func (m UUIDArgs) ToYdb(sql string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	newArgs = make([]interface{}, len(args))
+	for i, arg := range args {
+		newArgs[i] = m.convertArg(arg)
+	}
+
+	return sql, newArgs, nil
+}
+
+func (m UUIDArgs) convertArg(arg interface{}) interface{} {
+	switch x := arg.(type) {
+	case driver.NamedValue:
+		x.Value = m.convert(x.Value)
+
+		return x
+	case sql.NamedArg:
+		x.Value = m.convert(x.Value)
+
+		return x
+	default:
+		return m.convert(arg)
+	}
+}
+
+func (m UUIDArgs) convert(v interface{}) interface{} {
+	switch x := v.(type) {
+	case [16]byte:
+		return value.Uuid(uuid.UUID(x))
+	case *[16]byte:
+		if x == nil {
+			return value.NullValue(types.UUID)
+		}
+
+		return value.OptionalValue(value.Uuid(uuid.UUID(*x)))
+	default:
+		return v
+	}
+}
@@ -0,0 +1,47 @@
+package bind
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestUUIDArgs(t *testing.T) {
+	id := uuid.MustParse("6E73B41C-4EDE-4D08-9CFB-B7462D9E498B")
+	idBytes := [16]byte(id)
+
+	t.Run("Positional", func(t *testing.T) {
+		yql, params, err := Bindings{AutoDeclare{}, PositionalArgs{}, UUIDArgs{}}.ToYdb(
+			"SELECT ?, ?, ?, ?", idBytes, &idBytes, (*[16]byte)(nil), id,
+		)
+		require.NoError(t, err)
+		require.Contains(t, yql, "DECLARE $p0 AS Uuid;")
+		require.Contains(t, yql, "DECLARE $p1 AS Optional<Uuid>;")
+		require.Contains(t, yql, "DECLARE $p2 AS Optional<Uuid>;")
+		require.Contains(t, yql, "DECLARE $p3 AS Uuid;")
+		require.Len(t, params, 4)
+		require.Equal(t, value.Uuid(id), params[0].Value())
+		require.Equal(t, value.OptionalValue(value.Uuid(id)), params[1].Value())
+		require.Equal(t, value.NullValue(types.UUID), params[2].Value())
+		require.Equal(t, params[0].Value(), params[3].Value())
+
+		var scanned uuid.UUID
+		require.NoError(t, value.CastTo(params[0].Value(), &scanned))
+		require.Equal(t, id, scanned)
+	})
+	t.Run("Named", func(t *testing.T) {
+		yql, params, err := Bindings{AutoDeclare{}, UUIDArgs{}}.ToYdb("SELECT $id", sql.Named("id", idBytes))
+		require.NoError(t, err)
+		require.Contains(t, yql, "DECLARE $id AS Uuid;")
+		require.Equal(t, value.Uuid(id), params[0].Value())
+	})
+	t.Run("WithoutUUIDArgs", func(t *testing.T) {
+		_, _, err := Bindings{AutoDeclare{}}.ToYdb("SELECT $id", sql.Named("id", idBytes))
+		require.ErrorIs(t, err, value.ErrIssue1501BadUUID)
+	})
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const generatedHeader = `// Code generated by gtrace. DO NOT EDIT.`
+
+// generatedFileName returns path of the file with generated code for the
+// source file.
+func generatedFileName(file string) string {
+	ext := filepath.Ext(file)
+
+	return filepath.Clean(strings.TrimSuffix(file, ext) + "_gtrace" + ext)
+}
+
+// checkFiles compares generated code of each source file with its generated
+// file on disk. Returned error describes all stale files.
+func checkFiles(files []string, results []generated) error {
+	errs := make([]error, 0, len(files))
+	for i, file := range files {
+		if err := check(generatedFileName(file), results[i].code); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// check compares code with content of the file placed at path and returns
+// error with summary of the first difference if they are not equal.
+func check(path string, code []byte) error {
+	actual, err := os.ReadFile(path)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if bytes.Equal(actual, code) {
+		return nil
+	}
+	if !bytes.HasPrefix(actual, []byte(generatedHeader)) {
+		return fmt.Errorf("%s: not generated by gtrace: no %q header", path, generatedHeader)
+	}
+
+	return fmt.Errorf("%s: stale generated code: %s", path, diffSummary(actual, code))
+}
+
+func diffSummary(actual, expected []byte) string {
+	var (
+		a = strings.Split(string(actual), "\n")
+		e = strings.Split(string(expected), "\n")
+	)
+	for i := 0; i < len(a) && i < len(e); i++ {
+		if a[i] != e[i] {
+			return fmt.Sprintf("line %d differs:\n-\t%s\n+\t%s", i+1, a[i], e[i])
+		}
+	}
+
+	return fmt.Sprintf("%d lines on disk, %d lines generated", len(a), len(e))
+}
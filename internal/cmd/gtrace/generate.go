@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"go/build"
+	"path/filepath"
+	"sync"
+)
+
+type generated struct {
+	pkg  Package
+	code []byte
+}
+
+// generate loads packages of the given source files and writes generated code
+// for each of them into separate buffer. Files are processed by at most workers
+// goroutines. Results are returned in the same order as files.
+func generate(buildCtx build.Context, files []string, workers int) ([]generated, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(files) {
+		workers = len(files)
+	}
+
+	var (
+		results = make([]generated, len(files))
+		errs    = make([]error, len(files))
+		jobs    = make(chan int)
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results[j], errs[j] = generateFile(buildCtx, files[j])
+			}
+		}()
+	}
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func generateFile(buildCtx build.Context, file string) (g generated, err error) {
+	g.pkg, err = loadPackage(buildCtx, filepath.Dir(file), filepath.Base(file))
+	if err != nil {
+		return g, err
+	}
+
+	var buf bytes.Buffer
+	if err = (&Writer{
+		Context: buildCtx,
+		Output:  &buf,
+	}).Write(g.pkg); err != nil {
+		return g, err
+	}
+	g.code = buf.Bytes()
+
+	return g, nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fixturePackages writes n independent packages with traces and returns paths
+// to their source files.
+func fixturePackages(tb testing.TB, n int) (files []string) {
+	tb.Helper()
+
+	root := tb.TempDir()
+	for i := 0; i < n; i++ {
+		dir := filepath.Join(root, fmt.Sprintf("pkg%d", i))
+		require.NoError(tb, os.Mkdir(dir, 0o700))
+		file := filepath.Join(dir, "trace.go")
+		require.NoError(tb, os.WriteFile(file, []byte(fmt.Sprintf(`package pkg%d
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// gtrace:gen
+	Trace struct {
+		OnStart func(StartInfo) func(DoneInfo)
+		OnCall  func(ctx context.Context, n int) func(err error)
+	}
+	StartInfo struct {
+		Context *context.Context
+		Name    string
+	}
+	DoneInfo struct {
+		Latency time.Duration
+		Error   error
+	}
+)
+`, i)), 0o600))
+		files = append(files, file)
+	}
+
+	return files
+}
+
+func TestGenerateParallel(t *testing.T) {
+	files := fixturePackages(t, 8)
+
+	serial, err := generate(build.Default, files, 1)
+	require.NoError(t, err)
+	require.Len(t, serial, len(files))
+
+	parallel, err := generate(build.Default, files, 4)
+	require.NoError(t, err)
+	require.Len(t, parallel, len(files))
+
+	for i := range files {
+		require.Contains(t, string(serial[i].code), fmt.Sprintf("package pkg%d", i))
+		require.Equal(t, string(serial[i].code), string(parallel[i].code))
+	}
+}
+
+func TestGenerateError(t *testing.T) {
+	files := fixturePackages(t, 2)
+	files = append(files, filepath.Join(t.TempDir(), "trace.go"))
+
+	_, err := generate(build.Default, files, 2)
+	require.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	files := fixturePackages(t, 2)
+
+	results, err := generate(build.Default, files, 2)
+	require.NoError(t, err)
+	for i, file := range files {
+		require.NoError(t, os.WriteFile(generatedFileName(file), results[i].code, 0o600))
+	}
+	require.NoError(t, checkFiles(files, results))
+
+	golden := generatedFileName(files[1])
+	stale := strings.Replace(string(results[1].code), "OnCall", "OnCallStale", 1)
+	require.NoError(t, os.WriteFile(golden, []byte(stale), 0o600))
+
+	err = checkFiles(files, results)
+	require.ErrorContains(t, err, golden+": stale generated code: line ")
+	require.NotContains(t, err.Error(), generatedFileName(files[0]))
+
+	withoutHeader := strings.TrimPrefix(string(results[1].code), generatedHeader)
+	require.NoError(t, os.WriteFile(golden, []byte(withoutHeader), 0o600))
+	require.ErrorContains(t, checkFiles(files, results), golden+": not generated by gtrace")
+
+	require.NoError(t, os.Remove(golden))
+	require.ErrorIs(t, checkFiles(files, results), os.ErrNotExist)
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	files := fixturePackages(b, 16)
+	workers := []int{1}
+	if n := runtime.GOMAXPROCS(0); n > 1 {
+		workers = append(workers, n)
+	}
+	for _, workers := range workers {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := generate(build.Default, files, workers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
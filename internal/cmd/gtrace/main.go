@@ -15,12 +15,12 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
-//nolint:gocyclo,funlen
 func main() {
 	var (
 		// Reports whether we were called from go:generate.
@@ -28,8 +28,14 @@ func main() {
 
 		gofile  string
 		workDir string
+		schema  string
+		check   bool
 		err     error
 	)
+	flag.StringVar(&schema, "schema", "", "path to file for JSON description of traces")
+	flag.BoolVar(&check, "check", false, "compare generated code with existing *_gtrace.go files instead of writing it")
+	flag.Parse()
+
 	if gofile = os.Getenv("GOFILE"); gofile != "" {
 		// NOTE: GOFILE is always a filename without path.
 		isGoGenerate = true
@@ -50,46 +56,63 @@ func main() {
 		log.SetPrefix("[" + prefix + "] ")
 	}
 	buildCtx := build.Default
-	buildPkg, err := buildCtx.ImportDir(workDir, build.IgnoreVendor)
+
+	files := flag.Args()
+	if isGoGenerate {
+		files = []string{filepath.Join(workDir, gofile)}
+	}
+	results, err := generate(buildCtx, files, runtime.GOMAXPROCS(0))
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	srcFilePath := filepath.Join(workDir, gofile)
-
-	var writers []*Writer
-	if isGoGenerate {
-		openFile := func(name string) (*os.File, func()) {
-			var f *os.File
-			//nolint:gofumpt
-			//nolint:nolintlint
-			//nolint:gosec
-			f, err = os.OpenFile(
-				filepath.Join(workDir, filepath.Clean(name)),
-				os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
-				0o600, //nolint:gomnd
-			)
-			if err != nil {
+	switch {
+	case check:
+		if err = checkFiles(files, results); err != nil {
+			log.Fatal(err)
+		}
+	case isGoGenerate:
+		//nolint:gosec
+		err = os.WriteFile(
+			generatedFileName(files[0]),
+			results[0].code,
+			0o600, //nolint:gomnd
+		)
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		// NOTE: outputs are written in order of arguments.
+		for _, r := range results {
+			if _, err = os.Stdout.Write(r.code); err != nil {
 				log.Fatal(err)
 			}
-
-			return f, func() { f.Close() }
 		}
-		ext := filepath.Ext(gofile)
-		name := strings.TrimSuffix(gofile, ext)
-		f, clean := openFile(name + "_gtrace" + ext)
-		defer clean()
-		writers = append(writers, &Writer{
-			Context: buildCtx,
-			Output:  f,
-		})
-	} else {
-		writers = append(writers, &Writer{
-			Context: buildCtx,
-			Output:  os.Stdout,
-		})
+	}
+	if schema != "" {
+		if len(results) != 1 {
+			log.Fatal("-schema flag requires single source file")
+		}
+		if err := writeSchema(buildCtx, schema, results[0].pkg); err != nil {
+			log.Fatal(err)
+		}
 	}
 
+	log.Println("OK")
+}
+
+// loadPackage parses and type-checks the package placed in workDir and collects
+// traces marked with gtrace:gen directive from the gofile.
+//
+//nolint:gocyclo,funlen
+func loadPackage(buildCtx build.Context, workDir, gofile string) (p Package, err error) {
+	buildPkg, err := buildCtx.ImportDir(workDir, build.IgnoreVendor)
+	if err != nil {
+		return p, xerrors.WithStackTrace(err)
+	}
+
+	srcFilePath := filepath.Join(workDir, gofile)
+
 	var (
 		pkgFiles = make([]*os.File, 0, len(buildPkg.GoFiles))
 		astFiles = make([]*ast.File, 0, len(buildPkg.GoFiles))
@@ -105,14 +128,14 @@ func main() {
 		var file *os.File
 		file, err = os.Open(filepath.Join(workDir, name))
 		if err != nil {
-			panic(err)
+			return p, xerrors.WithStackTrace(err)
 		}
 		defer file.Close() //nolint:gocritic
 
 		var ast *ast.File
 		ast, err = parser.ParseFile(fset, file.Name(), file, parser.ParseComments)
 		if err != nil {
-			panic(fmt.Sprintf("parse %q error: %v", file.Name(), err))
+			return p, xerrors.WithStackTrace(fmt.Errorf("parse %q error: %w", file.Name(), err))
 		}
 
 		pkgFiles = append(pkgFiles, file)
@@ -120,11 +143,11 @@ func main() {
 
 		if name == gofile {
 			if _, err = file.Seek(0, io.SeekStart); err != nil {
-				panic(err)
+				return p, xerrors.WithStackTrace(err)
 			}
 			buildConstraints, err = scanBuildConstraints(file)
 			if err != nil {
-				panic(err)
+				return p, xerrors.WithStackTrace(err)
 			}
 		}
 	}
@@ -140,7 +163,7 @@ func main() {
 	}
 	pkg, err := conf.Check(".", fset, astFiles, info)
 	if err != nil {
-		panic(fmt.Sprintf("type error: %v", err))
+		return p, xerrors.WithStackTrace(fmt.Errorf("type error: %w", err))
 	}
 	var items []*GenItem
 	for i, astFile := range astFiles {
@@ -176,12 +199,24 @@ func main() {
 				return false
 
 			case *ast.CommentGroup:
+				var flag GenFlag
 				for _, c := range v.List {
-					if strings.Contains(strings.TrimPrefix(c.Text, "//"), "gtrace:gen") {
+					text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+					if strings.Contains(text, "gtrace:gen") {
 						if item == nil {
 							item = &GenItem{}
 						}
 					}
+					if strings.HasPrefix(text, "gtrace:set ") {
+						f, parseErr := parseGenFlag(strings.TrimSpace(strings.TrimPrefix(text, "gtrace:set ")))
+						if parseErr != nil && err == nil {
+							err = parseErr
+						}
+						flag |= f
+					}
+				}
+				if item != nil {
+					item.Flag |= flag
 				}
 
 				return false
@@ -198,8 +233,11 @@ func main() {
 
 			return true
 		})
+		if err != nil {
+			return p, err
+		}
 	}
-	p := Package{
+	p = Package{
 		Package:          pkg,
 		BuildConstraints: buildConstraints,
 	}
@@ -207,6 +245,7 @@ func main() {
 	for _, item := range items {
 		t := &Trace{
 			Name: item.Ident.Name,
+			Flag: item.Flag,
 		}
 		p.Traces = append(p.Traces, t)
 		traces[item.Ident.Name] = t
@@ -237,13 +276,8 @@ func main() {
 			})
 		}
 	}
-	for _, w := range writers {
-		if err := w.Write(p); err != nil {
-			panic(err)
-		}
-	}
 
-	log.Println("OK")
+	return p, nil
 }
 
 func buildFunc(info *types.Info, traces map[string]*Trace, fn *ast.FuncType) (ret *Func, err error) {
@@ -253,6 +287,8 @@ func buildFunc(info *types.Info, traces map[string]*Trace, fn *ast.FuncType) (re
 		if t == nil {
 			log.Fatalf("unknown type: %s", p.Type)
 		}
+		// NOTE: type of variadic parameter is a slice of elements.
+		_, variadic := p.Type.(*ast.Ellipsis)
 		var names []string
 		for _, n := range p.Names {
 			name := n.Name
@@ -267,44 +303,50 @@ func buildFunc(info *types.Info, traces map[string]*Trace, fn *ast.FuncType) (re
 		}
 		for _, name := range names {
 			ret.Params = append(ret.Params, Param{
-				Name: name,
-				Type: t,
+				Name:     name,
+				Type:     t,
+				Variadic: variadic,
 			})
 		}
 	}
 	if fn.Results == nil {
 		return ret, nil
 	}
-	if len(fn.Results.List) > 1 {
-		return nil, fmt.Errorf(
-			"unsupported number of function results",
-		)
+	for _, r := range fn.Results.List {
+		result, err := buildFuncResult(info, traces, r.Type)
+		if err != nil {
+			return nil, err
+		}
+		// NOTE: results like (a, b func()) share the same type.
+		for n := max(len(r.Names), 1); n > 0; n-- {
+			ret.Result = append(ret.Result, result)
+		}
 	}
 
-	r := fn.Results.List[0]
+	return ret, nil
+}
 
-	switch x := r.Type.(type) {
+func buildFuncResult(info *types.Info, traces map[string]*Trace, expr ast.Expr) (FuncResult, error) {
+	switch x := expr.(type) {
 	case *ast.FuncType:
 		result, err := buildFunc(info, traces, x)
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
 		}
-		ret.Result = append(ret.Result, result)
 
-		return ret, nil
+		return result, nil
 
 	case *ast.Ident:
 		if t, ok := traces[x.Name]; ok {
 			t.Nested = true
-			ret.Result = append(ret.Result, t)
 
-			return ret, nil
+			return t, nil
 		}
 	}
 
 	return nil, fmt.Errorf(
 		"unsupported function result type %s",
-		info.TypeOf(r.Type),
+		info.TypeOf(expr),
 	)
 }
 
@@ -319,6 +361,7 @@ type Trace struct {
 	Name   string
 	Hooks  []Hook
 	Nested bool
+	Flag   GenFlag
 }
 
 func (*Trace) isFuncResult() bool { return true }
@@ -329,21 +372,34 @@ type Hook struct {
 }
 
 type Param struct {
-	Name string // Might be empty.
-	Type types.Type
+	Name     string // Might be empty.
+	Type     types.Type
+	Variadic bool // Only the last param might be variadic. Type is a slice then.
 }
 
 func (p Param) String() string {
+	if p.Variadic {
+		return p.Name + " ..." + p.elem().String()
+	}
+
 	return p.Name + " " + p.Type.String()
 }
 
+func (p Param) elem() types.Type {
+	if s, ok := p.Type.(*types.Slice); ok {
+		return s.Elem()
+	}
+
+	return p.Type
+}
+
 type FuncResult interface {
 	isFuncResult() bool
 }
 
 type Func struct {
 	Params []Param
-	Result []FuncResult // 0 or 1.
+	Result []FuncResult
 }
 
 func (*Func) isFuncResult() bool { return true }
@@ -354,13 +410,41 @@ func (f *Func) HasResult() bool {
 
 type GenFlag uint8
 
+const (
+	// GenEvents enables generation of hook names table. Set by the
+	// "gtrace:set events" directive.
+	GenEvents GenFlag = 1 << iota
+	// GenInterface enables generation of interface with method per hook and
+	// constructor of trace from interface implementation. Set by the
+	// "gtrace:set interface" directive.
+	GenInterface
+	// GenToggle enables generation of package-level variable which disables
+	// hooks of trace in shortcuts when false. Set by the "gtrace:set toggle"
+	// directive.
+	GenToggle
+)
+
 func (f GenFlag) Has(x GenFlag) bool {
 	return f&x != 0
 }
 
+func parseGenFlag(s string) (GenFlag, error) {
+	switch s {
+	case "events":
+		return GenEvents, nil
+	case "interface":
+		return GenInterface, nil
+	case "toggle":
+		return GenToggle, nil
+	default:
+		return 0, xerrors.WithStackTrace(fmt.Errorf("unknown gtrace:set flag %q", s))
+	}
+}
+
 type GenItem struct {
 	Ident      *ast.Ident
 	StructType *ast.StructType
+	Flag       GenFlag
 }
 
 func rsplit(s string, c byte) (s1, s2 string) {
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"go/build"
+	"os"
+	"path/filepath"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type schemaPackage struct {
+	Package string        `json:"package"`
+	Traces  []schemaTrace `json:"traces"`
+}
+
+type schemaTrace struct {
+	Name  string       `json:"name"`
+	Hooks []schemaHook `json:"hooks"`
+}
+
+type schemaHook struct {
+	Name string `json:"name"`
+	schemaFunc
+}
+
+// schemaFunc describes hook func. Result is set for single result, Results is
+// set for multiple ones.
+type schemaFunc struct {
+	Params  []schemaParam  `json:"params"`
+	Result  *schemaResult  `json:"result,omitempty"`
+	Results []schemaResult `json:"results,omitempty"`
+}
+
+type schemaParam struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+// schemaResult describes hook result. Exactly one of fields is set.
+type schemaResult struct {
+	Func  *schemaFunc `json:"func,omitempty"`
+	Trace string      `json:"trace,omitempty"`
+}
+
+func writeSchema(buildCtx build.Context, path string, p Package) (err error) {
+	f, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = xerrors.WithStackTrace(closeErr)
+		}
+	}()
+
+	return (&Writer{
+		Context: buildCtx,
+		Output:  f,
+	}).WriteSchema(p)
+}
+
+// WriteSchema writes JSON description of traces of the package p instead of
+// the generated code.
+func (w *Writer) WriteSchema(p Package) error {
+	w.pkg = p.Package
+
+	s := schemaPackage{
+		Package: p.Name(),
+		Traces:  make([]schemaTrace, 0, len(p.Traces)),
+	}
+	for _, trace := range p.Traces {
+		t := schemaTrace{
+			Name:  trace.Name,
+			Hooks: make([]schemaHook, 0, len(trace.Hooks)),
+		}
+		for _, hook := range trace.Hooks {
+			t.Hooks = append(t.Hooks, schemaHook{
+				Name:       hook.Name,
+				schemaFunc: w.schemaFunc(hook.Func),
+			})
+		}
+		s.Traces = append(s.Traces, t)
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if _, err = w.Output.Write(append(b, '\n')); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (w *Writer) schemaFunc(fn *Func) (f schemaFunc) {
+	f.Params = make([]schemaParam, 0, len(fn.Params))
+	for i := range fn.Params {
+		f.Params = append(f.Params, schemaParam{
+			Name: fn.Params[i].Name,
+			Type: w.paramTypeString(&fn.Params[i]),
+		})
+	}
+	switch len(fn.Result) {
+	case 0:
+	case 1:
+		r := w.schemaResult(fn.Result[0])
+		f.Result = &r
+	default:
+		f.Results = make([]schemaResult, 0, len(fn.Result))
+		for _, r := range fn.Result {
+			f.Results = append(f.Results, w.schemaResult(r))
+		}
+	}
+
+	return f
+}
+
+func (w *Writer) schemaResult(r FuncResult) schemaResult {
+	switch x := r.(type) {
+	case *Func:
+		f := w.schemaFunc(x)
+
+		return schemaResult{Func: &f}
+	case *Trace:
+		return schemaResult{Trace: x.Name}
+	default:
+		panic("unexpected result type")
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"context"
+	"time"
+)
+
+type Option func()
+
+type (
+	// gtrace:gen
+	Trace struct {
+		OnStart func(StartInfo) func(DoneInfo)
+		OnCall  func(ctx context.Context, opts ...Option) func(err error)
+		OnChild func(name string) Child
+	}
+	StartInfo struct {
+		Context *context.Context
+		Name    string
+	}
+	DoneInfo struct {
+		Latency time.Duration
+		Error   error
+	}
+)
+
+// gtrace:gen
+type Child struct {
+	OnEvent func(int)
+}
@@ -39,7 +39,7 @@ func (w *Writer) Write(p Package) error {
 	w.pkg = p.Package
 
 	w.init()
-	w.line(`// Code generated by gtrace. DO NOT EDIT.`)
+	w.line(generatedHeader)
 
 	for i, line := range p.BuildConstraints {
 		if i == 0 {
@@ -61,12 +61,24 @@ func (w *Writer) Write(p Package) error {
 		for _, trace := range p.Traces {
 			w.options(trace)
 			w.compose(trace)
+			w.composeAll(trace)
 			if trace.Nested {
 				w.isZero(trace)
 			}
+			w.isEmpty(trace)
+			w.definedHooks(trace)
 			for _, hook := range trace.Hooks {
 				w.hook(trace, hook)
 			}
+			if trace.Flag.Has(GenEvents) {
+				w.events(trace)
+			}
+			if trace.Flag.Has(GenInterface) {
+				w.hooksInterface(trace)
+			}
+			if trace.Flag.Has(GenToggle) {
+				w.toggle(trace)
+			}
 		}
 		for _, trace := range p.Traces {
 			for _, hook := range trace.Hooks {
@@ -150,24 +162,66 @@ type dep struct {
 	typName string
 }
 
+// typeImports collects packages of named types referenced by t. Composite
+// types such as interfaces, maps or function signatures are traversed because
+// generated code spells them out with qualified names of nested types.
+//
+//nolint:gocyclo
 func (w *Writer) typeImports(dst []dep, t types.Type) []dep {
-	if p, ok := t.(*types.Pointer); ok {
-		return w.typeImports(dst, p.Elem())
-	}
-	n, ok := t.(*types.Named)
-	if !ok {
+	switch t := t.(type) {
+	case *types.Pointer:
+		return w.typeImports(dst, t.Elem())
+	case *types.Slice:
+		return w.typeImports(dst, t.Elem())
+	case *types.Array:
+		return w.typeImports(dst, t.Elem())
+	case *types.Chan:
+		return w.typeImports(dst, t.Elem())
+	case *types.Map:
+		return w.typeImports(w.typeImports(dst, t.Key()), t.Elem())
+	case *types.Signature:
+		return w.tupleImports(w.tupleImports(dst, t.Params()), t.Results())
+	case *types.Interface:
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			dst = w.typeImports(dst, t.EmbeddedType(i))
+		}
+		for i := 0; i < t.NumExplicitMethods(); i++ {
+			dst = w.typeImports(dst, t.ExplicitMethod(i).Type())
+		}
+
+		return dst
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			dst = w.typeImports(dst, t.Field(i).Type())
+		}
+
+		return dst
+	case *types.Named:
+		args := t.TypeArgs()
+		for i := 0; i < args.Len(); i++ {
+			dst = w.typeImports(dst, args.At(i))
+		}
+		var (
+			obj = t.Obj()
+			pkg = obj.Pkg()
+		)
+		if pkg != nil && pkg.Path() != w.pkg.Path() {
+			return append(dst, dep{
+				pkgPath: pkg.Path(),
+				pkgName: pkg.Name(),
+				typName: obj.Name(),
+			})
+		}
+
+		return dst
+	default:
 		return dst
 	}
-	var (
-		obj = n.Obj()
-		pkg = obj.Pkg()
-	)
-	if pkg != nil && pkg.Path() != w.pkg.Path() {
-		return append(dst, dep{
-			pkgPath: pkg.Path(),
-			pkgName: pkg.Name(),
-			typName: obj.Name(),
-		})
+}
+
+func (w *Writer) tupleImports(dst []dep, t *types.Tuple) []dep {
+	for i := 0; i < t.Len(); i++ {
+		dst = w.typeImports(dst, t.At(i).Type())
 	}
 
 	return dst
@@ -189,15 +243,35 @@ func unwrapStruct(t types.Type) (n *types.Named, s *types.Struct) {
 	return
 }
 
+// embeddedStruct returns named struct type of the embedded field v or nil if v
+// is not embedded struct.
+func embeddedStruct(v *types.Var) (n *types.Named, s *types.Struct) {
+	if !v.Embedded() {
+		return nil, nil
+	}
+
+	return unwrapStruct(v.Type())
+}
+
+func (w *Writer) structImports(dst []dep, s *types.Struct) []dep {
+	forEachField(s, func(v *types.Var) {
+		if !v.Exported() {
+			return
+		}
+		dst = w.typeImports(dst, v.Type())
+		if _, es := embeddedStruct(v); es != nil {
+			dst = w.structImports(dst, es)
+		}
+	})
+
+	return dst
+}
+
 func (w *Writer) funcImports(dst []dep, fn *Func) []dep {
 	for i := range fn.Params {
 		dst = w.typeImports(dst, fn.Params[i].Type)
 		if _, s := unwrapStruct(fn.Params[i].Type); s != nil {
-			forEachField(s, func(v *types.Var) {
-				if v.Exported() {
-					dst = w.typeImports(dst, v.Type())
-				}
-			})
+			dst = w.structImports(dst, s)
 		}
 	}
 	for _, x := range fn.Result {
@@ -273,9 +347,26 @@ func (w *Writer) ensureStdLibMapping() {
 	if w.std != nil {
 		return
 	}
-	w.std = make(map[string]bool)
+	w.std = stdLibMapping(w.Context.GOROOT)
+}
+
+var stdLib struct {
+	mu sync.Mutex
+	m  map[string]map[string]bool // GOROOT -> top level std packages.
+}
+
+// stdLibMapping lists GOROOT's src once and shares the result between writers.
+// Returned map must not be modified.
+func stdLibMapping(goroot string) map[string]bool {
+	stdLib.mu.Lock()
+	defer stdLib.mu.Unlock()
+
+	if std, ok := stdLib.m[goroot]; ok {
+		return std
+	}
+	std := make(map[string]bool)
 
-	src := filepath.Join(w.Context.GOROOT, "src")
+	src := filepath.Join(goroot, "src")
 	files, err := os.ReadDir(src)
 	if err != nil {
 		panic(fmt.Sprintf("can't list GOROOT's src: %v", err))
@@ -290,9 +381,15 @@ func (w *Writer) ensureStdLibMapping() {
 			// Ignored.
 
 		default:
-			w.std[name] = true
+			std[name] = true
 		}
 	}
+	if stdLib.m == nil {
+		stdLib.m = make(map[string]map[string]bool)
+	}
+	stdLib.m[goroot] = std
+
+	return std
 }
 
 func (w *Writer) call(args []string) {
@@ -325,6 +422,58 @@ func (w *Writer) isZero(trace *Trace) {
 	})
 }
 
+func (w *Writer) isEmpty(trace *Trace) {
+	w.newScope(func() {
+		t := w.declare("t")
+		w.line(`// IsEmpty checks whether `, t, ` has no hooks. Nil `, t, ` is empty`)
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(`func (`, t, ` *`, trace.Name, `) IsEmpty() bool {`)
+		w.block(func() {
+			w.line(`if `, t, ` == nil {`)
+			w.block(func() {
+				w.line(`return true`)
+			})
+			w.line(`}`)
+			for _, hook := range trace.Hooks {
+				w.line(`if `, t, `.`, hook.Name, ` != nil {`)
+				w.block(func() {
+					w.line(`return false`)
+				})
+				w.line(`}`)
+			}
+			w.line(`return true`)
+		})
+		w.line(`}`)
+	})
+}
+
+func (w *Writer) definedHooks(trace *Trace) {
+	w.newScope(func() {
+		t := w.declare("t")
+		hooks := w.declare("hooks")
+		w.line(`// DefinedHooks returns names of hooks which are set in `, t)
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(`func (`, t, ` *`, trace.Name, `) DefinedHooks() []string {`)
+		w.block(func() {
+			w.line(`if `, t, ` == nil {`)
+			w.block(func() {
+				w.line(`return nil`)
+			})
+			w.line(`}`)
+			w.line(`var `, hooks, ` []string`)
+			for _, hook := range trace.Hooks {
+				w.line(`if `, t, `.`, hook.Name, ` != nil {`)
+				w.block(func() {
+					w.line(hooks, ` = append(`, hooks, `, "`, hook.Name, `")`)
+				})
+				w.line(`}`)
+			}
+			w.line(`return `, hooks)
+		})
+		w.line(`}`)
+	})
+}
+
 func (w *Writer) compose(trace *Trace) {
 	w.newScope(func() {
 		t := w.declare("t")
@@ -364,25 +513,62 @@ func (w *Writer) compose(trace *Trace) {
 	})
 }
 
+func (w *Writer) composeAll(trace *Trace) {
+	w.newScope(func() {
+		t := w.declare("t")
+		others := w.declare("others")
+		ret := w.declare("ret")
+		x := w.declare("x")
+		w.line(`// ComposeAll returns a new `, trace.Name, ` which has functional fields composed from `, t,
+			` and `, others, ` in order,`,
+		)
+		w.line(`// as a chain of Compose calls. Nil traces are skipped.`)
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(`func (`, t, ` *`, trace.Name, `) ComposeAll(`, others, ` ...*`, trace.Name, `) *`, trace.Name, ` {`)
+		w.block(func() {
+			w.line(ret, ` := `, t)
+			w.line(`for _, `, x, ` := range `, others, ` {`)
+			w.block(func() {
+				w.line(`if `, x, ` != nil {`)
+				w.block(func() {
+					w.line(ret, ` = `, ret, `.Compose(`, x, `)`)
+				})
+				w.line(`}`)
+			})
+			w.line(`}`)
+			w.line(`if `, ret, ` == nil {`)
+			w.block(func() {
+				w.line(`return &`, trace.Name, `{}`)
+			})
+			w.line(`}`)
+			w.line(`return `, ret)
+		})
+		w.line(`}`)
+	})
+}
+
 func (w *Writer) composeHook(hook Hook, t1, t2, dst string) {
 	w.line(`{`)
 	w.block(func() {
 		h1 := w.declare("h1")
 		h2 := w.declare("h2")
+		cb := w.declare("panicCallback")
 		w.line(h1, ` := `, t1, `.`, hook.Name)
 		w.line(h2, ` := `, t2, `.`, hook.Name)
+		w.line(cb, ` := options.hookPanicCallback(`, strconv.Quote(hook.Name), `)`)
 		w.code(dst, ` = `)
-		w.composeHookCall(hook.Func, h1, h2)
+		w.composeHookCall(hook.Func, h1, h2, cb)
+		w.line()
 	})
 	w.line(`}`)
 }
 
 //nolint:funlen
-func (w *Writer) composeHookCall(fn *Func, h1, h2 string) {
+func (w *Writer) composeHookCall(fn *Func, h1, h2, cb string) {
 	w.newScope(func() {
-		w.capture(h1, h2)
+		w.capture(h1, h2, cb)
 		w.block(func() {
-			w.capture(h1, h2)
+			w.capture(h1, h2, cb)
 			w.code(`func`)
 			args := w.funcParams(fn.Params)
 			if fn.HasResult() {
@@ -390,38 +576,39 @@ func (w *Writer) composeHookCall(fn *Func, h1, h2 string) {
 			}
 			w.funcResults(fn)
 			w.line(` {`)
-			w.line(`if options.panicCallback != nil {`)
+			w.line(`if `, cb, ` != nil {`)
 			w.block(func() {
 				w.line("defer func() {")
 				w.block(func() {
 					w.line("if e := recover(); e != nil {")
 					w.block(func() {
-						w.line(`options.panicCallback(e)`)
+						w.line(cb, `(e)`)
 					})
 					w.line("}")
 				})
 				w.line("}()")
 			})
 			w.line("}")
-			var (
-				r1 string
-				r2 string
-				rs []string
-			)
-			if fn.HasResult() {
-				r1 = w.declare("r")
-				r2 = w.declare("r")
-				rs = []string{r1, r2}
-				w.code("var " + r1 + ", " + r2 + " ")
-				w.funcResults(fn)
+			// NOTE: rs[i] holds i-th results of h1 and h2.
+			rs := make([][2]string, len(fn.Result))
+			for i, r := range fn.Result {
+				rs[i] = [2]string{w.declare("r"), w.declare("r")}
+				w.code("var " + rs[i][0] + ", " + rs[i][1] + " ")
+				w.resultFlags(fn, r, 0)
 				_ = w.bw.WriteByte('\n')
 				w.atEOL = true
 			}
 			for i, h := range []string{h1, h2} {
 				w.line("if " + h + " != nil {")
 				w.block(func() {
+					for j := range rs {
+						if j > 0 {
+							w.code(`, `)
+						}
+						w.code(rs[j][i]) //nolint:scopelint
+					}
 					if fn.HasResult() {
-						w.code(rs[i], ` = `) //nolint:scopelint
+						w.code(` = `)
 					}
 					w.code(h) //nolint:scopelint
 					w.call(args)
@@ -430,26 +617,75 @@ func (w *Writer) composeHookCall(fn *Func, h1, h2 string) {
 			}
 			if fn.HasResult() {
 				w.code(`return `)
-				switch x := fn.Result[0].(type) {
-				case *Func:
-					w.composeHookCall(x, r1, r2)
-				case *Trace:
-					w.line(r1, `.Compose(`, r2, `)`)
-				default:
-					panic("unknown result type")
+				for i, r := range fn.Result {
+					if i > 0 {
+						w.code(`, `)
+					}
+					switch x := r.(type) {
+					case *Func:
+						w.composeHookCall(x, rs[i][0], rs[i][1], cb)
+					case *Trace:
+						w.code(rs[i][0], `.Compose(`, rs[i][1], `)`)
+					default:
+						panic("unknown result type")
+					}
 				}
+				w.line()
 			}
 		})
-		w.line(`}`)
+		w.code(`}`)
 	})
 }
 
+// hasPanicPolicy reports whether package declares PanicPolicy type with helpers
+// of panic policy (like trace/compose.go of ydb-go-sdk)
+func (w *Writer) hasPanicPolicy() bool {
+	_, ok := w.pkg.Scope().Lookup("PanicPolicy").(*types.TypeName)
+
+	return ok
+}
+
+//nolint:funlen
 func (w *Writer) options(trace *Trace) {
+	hasPanicPolicy := w.hasPanicPolicy()
 	w.newScope(func() {
 		w.line(fmt.Sprintf(`// %sComposeOptions is a holder of options`, unexported(trace.Name)))
 		w.line(fmt.Sprintf(`type %sComposeOptions struct {`, unexported(trace.Name)))
 		w.block(func() {
-			w.line(`panicCallback func(e interface{})`)
+			w.line(`panicCallback      func(e interface{})`)
+			w.line(`hookPanicCallbacks map[string]func(e interface{})`)
+			if hasPanicPolicy {
+				w.line(`panicPolicy        PanicPolicy`)
+				w.line(`panicLogger        PanicLogger`)
+			}
+		})
+		w.line(`}`)
+		_ = w.bw.WriteByte('\n')
+	})
+	w.newScope(func() {
+		if hasPanicPolicy {
+			w.line(`// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback`)
+			w.line(`// of hook (or the common one) and handles panic according to panic policy`)
+		} else {
+			w.line(`// hookPanicCallback returns panic callback of hook with given name (or the common one)`)
+		}
+		w.line(fmt.Sprintf(`func (o *%sComposeOptions) hookPanicCallback(hook string) func(e interface{}) {`,
+			unexported(trace.Name)),
+		)
+		w.block(func() {
+			w.line(`cb, has := o.hookPanicCallbacks[hook]`)
+			w.line(`if !has {`)
+			w.block(func() {
+				w.line(`cb = o.panicCallback`)
+			})
+			w.line(`}`)
+			if hasPanicPolicy {
+				w.line(fmt.Sprintf(`return o.panicPolicy.hookPanicCallback(%s+hook, cb, o.panicLogger)`,
+					strconv.Quote(trace.Name+".")),
+				)
+			} else {
+				w.line(`return cb`)
+			}
 		})
 		w.line(`}`)
 		_ = w.bw.WriteByte('\n')
@@ -474,6 +710,199 @@ func (w *Writer) options(trace *Trace) {
 		w.line(`}`)
 		_ = w.bw.WriteByte('\n')
 	})
+	if hasPanicPolicy {
+		w.panicPolicyOptions(trace)
+	}
+	w.newScope(func() {
+		w.line(fmt.Sprintf(`// %sHooks is a set of names of %s hooks`, unexported(trace.Name), trace.Name))
+		w.line(fmt.Sprintf(`var %sHooks = map[string]struct{}{`, unexported(trace.Name)))
+		w.block(func() {
+			for _, hook := range trace.Hooks {
+				w.line(strconv.Quote(hook.Name), `: {},`)
+			}
+		})
+		w.line(`}`)
+		_ = w.bw.WriteByte('\n')
+	})
+	w.newScope(func() {
+		w.line(fmt.Sprintf(`// With%sHookPanicCallback specified behavior on panic in hook with given name`, trace.Name))
+		w.line(fmt.Sprintf(`// It overrides callback specified by With%sPanicCallback for this hook`, trace.Name))
+		w.line(`// Unknown hook name is rejected with panic on make of option`)
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(fmt.Sprintf(`func With%sHookPanicCallback(hook string, cb func(e interface{})) %sComposeOption {`,
+			trace.Name, trace.Name),
+		)
+		w.block(func() {
+			w.line(fmt.Sprintf(`if _, has := %sHooks[hook]; !has {`, unexported(trace.Name)))
+			w.block(func() {
+				w.line(fmt.Sprintf(`panic("unknown %s hook " + hook)`, trace.Name))
+			})
+			w.line(`}`)
+			_ = w.bw.WriteByte('\n')
+			w.atEOL = true
+			w.line(fmt.Sprintf(`return func(o *%sComposeOptions) {`, unexported(trace.Name)))
+			w.block(func() {
+				w.line(`if o.hookPanicCallbacks == nil {`)
+				w.block(func() {
+					w.line(`o.hookPanicCallbacks = make(map[string]func(e interface{}))`)
+				})
+				w.line(`}`)
+				w.line(`o.hookPanicCallbacks[hook] = cb`)
+			})
+			w.line(`}`)
+		})
+		w.line(`}`)
+		_ = w.bw.WriteByte('\n')
+	})
+}
+
+func (w *Writer) panicPolicyOptions(trace *Trace) {
+	w.newScope(func() {
+		w.line(fmt.Sprintf(`// With%sPanicPolicy specified handling of recovered panic after call of panic callback.`,
+			trace.Name),
+		)
+		w.line(`// Unknown panic policy is rejected with panic on make of option`)
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(fmt.Sprintf(`func With%sPanicPolicy(policy PanicPolicy) %sComposeOption {`, trace.Name, trace.Name))
+		w.block(func() {
+			w.line(`policy.mustBeKnown()`)
+			_ = w.bw.WriteByte('\n')
+			w.atEOL = true
+			w.line(fmt.Sprintf(`return func(o *%sComposeOptions) {`, unexported(trace.Name)))
+			w.block(func() {
+				w.line(`o.panicPolicy = policy`)
+			})
+			w.line(`}`)
+		})
+		w.line(`}`)
+		_ = w.bw.WriteByte('\n')
+	})
+	w.newScope(func() {
+		w.line(fmt.Sprintf(`// With%sPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy`,
+			trace.Name),
+		)
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(fmt.Sprintf(`func With%sPanicLogger(l PanicLogger) %sComposeOption {`, trace.Name, trace.Name))
+		w.block(func() {
+			w.line(fmt.Sprintf(`return func(o *%sComposeOptions) {`, unexported(trace.Name)))
+			w.block(func() {
+				w.line(`o.panicLogger = l`)
+			})
+			w.line(`}`)
+		})
+		w.line(`}`)
+		_ = w.bw.WriteByte('\n')
+	})
+}
+
+// events writes the table of stable names of trace hooks. Each hook is
+// identified by "TraceName.HookName" string.
+func (w *Writer) events(trace *Trace) {
+	if len(trace.Hooks) == 0 {
+		return
+	}
+	var (
+		names = make([]string, len(trace.Hooks))
+		width int
+	)
+	for i, hook := range trace.Hooks {
+		names[i] = exported(tempName(trace.Name, hook.Name, "Event"))
+		w.mustDeclare(names[i])
+		if len(names[i]) > width {
+			width = len(names[i])
+		}
+	}
+	all := exported(tempName(trace.Name, "Events"))
+	w.mustDeclare(all)
+
+	w.line(fmt.Sprintf(`// Names of %s hooks`, trace.Name))
+	w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+	w.line(`const (`)
+	w.block(func() {
+		for i, hook := range trace.Hooks {
+			pad := strings.Repeat(" ", width-len(names[i]))
+			w.line(names[i], pad, ` = `, strconv.Quote(trace.Name+"."+hook.Name))
+		}
+	})
+	w.line(`)`)
+	_ = w.bw.WriteByte('\n')
+
+	w.newScope(func() {
+		w.line(fmt.Sprintf(`// %s returns names of all %s hooks in declaration order`, all, trace.Name))
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(`func `, all, `() []string {`)
+		w.block(func() {
+			w.line(`return []string{`)
+			w.block(func() {
+				for _, name := range names {
+					w.line(name, `,`)
+				}
+			})
+			w.line(`}`)
+		})
+		w.line(`}`)
+	})
+}
+
+// hooksInterface writes interface with method per hook of trace and constructor of trace
+// which hooks are method values of interface implementation
+func (w *Writer) hooksInterface(trace *Trace) {
+	iface := exported(tempName(trace.Name, "Hooks"))
+	w.mustDeclare(iface)
+	constructor := exported(tempName(trace.Name, "FromHooks"))
+	w.mustDeclare(constructor)
+
+	w.line(fmt.Sprintf(`// %s is an interface of %s hooks with method per hook`, iface, trace.Name))
+	w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+	w.line(`type `, iface, ` interface {`)
+	w.block(func() {
+		for _, hook := range trace.Hooks {
+			w.newScope(func() {
+				w.code(hook.Name)
+				w.funcParams(hook.Func.Params)
+				if hook.Func.HasResult() {
+					w.code(` `)
+				}
+				w.funcResultsFlags(hook.Func, docs)
+				w.line()
+			})
+		}
+	})
+	w.line(`}`)
+	_ = w.bw.WriteByte('\n')
+
+	w.newScope(func() {
+		h := w.declare("h")
+		w.line(fmt.Sprintf(`// %s returns %s which forwards calls of hooks to methods of %s`, constructor, trace.Name, h))
+		w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+		w.line(`func `, constructor, `(`, h, ` `, iface, `) *`, trace.Name, ` {`)
+		w.block(func() {
+			w.line(`return &`, trace.Name, `{`)
+			w.block(func() {
+				for _, hook := range trace.Hooks {
+					w.line(hook.Name, `: `, h, `.`, hook.Name, `,`)
+				}
+			})
+			w.line(`}`)
+		})
+		w.line(`}`)
+	})
+}
+
+// toggle writes package-level variable which enables hooks of trace in shortcuts
+func (w *Writer) toggle(trace *Trace) {
+	name := toggleName(trace)
+	w.mustDeclare(name)
+
+	w.line(fmt.Sprintf(`// %s enables %s hooks called over shortcuts. Shortcuts return immediately`, name, trace.Name))
+	w.line(`// without calling of hooks when it is false. Must be set before start of tracing (for example in init)`)
+	w.line(`// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals`)
+	w.line(`var `, name, ` = true`)
+	_ = w.bw.WriteByte('\n')
+}
+
+func toggleName(trace *Trace) string {
+	return exported(tempName(trace.Name, "Enabled"))
 }
 
 func (w *Writer) hook(trace *Trace, hook Hook) {
@@ -512,6 +941,12 @@ func (w *Writer) hook(trace *Trace, hook Hook) {
 }
 
 func (w *Writer) hookFuncCall(fn *Func, name string, args []string) {
+	if len(fn.Result) > 1 {
+		w.hookFuncCallResults(fn, name, args)
+
+		return
+	}
+
 	var res string
 	if fn.HasResult() {
 		res = w.declare("res")
@@ -553,8 +988,69 @@ func (w *Writer) hookFuncCall(fn *Func, name string, args []string) {
 	w.line(`return `, res)
 }
 
+// hookFuncCallResults calls hook with multiple results. Nil callbacks returned
+// by hook are replaced with no-op ones.
+func (w *Writer) hookFuncCallResults(fn *Func, name string, args []string) {
+	res := make([]string, len(fn.Result))
+	for i := range fn.Result {
+		res[i] = w.declare("res")
+	}
+	w.code(strings.Join(res, ", "), ` := `, name)
+	w.call(args)
+
+	for i, r := range fn.Result {
+		if _, isFunc := r.(*Func); !isFunc {
+			continue
+		}
+		w.line(`if `, res[i], ` == nil {`)
+		w.block(func() {
+			w.code(res[i], ` = `)
+			w.zeroValue(r)
+			w.line()
+		})
+		w.line(`}`)
+	}
+
+	w.code(`return `)
+	for i, r := range fn.Result {
+		if i > 0 {
+			w.code(`, `)
+		}
+		x, isFunc := r.(*Func)
+		if !isFunc || !x.HasResult() {
+			w.code(res[i])
+
+			continue
+		}
+		w.newScope(func() {
+			w.capture(res[i])
+			w.code(`func`)
+			args := w.funcParams(x.Params)
+			w.code(` `)
+			w.funcResults(x)
+			w.line(` {`)
+			w.block(func() {
+				w.hookFuncCall(x, res[i], args)
+			})
+			w.code(`}`)
+		})
+	}
+	w.line()
+}
+
+// nameParam returns name of param variable. Params of context.Context type without descriptive
+// name (unnamed, single-letter or named as package context) are named ctx as in hand-written code
+// (declare deduplicates names of multiple context params). Descriptive names (such as txContext) are kept
 func nameParam(p *Param) (s string) {
+	if isContext(p.Type) && (len(p.Name) <= 1 || unexported(p.Name) == "context") {
+		return "ctx"
+	}
 	s = p.Name
+	if s == "" && isFunc(p.Type) {
+		// NOTE: basename of unnamed function type is made by types of its
+		// params and results, so callback is named independently of them.
+		return "f"
+	}
 	if s == "" {
 		s = firstChar(ident(typeBasename(p.Type)))
 	}
@@ -562,6 +1058,23 @@ func nameParam(p *Param) (s string) {
 	return unexported(s)
 }
 
+func isContext(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
+// isFunc reports whether t is unnamed function type such as func(error).
+func isFunc(t types.Type) bool {
+	_, ok := t.(*types.Signature)
+
+	return ok
+}
+
 func (w *Writer) declareParams(src []Param) (names []string) {
 	names = make([]string, len(src))
 	for i := range src {
@@ -573,6 +1086,13 @@ func (w *Writer) declareParams(src []Param) (names []string) {
 
 func flattenParams(params []Param) (dst []Param) {
 	for i := range params {
+		if params[i].Variadic {
+			dst = append(dst, params[i])
+
+			continue
+		}
+		// NOTE: function typed params (callbacks) are never flattened and
+		// passed to the hook as is.
 		_, s := unwrapStruct(params[i].Type)
 		if s != nil {
 			dst = flattenStruct(dst, s)
@@ -599,6 +1119,12 @@ func flattenStruct(dst []Param, s *types.Struct) []Param {
 		if !f.Exported() {
 			return
 		}
+		if _, es := embeddedStruct(f); es != nil {
+			// NOTE: fields of embedded structs are flattened recursively.
+			dst = flattenStruct(dst, es)
+
+			return
+		}
 		var (
 			name = f.Name()
 			typ  = f.Type()
@@ -629,6 +1155,9 @@ func (w *Writer) constructParams(params []Param, names []string) (res []string)
 		}
 		name := names[0]
 		names = names[1:]
+		if params[i].Variadic {
+			name += "..."
+		}
 		res = append(res, name)
 	}
 
@@ -644,6 +1173,13 @@ func (w *Writer) constructStruct(n types.Type, s *types.Struct, vars []string) (
 		if !v.Exported() {
 			continue
 		}
+		if en, es := embeddedStruct(v); es != nil {
+			var e string
+			e, vars = w.constructStruct(en, es, vars)
+			w.line(p, `.`, v.Name(), ` = `, e)
+
+			continue
+		}
 		name := vars[0]
 		vars = vars[1:]
 		w.line(p, `.`, v.Name(), ` = `, name)
@@ -671,7 +1207,7 @@ func (w *Writer) hookShortcut(trace *Trace, hook Hook) {
 		)
 		for i := range params {
 			w.code(`, `)
-			w.code(names[i], ` `, w.typeString(params[i].Type))
+			w.code(names[i], ` `, w.paramTypeString(&params[i]))
 		}
 		w.code(`)`)
 		if hook.Func.HasResult() {
@@ -683,29 +1219,21 @@ func (w *Writer) hookShortcut(trace *Trace, hook Hook) {
 			for _, name := range names {
 				w.capture(name)
 			}
-			vars := w.constructParams(hook.Func.Params, names)
-			var res string
-			if hook.Func.HasResult() {
-				res = w.declare("res")
-				w.code(res, ` := `)
+			if trace.Flag.Has(GenToggle) {
+				w.line(`if !`, toggleName(trace), ` {`)
+				w.block(func() {
+					w.shortcutZeroReturn(hook.Func)
+				})
+				w.line(`}`)
 			}
+			vars := w.constructParams(hook.Func.Params, names)
+			res := w.declareResults(hook.Func)
 			w.code(t, `.`, unexported(hook.Name))
 			if ctx != "" {
 				vars = append([]string{ctx}, vars...)
 			}
 			w.call(vars)
-			if hook.Func.HasResult() {
-				w.code(`return `)
-				r := hook.Func.Result[0]
-				switch x := r.(type) {
-				case *Func:
-					w.hookFuncShortcut(x, res)
-				case *Trace:
-					w.line(res)
-				default:
-					panic("unexpected result type")
-				}
-			}
+			w.returnShortcuts(hook.Func, res)
 		})
 		w.line(`}`)
 	})
@@ -722,7 +1250,7 @@ func (w *Writer) hookFuncShortcut(fn *Func, name string) {
 			if i > 0 {
 				w.code(`, `)
 			}
-			w.code(names[i], ` `, w.typeString(params[i].Type))
+			w.code(names[i], ` `, w.paramTypeString(&params[i]))
 		}
 		w.code(`)`)
 		if fn.HasResult() {
@@ -735,30 +1263,51 @@ func (w *Writer) hookFuncShortcut(fn *Func, name string) {
 				w.capture(name)
 			}
 			params := w.constructParams(fn.Params, names)
-			var res string
-			if fn.HasResult() {
-				res = w.declare("res")
-				w.code(res, ` := `)
-			}
+			res := w.declareResults(fn)
 			w.code(name)
 			w.call(params)
-			if fn.HasResult() {
-				r := fn.Result[0]
-				w.code(`return `)
-				switch x := r.(type) {
-				case *Func:
-					w.hookFuncShortcut(x, res)
-				case *Trace:
-					w.line(res)
-				default:
-					panic("unexpected result type")
-				}
-			}
+			w.returnShortcuts(fn, res)
 		})
-		w.line(`}`)
+		w.code(`}`)
 	})
 }
 
+// declareResults declares variables for results of fn and writes the left
+// side of assignment of them.
+func (w *Writer) declareResults(fn *Func) (res []string) {
+	if !fn.HasResult() {
+		return nil
+	}
+	res = make([]string, len(fn.Result))
+	for i := range fn.Result {
+		res[i] = w.declare("res")
+	}
+	w.code(strings.Join(res, ", "), ` := `)
+
+	return res
+}
+
+func (w *Writer) returnShortcuts(fn *Func, res []string) {
+	if !fn.HasResult() {
+		return
+	}
+	w.code(`return `)
+	for i, r := range fn.Result {
+		if i > 0 {
+			w.code(`, `)
+		}
+		switch x := r.(type) {
+		case *Func:
+			w.hookFuncShortcut(x, res[i])
+		case *Trace:
+			w.code(res[i])
+		default:
+			panic("unexpected result type")
+		}
+	}
+	w.line()
+}
+
 func (w *Writer) zeroReturn(fn *Func) {
 	if !fn.HasResult() {
 		w.line(`return`)
@@ -766,21 +1315,60 @@ func (w *Writer) zeroReturn(fn *Func) {
 		return
 	}
 	w.code(`return `)
-	switch x := fn.Result[0].(type) {
+	for i, r := range fn.Result {
+		if i > 0 {
+			w.code(`, `)
+		}
+		w.zeroValue(r)
+	}
+	w.line()
+}
+
+func (w *Writer) zeroValue(r FuncResult) {
+	switch x := r.(type) {
 	case *Func:
 		w.funcSignature(x)
 		w.line(` {`)
 		w.block(func() {
 			w.zeroReturn(x)
 		})
-		w.line(`}`)
+		w.code(`}`)
 	case *Trace:
-		w.line(x.Name, `{}`)
+		w.code(x.Name, `{}`)
 	default:
 		panic("unexpected result type")
 	}
 }
 
+// shortcutZeroReturn writes return of no-op results of shortcut of fn
+func (w *Writer) shortcutZeroReturn(fn *Func) {
+	if !fn.HasResult() {
+		w.line(`return`)
+
+		return
+	}
+	w.code(`return `)
+	for i, r := range fn.Result {
+		if i > 0 {
+			w.code(`, `)
+		}
+		switch x := r.(type) {
+		case *Func:
+			w.shortcutFuncSignFlags(x, 0)
+			w.line(` {`)
+			w.block(func() {
+				w.shortcutZeroReturn(x)
+			})
+			w.code(`}`)
+		case *Trace:
+			w.code(x.Name, `{}`)
+		default:
+			panic("unexpected result type")
+		}
+	}
+	w.line()
+}
+
 func (w *Writer) funcParams(params []Param) (vars []string) {
 	w.code(`(`)
 	for i := range params {
@@ -797,7 +1385,12 @@ func (w *Writer) funcParams(params []Param) (vars []string) {
 func (w *Writer) funcParam(p *Param) (name string) {
 	name = w.declare(nameParam(p))
 	w.code(name, ` `)
-	w.code(w.typeString(p.Type))
+	w.code(w.paramTypeString(p))
+
+	if p.Variadic {
+		// Variadic param must be expanded when passed to the hook.
+		return name + "..."
+	}
 
 	return name
 }
@@ -808,7 +1401,7 @@ func (w *Writer) funcParamSign(p *Param) {
 		name = "_"
 	}
 	w.code(name, ` `)
-	w.code(w.typeString(p.Type))
+	w.code(w.paramTypeString(p))
 }
 
 type flags uint8
@@ -822,17 +1415,48 @@ const (
 	docs
 )
 
-func (w *Writer) funcResultsFlags(fn *Func, flags flags) {
-	for _, r := range fn.Result {
-		switch x := r.(type) {
-		case *Func:
-			w.funcSignatureFlags(x, flags)
-		case *Trace:
-			w.code(x.Name, ` `)
-		default:
-			panic("unexpected result type")
+// results writes results of fn with result func. Multiple results are enclosed
+// in parentheses.
+func (w *Writer) results(fn *Func, result func(r FuncResult)) {
+	if len(fn.Result) < 2 { //nolint:gomnd
+		for _, r := range fn.Result {
+			result(r)
 		}
+
+		return
 	}
+	w.code(`(`)
+	for i, r := range fn.Result {
+		if i > 0 {
+			w.code(`, `)
+		}
+		result(r)
+	}
+	w.code(`)`)
+}
+
+func (w *Writer) traceResult(fn *Func, t *Trace) {
+	w.code(t.Name)
+	if len(fn.Result) == 1 {
+		w.code(` `)
+	}
+}
+
+func (w *Writer) resultFlags(fn *Func, r FuncResult, flags flags) {
+	switch x := r.(type) {
+	case *Func:
+		w.funcSignatureFlags(x, flags)
+	case *Trace:
+		w.traceResult(fn, x)
+	default:
+		panic("unexpected result type")
+	}
+}
+
+func (w *Writer) funcResultsFlags(fn *Func, flags flags) {
+	w.results(fn, func(r FuncResult) {
+		w.resultFlags(fn, r, flags)
+	})
 }
 
 func (w *Writer) funcResults(fn *Func) {
@@ -849,7 +1473,7 @@ func (w *Writer) funcSignatureFlags(fn *Func, flags flags) {
 		if flags.has(docs) && haveNames {
 			w.funcParamSign(&fn.Params[i])
 		} else {
-			w.code(w.typeString(fn.Params[i].Type))
+			w.code(w.paramTypeString(&fn.Params[i]))
 		}
 	}
 	w.code(`)`)
@@ -878,7 +1502,7 @@ func (w *Writer) shortcutFuncSignFlags(fn *Func, flags flags) {
 		if flags.has(docs) && haveNames {
 			w.funcParamSign(&params[i])
 		} else {
-			w.code(w.typeString(params[i].Type))
+			w.code(w.paramTypeString(&params[i]))
 		}
 	}
 	w.code(`)`)
@@ -891,16 +1515,16 @@ func (w *Writer) shortcutFuncSignFlags(fn *Func, flags flags) {
 }
 
 func (w *Writer) shortcutFuncResultsFlags(fn *Func, flags flags) {
-	for _, r := range fn.Result {
+	w.results(fn, func(r FuncResult) {
 		switch x := r.(type) {
 		case *Func:
 			w.shortcutFuncSignFlags(x, flags)
 		case *Trace:
-			w.code(x.Name, ` `)
+			w.traceResult(fn, x)
 		default:
 			panic("unexpected result type")
 		}
-	}
+	})
 }
 
 func (w *Writer) shortcutFuncResults(fn *Func) {
@@ -928,6 +1552,14 @@ func (w *Writer) typeString(t types.Type) string {
 	})
 }
 
+func (w *Writer) paramTypeString(p *Param) string {
+	if p.Variadic {
+		return "..." + w.typeString(p.elem())
+	}
+
+	return w.typeString(p.Type)
+}
+
 func (w *Writer) block(fn func()) {
 	w.depth++
 	w.newScope(fn)
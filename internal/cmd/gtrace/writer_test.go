@@ -0,0 +1,815 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// generateFixture writes src as trace.go into temporary package directory, generates
+// trace_gtrace.go next to it and checks that the whole package compiles.
+func generateFixture(t *testing.T, src string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trace.go"), []byte(src), 0o600))
+
+	p, err := loadPackage(build.Default, dir, "trace.go")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, (&Writer{
+		Context: build.Default,
+		Output:  &buf,
+	}).Write(p))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trace_gtrace.go"), buf.Bytes(), 0o600))
+
+	typeCheck(t, dir)
+
+	return buf.String()
+}
+
+func typeCheck(t *testing.T, dir string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	var (
+		fset  = token.NewFileSet()
+		files []*ast.File
+	)
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, 0)
+		require.NoError(t, err)
+		files = append(files, f)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+	}
+	_, err = conf.Check("fixture", fset, files, nil)
+	require.NoError(t, err)
+}
+
+func TestVariadicParams(t *testing.T) {
+	out := generateFixture(t, `package fixture
+
+import "context"
+
+type Option func()
+
+type VariadicStartInfo struct {
+	Context *context.Context
+	Name    string
+}
+
+// gtrace:gen
+type Trace struct {
+	OnCall   func(ctx context.Context, n int, opts ...Option) func(err error)
+	OnStruct func(info VariadicStartInfo, names ...string)
+	OnNested func(n int) func(opts ...Option) func(err error)
+}
+`)
+
+	require.Contains(t, out, "func (t *Trace) onCall(ctx context.Context, n int, opts ...Option) func(err error) {")
+	require.Contains(t, out, "res := fn(ctx, n, opts...)")
+	require.Contains(t, out, "func TraceOnCall(t *Trace, ctx context.Context, n int, opts ...Option) func(err error) {")
+	require.Contains(t, out, "res := t.onCall(ctx, n, opts...)")
+	require.Contains(t, out, "func TraceOnStruct(t *Trace, c *context.Context, name string, names ...string) {")
+	require.Contains(t, out, "t.onStruct(p, names...)")
+	require.Contains(t, out, "func TraceOnNested(t *Trace, n int) func(opts ...Option) func(err error) {")
+	require.Contains(t, out, "res := res(opts...)")
+}
+
+func TestEvents(t *testing.T) {
+	const src = `package fixture
+
+import "context"
+
+// gtrace:gen
+// gtrace:set events
+type Trace struct {
+	OnStart func(ctx context.Context) func(err error)
+	OnStop  func(ctx context.Context)
+	OnRetry func(attempt int)
+}
+
+// gtrace:gen
+type Other struct {
+	OnCall func()
+}
+`
+	events := func(out string) (lines []string) {
+		for _, line := range strings.Split(out, "\n") {
+			if strings.Contains(line, "Event ") && strings.Contains(line, " = ") {
+				lines = append(lines, strings.TrimSpace(line))
+			}
+		}
+
+		return lines
+	}
+
+	out := generateFixture(t, src)
+	require.Equal(t, []string{
+		`TraceOnStartEvent = "Trace.OnStart"`,
+		`TraceOnStopEvent  = "Trace.OnStop"`,
+		`TraceOnRetryEvent = "Trace.OnRetry"`,
+	}, events(out))
+	require.Contains(t, out, "func TraceEvents() []string {")
+	require.NotContains(t, out, "OtherEvents")
+	require.Equal(t, out, generateFixture(t, src))
+
+	renamed := generateFixture(t, strings.Replace(src, "OnStop ", "OnClose", 1))
+	require.Equal(t, []string{
+		`TraceOnStartEvent = "Trace.OnStart"`,
+		`TraceOnCloseEvent = "Trace.OnClose"`,
+		`TraceOnRetryEvent = "Trace.OnRetry"`,
+	}, events(renamed))
+}
+
+func TestUnknownGenFlag(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trace.go"), []byte(`package fixture
+
+// gtrace:gen
+// gtrace:set unknown
+type Trace struct {
+	OnCall func()
+}
+`), 0o600))
+
+	_, err := loadPackage(build.Default, dir, "trace.go")
+	require.ErrorContains(t, err, `unknown gtrace:set flag "unknown"`)
+}
+
+func TestPanicPolicyOptions(t *testing.T) {
+	out := generateFixture(t, `package fixture
+
+// gtrace:gen
+type Trace struct {
+	OnCall func()
+}
+`)
+	require.Contains(t, out, "func WithTracePanicCallback(cb func(e interface{})) TraceComposeOption {")
+	require.NotContains(t, out, "PanicPolicy")
+	require.NotContains(t, out, "PanicLogger")
+}
+
+func TestSchema(t *testing.T) {
+	p, err := loadPackage(build.Default, filepath.Join("testdata", "schema"), "trace.go")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, (&Writer{
+		Context: build.Default,
+		Output:  &buf,
+	}).WriteSchema(p))
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "schema.golden.json"))
+	require.NoError(t, err)
+	require.JSONEq(t, string(golden), buf.String())
+}
+
+func TestEmbeddedStructParams(t *testing.T) {
+	out := generateFixture(t, `package fixture
+
+import (
+	"context"
+	"time"
+)
+
+type Base struct {
+	Context *context.Context
+	ID      string
+}
+
+type Call struct {
+	Base
+	Latency time.Duration
+}
+
+type Nested struct {
+	Name string
+}
+
+type CallStartInfo struct {
+	Call
+	Nested  Nested
+	Attempt int
+	hidden  int
+}
+
+// gtrace:gen
+type Trace struct {
+	OnCall func(CallStartInfo)
+}
+`)
+
+	require.Contains(t, out,
+		"func TraceOnCall(t *Trace, c *context.Context, iD string, latency time.Duration, n Nested, attempt int) {",
+	)
+	require.Contains(t, out, "p.Call = p1")
+	require.Contains(t, out, "p1.Base = p2")
+	require.Contains(t, out, "p2.Context = c")
+	require.Contains(t, out, "p1.Latency = latency")
+	require.Contains(t, out, "p.Nested = n")
+}
+
+func TestCrossPackageTypeParams(t *testing.T) {
+	out := generateFixture(t, `package fixture
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+type ReadCloserInfo struct {
+	Closer io.Closer
+}
+
+// gtrace:gen
+type Trace struct {
+	OnRead      func(r io.Reader, info ReadCloserInfo) func(err error)
+	OnInterface func(c interface{ Conn() net.Conn }, s interface{ fmt.Stringer })
+	OnContainer func(m map[string]fmt.Stringer, ch chan<- time.Duration, a [2]io.Writer)
+	OnFunc      func(fn func(time.Time) net.Addr)
+}
+`)
+
+	for _, pkg := range []string{"fmt", "io", "net", "time"} {
+		require.Contains(t, out, "\t\""+pkg+"\"\n")
+	}
+	require.Contains(t, out, "func TraceOnInterface(t *Trace, c interface{Conn() net.Conn}, s interface{fmt.Stringer}) {")
+}
+
+func TestCallbackParams(t *testing.T) {
+	out := testFixture(t, `package fixture
+
+import (
+	"context"
+	"time"
+)
+
+type CallStartInfo struct {
+	Context *context.Context
+	OnError func(error)
+}
+
+// gtrace:gen
+type Trace struct {
+	OnCall    func(ctx context.Context, onError func(error)) func(err error)
+	OnUnnamed func(func(error), func(time.Time))
+	OnInfo    func(CallStartInfo)
+}
+`, `package fixture
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCallbackParams(t *testing.T) {
+	var (
+		errs  []string
+		ctx   = context.Background()
+		onErr = func(err error) { errs = append(errs, err.Error()) }
+	)
+	trace := &Trace{
+		OnCall: func(ctx context.Context, onError func(error)) func(err error) {
+			onError(errors.New("call"))
+
+			return func(err error) { onError(err) }
+		},
+		OnUnnamed: func(onError func(error), onTime func(time.Time)) {
+			onError(errors.New("unnamed"))
+			onTime(time.Time{})
+		},
+		OnInfo: func(info CallStartInfo) {
+			info.OnError(errors.New("info"))
+		},
+	}
+	TraceOnCall(trace, ctx, onErr)(errors.New("done"))
+	TraceOnUnnamed(trace, onErr, func(time.Time) { errs = append(errs, "time") })
+	TraceOnInfo(trace, &ctx, onErr)
+	if exp := []string{"call", "done", "unnamed", "time", "info"}; !reflect.DeepEqual(errs, exp) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	errs = nil
+	TraceOnCall(trace.Compose(trace), ctx, onErr)(errors.New("done"))
+	if exp := []string{"call", "call", "done", "done"}; !reflect.DeepEqual(errs, exp) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+`)
+	require.Contains(t, out,
+		"func TraceOnCall(t *Trace, ctx context.Context, onError func(error)) func(err error) {",
+	)
+	require.Contains(t, out, "func TraceOnUnnamed(t *Trace, f func(error), f1 func(time.Time)) {")
+	require.Contains(t, out, "func TraceOnInfo(t *Trace, c *context.Context, onError func(error)) {")
+	require.Contains(t, out, "p.OnError = onError")
+}
+
+func TestMultipleResults(t *testing.T) {
+	out := testFixture(t, `package fixture
+
+// gtrace:gen
+type Trace struct {
+	OnCall  func(n int) (onStep func(step int), onDone func(err error))
+	OnRetry func(attempt int) (func(step int) func(err error), func())
+}
+`, `package fixture
+
+import "testing"
+
+func TestMultipleResults(t *testing.T) {
+	var steps, done, retries int
+	trace := &Trace{
+		OnCall: func(n int) (func(step int), func(err error)) {
+			return func(step int) { steps += step }, func(err error) { done++ }
+		},
+		OnRetry: func(attempt int) (func(step int) func(err error), func()) {
+			return nil, func() { retries++ }
+		},
+	}
+
+	onStep, onDone := TraceOnCall(trace, 1)
+	onStep(1)
+	onStep(2)
+	if steps != 3 || done != 0 {
+		t.Fatalf("unexpected steps=%d, done=%d", steps, done)
+	}
+	onDone(nil)
+	if steps != 3 || done != 1 {
+		t.Fatalf("unexpected steps=%d, done=%d", steps, done)
+	}
+
+	onRetryStep, onRetryDone := TraceOnRetry(trace, 1)
+	onRetryStep(1)(nil)
+	onRetryDone()
+	if retries != 1 {
+		t.Fatalf("unexpected retries=%d", retries)
+	}
+
+	onStep, onDone = TraceOnCall(trace.Compose(trace), 1)
+	onStep(1)
+	onDone(nil)
+	if steps != 5 || done != 3 {
+		t.Fatalf("unexpected steps=%d, done=%d", steps, done)
+	}
+
+	onStep, onDone = TraceOnCall(&Trace{}, 1)
+	onStep(1)
+	onDone(nil)
+	onRetryStep, onRetryDone = TraceOnRetry(&Trace{}, 1)
+	onRetryStep(1)(nil)
+	onRetryDone()
+}
+`)
+	require.Contains(t, out, "func TraceOnCall(t *Trace, n int) (func(step int), func(err error)) {")
+	require.Contains(t, out, "res, res1 := fn(n)")
+}
+
+func TestErrorCallbackResults(t *testing.T) {
+	testFixture(t, `package fixture
+
+// gtrace:gen
+type Trace struct {
+	OnCall   func(StartInfo) func(error)
+	OnStream func(StartInfo) func(n int) func(error)
+	OnNested func(s StartInfo) func(n int) func(m int) func(err error)
+}
+
+type StartInfo struct {
+	Name string
+}
+`, `package fixture
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorCallbackResults(t *testing.T) {
+	var errs []error
+	onErr := func(err error) { errs = append(errs, err) }
+	trace := &Trace{
+		OnCall: func(StartInfo) func(error) {
+			return onErr
+		},
+		OnStream: func(StartInfo) func(n int) func(error) {
+			return func(n int) func(error) {
+				return onErr
+			}
+		},
+		OnNested: func(StartInfo) func(n int) func(m int) func(error) {
+			return func(n int) func(m int) func(error) {
+				return func(m int) func(error) {
+					return onErr
+				}
+			}
+		},
+	}
+	errTest := errors.New("test")
+	for _, tt := range []*Trace{trace, trace.Compose(&Trace{}), (&Trace{}).Compose(trace)} {
+		errs = errs[:0]
+		TraceOnCall(tt, "name")(errTest)
+		TraceOnStream(tt, "name")(1)(errTest)
+		TraceOnNested(tt, "name")(1)(2)(errTest)
+		if len(errs) != 3 {
+			t.Fatalf("unexpected errs: %v", errs)
+		}
+	}
+
+	// nil hooks and nil callbacks on each level of nesting are replaced with no-op callbacks
+	for _, tt := range []*Trace{
+		{},
+		(&Trace{}).Compose(&Trace{}),
+		{
+			OnCall: func(StartInfo) func(error) {
+				return nil
+			},
+			OnStream: func(StartInfo) func(n int) func(error) {
+				return nil
+			},
+			OnNested: func(StartInfo) func(n int) func(m int) func(error) {
+				return nil
+			},
+		},
+		{
+			OnStream: func(StartInfo) func(n int) func(error) {
+				return func(n int) func(error) {
+					return nil
+				}
+			},
+			OnNested: func(StartInfo) func(n int) func(m int) func(error) {
+				return func(n int) func(m int) func(error) {
+					return nil
+				}
+			},
+		},
+		{
+			OnNested: func(StartInfo) func(n int) func(m int) func(error) {
+				return func(n int) func(m int) func(error) {
+					return func(m int) func(error) {
+						return nil
+					}
+				}
+			},
+		},
+	} {
+		TraceOnCall(tt, "name")(errTest)
+		TraceOnStream(tt, "name")(1)(errTest)
+		TraceOnNested(tt, "name")(1)(2)(errTest)
+		composed := tt.Compose(tt)
+		TraceOnCall(composed, "name")(errTest)
+		TraceOnStream(composed, "name")(1)(errTest)
+		TraceOnNested(composed, "name")(1)(2)(errTest)
+	}
+}
+`)
+}
+
+func TestComposeAll(t *testing.T) {
+	out := testFixture(t, `package fixture
+
+// gtrace:gen
+type Trace struct {
+	OnCall func(n int) func(err error)
+	OnStop func()
+}
+`, `package fixture
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComposeAll(t *testing.T) {
+	var calls []string
+	partial := func(name string) *Trace {
+		return &Trace{
+			OnCall: func(n int) func(err error) {
+				calls = append(calls, name+".start")
+
+				return func(err error) {
+					calls = append(calls, name+".done")
+				}
+			},
+		}
+	}
+
+	trace := partial("a").ComposeAll(nil, partial("b"), nil, partial("c"))
+	TraceOnCall(trace, 1)(nil)
+	TraceOnStop(trace)
+	if exp := []string{
+		"a.start", "b.start", "c.start", "a.done", "b.done", "c.done",
+	}; !reflect.DeepEqual(calls, exp) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+
+	calls = calls[:0]
+	others := []*Trace{partial("a"), partial("b")}
+	trace = (*Trace)(nil).ComposeAll(others...)
+	TraceOnCall(trace, 1)(nil)
+	if exp := []string{"a.start", "b.start", "a.done", "b.done"}; !reflect.DeepEqual(calls, exp) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+
+	TraceOnCall((*Trace)(nil).ComposeAll(), 1)(nil)
+
+	var panics []interface{}
+	trace = (&Trace{}).Compose(partial("a").ComposeAll(&Trace{
+		OnStop: func() {
+			panic("stop")
+		},
+	}, partial("b")), WithTracePanicCallback(func(e interface{}) {
+		panics = append(panics, e)
+	}))
+	TraceOnStop(trace)
+	if len(panics) != 1 || panics[0] != "stop" {
+		t.Fatalf("unexpected panics: %v", panics)
+	}
+}
+`)
+	require.Contains(t, out, "func (t *Trace) ComposeAll(others ...*Trace) *Trace {")
+}
+
+func TestContextParamNames(t *testing.T) {
+	const src = `package fixture
+
+import "context"
+
+type CallStartInfo struct {
+	Context       *context.Context
+	ParentContext context.Context
+	Context2      context.Context
+}
+
+type StreamInfo struct {
+	Context context.Context
+	Name    string
+}
+
+// gtrace:gen
+type Trace struct {
+	OnCall   func(context.Context, context.Context, int) func(error)
+	OnNamed  func(c context.Context, parent context.Context)
+	OnInfo   func(CallStartInfo)
+	OnStream func(StreamInfo) func(ctx context.Context)
+}
+`
+	out := generateFixture(t, src)
+
+	require.Contains(t, out, "func (t *Trace) onCall(ctx context.Context, ctx1 context.Context, i int) func(error) {")
+	require.Contains(t, out, "res := fn(ctx, ctx1, i)")
+	require.Contains(t, out, "func TraceOnCall(t *Trace, ctx context.Context, ctx1 context.Context, i int) func(error) {")
+	require.Contains(t, out, "func TraceOnNamed(t *Trace, ctx context.Context, parent context.Context) {")
+	require.Contains(t, out,
+		"func TraceOnInfo(t *Trace, c *context.Context, parentContext context.Context, context2 context.Context) {",
+	)
+	require.Contains(t, out, "func TraceOnStream(t *Trace, ctx context.Context, name string) func(ctx context.Context) {")
+	require.Equal(t, out, generateFixture(t, src))
+}
+
+func TestHooksInterface(t *testing.T) {
+	out := testFixture(t, `package fixture
+
+import "context"
+
+type CallStartInfo struct {
+	Context *context.Context
+	Name    string
+}
+
+// gtrace:gen
+// gtrace:set interface
+type Trace struct {
+	OnCall  func(CallStartInfo) func(err error)
+	OnRetry func(ctx context.Context, attempt int, opts ...string)
+}
+
+// gtrace:gen
+type Other struct {
+	OnCall func()
+}
+`, `package fixture
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type mockTrace struct {
+	calls []string
+}
+
+func (m *mockTrace) OnCall(info CallStartInfo) func(err error) {
+	m.calls = append(m.calls, "call "+info.Name)
+
+	return func(err error) {
+		m.calls = append(m.calls, "done "+err.Error())
+	}
+}
+
+func (m *mockTrace) OnRetry(ctx context.Context, attempt int, opts ...string) {
+	m.calls = append(m.calls, "retry", opts[attempt])
+}
+
+func TestHooksInterface(t *testing.T) {
+	var (
+		m     mockTrace
+		ctx   = context.Background()
+		trace = TraceFromHooks(&m)
+	)
+	TraceOnCall(trace, &ctx, "test")(errors.New("error"))
+	TraceOnRetry(trace, ctx, 1, "a", "b")
+	if exp := []string{"call test", "done error", "retry", "b"}; !reflect.DeepEqual(m.calls, exp) {
+		t.Fatalf("unexpected calls: %v", m.calls)
+	}
+}
+`)
+	require.Contains(t, out, "type TraceHooks interface {\n"+
+		"\tOnCall(c CallStartInfo) func(err error)\n"+
+		"\tOnRetry(ctx context.Context, attempt int, opts ...string)\n"+
+		"}\n",
+	)
+	require.Contains(t, out, "func TraceFromHooks(h TraceHooks) *Trace {")
+	require.NotContains(t, out, "OtherHooks")
+}
+
+// testFixture generates src as fixture module and runs testSrc as tests of generated code.
+// It returns generated code
+func testFixture(t *testing.T, src, testSrc string) string {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("runs go test on generated fixture")
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module fixture\n\ngo 1.21\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trace.go"), []byte(src), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trace_test.go"), []byte(testSrc), 0o600))
+
+	p, err := loadPackage(build.Default, dir, "trace.go")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, (&Writer{
+		Context: build.Default,
+		Output:  &buf,
+	}).Write(p))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trace_gtrace.go"), buf.Bytes(), 0o600))
+
+	cmd := exec.Command("go", "test", "-count=1", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	return buf.String()
+}
+
+func TestToggle(t *testing.T) {
+	out := testFixture(t, `package fixture
+
+import "context"
+
+// gtrace:gen
+// gtrace:set toggle
+type Trace struct {
+	OnCall  func(ctx context.Context) func(n int) func(err error)
+	OnRetry func(attempt int)
+}
+
+// gtrace:gen
+type Other struct {
+	OnCall func()
+}
+`, `package fixture
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestToggle(t *testing.T) {
+	var calls []string
+	trace := &Trace{
+		OnCall: func(context.Context) func(int) func(error) {
+			calls = append(calls, "call")
+
+			return func(int) func(error) {
+				calls = append(calls, "intermediate")
+
+				return func(error) {
+					calls = append(calls, "done")
+				}
+			}
+		},
+		OnRetry: func(int) {
+			calls = append(calls, "retry")
+		},
+	}
+	run := func() {
+		TraceOnCall(trace, context.Background())(1)(errors.New("error"))
+		TraceOnRetry(trace, 1)
+	}
+
+	run()
+	if exp := []string{"call", "intermediate", "done", "retry"}; !reflect.DeepEqual(calls, exp) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+
+	calls = nil
+	TraceEnabled = false
+	defer func() {
+		TraceEnabled = true
+	}()
+	run()
+	if len(calls) != 0 {
+		t.Fatalf("hooks must be skipped: %v", calls)
+	}
+}
+`)
+
+	require.Contains(t, out, "var TraceEnabled = true\n")
+	require.NotContains(t, out, "OtherEnabled")
+}
+
+func TestIsEmpty(t *testing.T) {
+	out := testFixture(t, `package fixture
+
+import "context"
+
+// gtrace:gen
+type Trace struct {
+	OnCall  func(ctx context.Context) func(err error)
+	OnRetry func(attempt int)
+}
+`, `package fixture
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestIsEmpty(t *testing.T) {
+	var nilTrace *Trace
+	if !nilTrace.IsEmpty() || nilTrace.DefinedHooks() != nil {
+		t.Fatal("nil trace must be empty")
+	}
+
+	trace := &Trace{}
+	if !trace.IsEmpty() {
+		t.Fatal("zero trace must be empty")
+	}
+	if hooks := trace.DefinedHooks(); len(hooks) != 0 {
+		t.Fatalf("unexpected hooks of zero trace: %v", hooks)
+	}
+
+	trace.OnRetry = func(int) {}
+	if trace.IsEmpty() {
+		t.Fatal("trace with hook must not be empty")
+	}
+	if hooks := trace.DefinedHooks(); !reflect.DeepEqual(hooks, []string{"OnRetry"}) {
+		t.Fatalf("unexpected hooks: %v", hooks)
+	}
+
+	trace.OnCall = func(context.Context) func(error) { return nil }
+	if hooks := trace.DefinedHooks(); !reflect.DeepEqual(hooks, []string{"OnCall", "OnRetry"}) {
+		t.Fatalf("unexpected hooks: %v", hooks)
+	}
+}
+`)
+
+	require.Contains(t, out, "func (t *Trace) IsEmpty() bool {")
+	require.Contains(t, out, "func (t *Trace) DefinedHooks() []string {")
+}
@@ -1,6 +1,14 @@
 package decimal
 
-import "math/big"
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var _ sql.Scanner = (*Decimal)(nil)
 
 type Decimal struct {
 	Bytes     [16]byte
@@ -8,6 +16,13 @@ type Decimal struct {
 	Scale     uint32
 }
 
+// valuer is a decimal value received from database (same as value.DecimalValuer)
+type valuer interface {
+	Value() [16]byte
+	Precision() uint32
+	Scale() uint32
+}
+
 func (d *Decimal) String() string {
 	v := FromInt128(d.Bytes, d.Precision, d.Scale)
 
@@ -17,3 +32,27 @@ func (d *Decimal) String() string {
 func (d *Decimal) BigInt() *big.Int {
 	return FromInt128(d.Bytes, d.Precision, d.Scale)
 }
+
+// Scan implements sql.Scanner for scan YDB Decimal values with database/sql.
+// Precision and scale of decimal are taken from source value
+func (d *Decimal) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case Decimal:
+		*d = v
+	case *Decimal:
+		if v == nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot scan nil %T into %T", src, d))
+		}
+		*d = *v
+	case valuer:
+		*d = Decimal{
+			Bytes:     v.Value(),
+			Precision: v.Precision(),
+			Scale:     v.Scale(),
+		}
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("cannot scan %T into %T", src, d))
+	}
+
+	return nil
+}
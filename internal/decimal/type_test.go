@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testValuer struct {
+	d Decimal
+}
+
+func (v testValuer) Value() [16]byte {
+	return v.d.Bytes
+}
+
+func (v testValuer) Precision() uint32 {
+	return v.d.Precision
+}
+
+func (v testValuer) Scale() uint32 {
+	return v.d.Scale
+}
+
+func TestDecimalScan(t *testing.T) {
+	x, err := Parse("-1234567890123456789012345.6789012345", 35, 10)
+	require.NoError(t, err)
+	expected := Decimal{
+		Bytes:     BigIntToByte(x, 35, 10),
+		Precision: 35,
+		Scale:     10,
+	}
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+	}{
+		{
+			name: "Decimal",
+			src:  expected,
+		},
+		{
+			name: "*Decimal",
+			src:  &expected,
+		},
+		{
+			name: "DecimalValuer",
+			src:  testValuer{d: expected},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			require.NoError(t, d.Scan(tt.src))
+			require.Equal(t, expected, d)
+			require.Equal(t, "-1234567890123456789012345.6789012345", d.String())
+		})
+	}
+	t.Run("Unsupported", func(t *testing.T) {
+		var d Decimal
+		require.Error(t, d.Scan(nil))
+		require.Error(t, d.Scan((*Decimal)(nil)))
+		require.Error(t, d.Scan("1.0"))
+	})
+}
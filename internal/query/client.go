@@ -268,6 +268,14 @@ func doTx(
 	txSettings tx.Settings,
 	opts ...retry.Option,
 ) (finalErr error) {
+	if outer, has := outerTxFromContext(ctx, pool); has {
+		return joinTx(ctx, outer, op, txSettings)
+	}
+
+	if txSettings == nil {
+		txSettings = tx.NewSettings(tx.WithDefaultTxMode())
+	}
+
 	err := do(ctx, pool, func(ctx context.Context, s *Session) (opErr error) {
 		tx, err := s.Begin(ctx, txSettings)
 		if err != nil {
@@ -282,7 +290,9 @@ func doTx(
 			}
 		}()
 
-		err = op(ctx, tx)
+		txCtx, done := withOuterTx(ctx, pool, tx, txSettings)
+		err = op(txCtx, tx)
+		done()
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
@@ -301,6 +311,21 @@ func doTx(
 	return nil
 }
 
+// joinTx runs op within outer transaction instead of beginning a new one.
+// Commit and rollback of the outer transaction are left to the outermost doTx.
+func joinTx(ctx context.Context, outer *outerTx, op query.TxOperation, txSettings tx.Settings) error {
+	if txSettings != nil && txSettings.IsolationStrength() > outer.txSettings.IsolationStrength() {
+		return xerrors.WithStackTrace(query.ErrNestedTxIsolation)
+	}
+
+	err := op(ctx, outer.tx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
 func clientQueryRow(
 	ctx context.Context, pool sessionPool, q string, settings executeSettings, resultOpts ...resultOption,
 ) (row query.Row, finalErr error) {
@@ -540,6 +565,7 @@ func CreateSession(ctx context.Context, c *Client) (*Session, error) {
 		}
 
 		s.laztTx = c.config.LazyTx()
+		s.retryBudget = c.config.RetryBudget()
 
 		return s, nil
 	})
@@ -591,6 +617,7 @@ func New(ctx context.Context, cc grpc.ClientConnInterface, cfg *config.Config) *
 				}
 
 				s.laztTx = cfg.LazyTx()
+				s.retryBudget = cfg.RetryBudget()
 
 				return s, nil
 			}),
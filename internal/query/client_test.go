@@ -171,6 +171,30 @@ func TestClient(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, 10, counter)
 		})
+		t.Run("RetryTrace", func(t *testing.T) {
+			var (
+				counter  int
+				attempts []int
+			)
+			err := do(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+				return newTestSession("123"), nil
+			}), func(ctx context.Context, s *Session) error {
+				counter++
+				if counter < 3 {
+					return xerrors.Retryable(errors.New(""))
+				}
+
+				return nil
+			}, options.WithRetryTrace(&trace.Retry{
+				OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+					return func(info trace.RetryLoopDoneInfo) {
+						attempts = append(attempts, info.Attempts)
+					}
+				},
+			})...)
+			require.NoError(t, err)
+			require.Equal(t, []int{3}, attempts)
+		})
 	})
 	t.Run("DoTx", func(t *testing.T) {
 		t.Run("HappyWay", func(t *testing.T) {
@@ -673,6 +697,163 @@ func TestClient(t *testing.T) {
 				})
 			})
 		})
+		t.Run("Nested", func(t *testing.T) {
+			t.Run("JoinOuterTx", func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				client := NewMockQueryServiceClient(ctrl)
+				client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+					TxMeta: &Ydb_Query.TransactionMeta{Id: "456"},
+				}, nil).Times(1)
+				client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil).Times(1)
+				p := testPool(ctx, func(ctx context.Context) (*Session, error) {
+					return newTestSessionWithClient("123", client, false), nil
+				})
+				var innerTxID string
+				err := doTx(ctx, p, func(ctx context.Context, outer query.TxActor) error {
+					return doTx(ctx, p, func(ctx context.Context, inner query.TxActor) error {
+						innerTxID = inner.ID()
+
+						return nil
+					}, nil)
+				}, tx.NewSettings(tx.WithSerializableReadWrite()))
+				require.NoError(t, err)
+				require.Equal(t, "456", innerTxID)
+			})
+			t.Run("CompletedOuterTx", func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				client := NewMockQueryServiceClient(ctrl)
+				txIDs := []string{"456", "789"}
+				client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(context.Context, *Ydb_Query.BeginTransactionRequest, ...grpc.CallOption) (
+						*Ydb_Query.BeginTransactionResponse, error,
+					) {
+						txID := txIDs[0]
+						txIDs = txIDs[1:]
+
+						return &Ydb_Query.BeginTransactionResponse{
+							Status: Ydb.StatusIds_SUCCESS,
+							TxMeta: &Ydb_Query.TransactionMeta{Id: txID},
+						}, nil
+					}).Times(2)
+				client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil).Times(2)
+				p := testPool(ctx, func(ctx context.Context) (*Session, error) {
+					return newTestSessionWithClient("123", client, false), nil
+				})
+				// context of operation outlives outer doTx
+				var outerCtx context.Context
+				err := doTx(ctx, p, func(ctx context.Context, outer query.TxActor) error {
+					outerCtx = ctx
+
+					return nil
+				}, tx.NewSettings(tx.WithSerializableReadWrite()))
+				require.NoError(t, err)
+				var txID string
+				err = doTx(outerCtx, p, func(ctx context.Context, tx query.TxActor) error {
+					txID = tx.ID()
+
+					return nil
+				}, nil)
+				require.NoError(t, err)
+				require.Equal(t, "789", txID)
+			})
+			t.Run("AnotherClient", func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				newPool := func(txID string) *pool.Pool[*Session, Session] {
+					client := NewMockQueryServiceClient(ctrl)
+					client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+						Status: Ydb.StatusIds_SUCCESS,
+						TxMeta: &Ydb_Query.TransactionMeta{Id: txID},
+					}, nil).Times(1)
+					client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+						Status: Ydb.StatusIds_SUCCESS,
+					}, nil).Times(1)
+
+					return testPool(ctx, func(ctx context.Context) (*Session, error) {
+						return newTestSessionWithClient("123", client, false), nil
+					})
+				}
+				p1, p2 := newPool("456"), newPool("789")
+				var innerTxID string
+				err := doTx(ctx, p1, func(ctx context.Context, outer query.TxActor) error {
+					return doTx(ctx, p2, func(ctx context.Context, inner query.TxActor) error {
+						innerTxID = inner.ID()
+
+						return nil
+					}, nil)
+				}, tx.NewSettings(tx.WithSerializableReadWrite()))
+				require.NoError(t, err)
+				require.Equal(t, "789", innerTxID)
+			})
+			t.Run("WeakerIsolation", func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				client := NewMockQueryServiceClient(ctrl)
+				client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+					TxMeta: &Ydb_Query.TransactionMeta{Id: "456"},
+				}, nil).Times(1)
+				client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil).Times(1)
+				p := testPool(ctx, func(ctx context.Context) (*Session, error) {
+					return newTestSessionWithClient("123", client, false), nil
+				})
+				err := doTx(ctx, p, func(ctx context.Context, outer query.TxActor) error {
+					return doTx(ctx, p, func(ctx context.Context, inner query.TxActor) error {
+						return nil
+					}, tx.NewSettings(tx.WithSnapshotReadOnly()))
+				}, tx.NewSettings(tx.WithSerializableReadWrite()))
+				require.NoError(t, err)
+			})
+			t.Run("OnlineOverStale", func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				client := NewMockQueryServiceClient(ctrl)
+				client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+					TxMeta: &Ydb_Query.TransactionMeta{Id: "456"},
+				}, nil).Times(1)
+				client.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.RollbackTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil).Times(1)
+				p := testPool(ctx, func(ctx context.Context) (*Session, error) {
+					return newTestSessionWithClient("123", client, false), nil
+				})
+				err := doTx(ctx, p, func(ctx context.Context, outer query.TxActor) error {
+					return doTx(ctx, p, func(ctx context.Context, inner query.TxActor) error {
+						return nil
+					}, tx.NewSettings(tx.WithOnlineReadOnly()))
+				}, tx.NewSettings(tx.WithStaleReadOnly()))
+				require.ErrorIs(t, err, query.ErrNestedTxIsolation)
+			})
+			t.Run("StrongerIsolation", func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				client := NewMockQueryServiceClient(ctrl)
+				client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+					TxMeta: &Ydb_Query.TransactionMeta{Id: "456"},
+				}, nil).Times(1)
+				client.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.RollbackTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil).Times(1)
+				p := testPool(ctx, func(ctx context.Context) (*Session, error) {
+					return newTestSessionWithClient("123", client, false), nil
+				})
+				var visited bool
+				err := doTx(ctx, p, func(ctx context.Context, outer query.TxActor) error {
+					return doTx(ctx, p, func(ctx context.Context, inner query.TxActor) error {
+						visited = true
+
+						return nil
+					}, tx.NewSettings(tx.WithSerializableReadWrite()))
+				}, tx.NewSettings(tx.WithSnapshotReadOnly()))
+				require.ErrorIs(t, err, query.ErrNestedTxIsolation)
+				require.False(t, visited)
+			})
+		})
 	})
 	t.Run("Exec", func(t *testing.T) {
 		t.Run("HappyWay", func(t *testing.T) {
@@ -17,4 +17,7 @@ var (
 	errNilOption               = errors.New("nil option")
 	ErrOptionNotForTxExecute   = errors.New("option is not for execute on transaction")
 	errExecuteOnCompletedTx    = errors.New("execute on completed transaction")
+	errNoRowsAffected          = errors.New("rows affected is not reported by server")
+	errUnknownRenamedColumn    = errors.New("renamed column not found in result set")
+	errInconsistentReads       = errors.New("inconsistent reads allowed only for online read-only transaction control")
 )
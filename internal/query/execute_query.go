@@ -32,6 +32,10 @@ type executeSettings interface {
 	RetryOpts() []retry.Option
 	ResourcePool() string
 	ResponsePartLimitSizeBytes() int64
+	InconsistentReads() bool
+	RowMode() options.RowMode
+	ColumnRename() map[string]string
+	Err() error
 }
 
 type executeScriptConfig interface {
@@ -75,6 +79,10 @@ func executeQueryRequest(a *allocator.Allocator, sessionID, q string, cfg execut
 	[]grpc.CallOption,
 	error,
 ) {
+	if err := cfg.Err(); err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
 	params, err := cfg.Params().ToYDB(a)
 	if err != nil {
 		return nil, nil, xerrors.WithStackTrace(err)
@@ -85,6 +93,11 @@ func executeQueryRequest(a *allocator.Allocator, sessionID, q string, cfg execut
 	request.SessionId = sessionID
 	request.ExecMode = Ydb_Query.ExecMode(cfg.ExecMode())
 	request.TxControl = cfg.TxControl().ToYDB(a)
+	if cfg.InconsistentReads() {
+		if err := allowInconsistentReads(request.GetTxControl()); err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+	}
 	request.Query = queryFromText(a, q, Ydb_Query.Syntax(cfg.Syntax()))
 	request.Parameters = params
 	request.StatsMode = Ydb_Query.StatsMode(cfg.StatsMode())
@@ -95,6 +108,22 @@ func executeQueryRequest(a *allocator.Allocator, sessionID, q string, cfg execut
 	return request, cfg.CallOptions(), nil
 }
 
+// allowInconsistentReads overrides inconsistent reads setting of online read-only transaction
+// which begins with the statement
+func allowInconsistentReads(txControl *Ydb_Query.TransactionControl) error {
+	beginTx := txControl.GetBeginTx()
+	if _, ok := beginTx.GetTxMode().(*Ydb_Query.TransactionSettings_OnlineReadOnly); !ok {
+		return xerrors.WithStackTrace(errInconsistentReads)
+	}
+
+	// NOTE: tx mode values may be shared between requests, so replace it instead of modification
+	beginTx.TxMode = &Ydb_Query.TransactionSettings_OnlineReadOnly{
+		OnlineReadOnly: &Ydb_Query.OnlineModeSettings{AllowInconsistentReads: true},
+	}
+
+	return nil
+}
+
 func queryQueryContent(a *allocator.Allocator, syntax Ydb_Query.Syntax, q string) *Ydb_Query.QueryContent {
 	content := a.QueryQueryContent()
 	content.Syntax = syntax
@@ -126,14 +155,29 @@ func execute(
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	executeCtx := xcontext.ValueOnly(ctx)
+	// stream context cancelled on ctx.Done() for abort query on server side
+	executeCtx, cancel := xcontext.WithCancel(xcontext.ValueOnly(ctx))
+	stop := context.AfterFunc(ctx, cancel)
+	defer func() {
+		if finalErr != nil {
+			stop()
+			cancel()
+		}
+	}()
 
 	stream, err := c.ExecuteQuery(executeCtx, request, callOptions...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	r, err := newResult(ctx, stream, append(opts, withStatsCallback(settings.StatsCallback()))...)
+	r, err := newResult(ctx, stream, append(opts,
+		withStatsCallback(settings.StatsCallback()),
+		withColumnRename(settings.ColumnRename()),
+		withStreamCancel(ctx, func() {
+			stop()
+			cancel()
+		}),
+	)...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -206,19 +250,19 @@ func readMaterializedResultSet(ctx context.Context, r *streamResult) (_ *materia
 	return MaterializedResultSet(rs.Index(), rs.Columns(), rs.ColumnTypes(), rows), nil
 }
 
-func readRow(ctx context.Context, r *streamResult) (_ *Row, finalErr error) {
+func readRow(ctx context.Context, r *streamResult, mode options.RowMode) (_ *Row, finalErr error) {
 	defer func() {
 		_ = r.Close(ctx)
 	}()
 
 	rs, err := r.nextResultSet(ctx)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, noRow(err, mode)
 	}
 
 	row, err := rs.nextRow(ctx)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, noRow(err, mode)
 	}
 
 	_, err = rs.nextRow(ctx)
@@ -239,3 +283,19 @@ func readRow(ctx context.Context, r *streamResult) (_ *Row, finalErr error) {
 
 	return row, nil
 }
+
+// noRow maps io.EOF error on empty result to error of row mode
+func noRow(err error, mode options.RowMode) error {
+	if !xerrors.Is(err, io.EOF) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	switch mode {
+	case options.RowModeOptional:
+		return xerrors.WithStackTrace(query.ErrNoRow)
+	case options.RowModeMustExist:
+		return xerrors.WithStackTrace(query.NoRowError{})
+	default:
+		return xerrors.WithStackTrace(err)
+	}
+}
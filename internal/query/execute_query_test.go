@@ -4,8 +4,10 @@ import (
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"go.uber.org/mock/gomock"
@@ -20,6 +22,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 )
 
 func TestExecute(t *testing.T) {
@@ -758,6 +761,34 @@ func TestExecute(t *testing.T) {
 			}
 		})
 	})
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(xtest.Context(t), 10*time.Millisecond)
+		defer cancel()
+		ctrl := gomock.NewController(t)
+		var streamCtx context.Context
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().DoAndReturn(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+			// slow server: stream breaks only after client cancel
+			<-streamCtx.Done()
+
+			return nil, grpcStatus.Error(grpcCodes.Canceled, "")
+		})
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				streamCtx = ctx
+
+				return stream, nil
+			},
+		)
+		_, err := execute(ctx, "123", client, "", options.ExecuteSettings())
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.False(t, xerrors.IsTransportError(err))
+		require.False(t, retry.Check(err).MustRetry(true))
+		require.ErrorIs(t, streamCtx.Err(), context.Canceled)
+	})
 }
 
 func TestExecuteQueryRequest(t *testing.T) {
@@ -1015,3 +1046,338 @@ func TestExecuteQueryRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteQueryRequestInconsistentReads(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	allowInconsistentReads := func(t *testing.T, request *Ydb_Query.ExecuteQueryRequest) bool {
+		t.Helper()
+
+		mode, ok := request.GetTxControl().GetBeginTx().GetTxMode().(*Ydb_Query.TransactionSettings_OnlineReadOnly)
+		require.True(t, ok)
+
+		return mode.OnlineReadOnly.GetAllowInconsistentReads()
+	}
+	t.Run("TxDefault", func(t *testing.T) {
+		request, _, err := executeQueryRequest(a, "", "", options.ExecuteSettings(
+			options.WithTxControl(query.OnlineReadOnlyTxControl()),
+		))
+		require.NoError(t, err)
+		require.False(t, allowInconsistentReads(t, request))
+	})
+	t.Run("Override", func(t *testing.T) {
+		request, _, err := executeQueryRequest(a, "", "", options.ExecuteSettings(
+			options.WithTxControl(query.OnlineReadOnlyTxControl()),
+			options.WithInconsistentReads(),
+		))
+		require.NoError(t, err)
+		require.True(t, allowInconsistentReads(t, request))
+		require.True(t, request.GetTxControl().GetCommitTx())
+
+		// next statement with the same tx control uses tx-level setting
+		request, _, err = executeQueryRequest(a, "", "", options.ExecuteSettings(
+			options.WithTxControl(query.OnlineReadOnlyTxControl()),
+		))
+		require.NoError(t, err)
+		require.False(t, allowInconsistentReads(t, request))
+	})
+	t.Run("AlreadyInconsistent", func(t *testing.T) {
+		request, _, err := executeQueryRequest(a, "", "", options.ExecuteSettings(
+			options.WithTxControl(query.OnlineReadOnlyTxControl(query.WithInconsistentReads())),
+			options.WithInconsistentReads(),
+		))
+		require.NoError(t, err)
+		require.True(t, allowInconsistentReads(t, request))
+	})
+	for _, tt := range []struct {
+		name      string
+		txControl *query.TransactionControl
+	}{
+		{
+			name:      "NoTx",
+			txControl: query.NoTx(),
+		},
+		{
+			name:      "SerializableReadWrite",
+			txControl: query.SerializableReadWriteTxControl(query.CommitTx()),
+		},
+		{
+			name:      "SnapshotReadOnly",
+			txControl: query.SnapshotReadOnlyTxControl(),
+		},
+		{
+			name:      "WithTxID",
+			txControl: query.TxControl(query.WithTxID("test")),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := executeQueryRequest(a, "", "", options.ExecuteSettings(
+				options.WithTxControl(tt.txControl),
+				options.WithInconsistentReads(),
+			))
+			require.ErrorIs(t, err, errInconsistentReads)
+		})
+	}
+}
+
+func TestExecuteResultPageSize(t *testing.T) {
+	t.Run("Paging", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		const pages, rowsPerPage = 3, 2
+		for i := 0; i < pages; i++ {
+			rows := make([]*Ydb.Value, 0, rowsPerPage)
+			for j := 0; j < rowsPerPage; j++ {
+				rows = append(rows, &Ydb.Value{
+					Items: []*Ydb.Value{{
+						Value: &Ydb.Value_Uint64Value{
+							Uint64Value: uint64(i*rowsPerPage + j),
+						},
+					}},
+				})
+			}
+			part := &Ydb_Query.ExecuteQueryResponsePart{
+				Status:         Ydb.StatusIds_SUCCESS,
+				ResultSetIndex: 0,
+				ResultSet: &Ydb.ResultSet{
+					Rows: rows,
+				},
+			}
+			if i == 0 {
+				part.ResultSet.Columns = []*Ydb.Column{
+					{
+						Name: "a",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UINT64,
+							},
+						},
+					},
+				}
+			}
+			stream.EXPECT().Recv().Return(part, nil)
+		}
+		stream.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *Ydb_Query.ExecuteQueryRequest, _ ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.EqualValues(t, 1024, request.GetResponsePartLimitBytes())
+
+				return stream, nil
+			},
+		)
+		r, err := execute(ctx, "123", client, "", options.ExecuteSettings(options.WithResultPageSize(1024)))
+		require.NoError(t, err)
+		defer func() {
+			_ = r.Close(ctx)
+		}()
+		rs, err := r.NextResultSet(ctx)
+		require.NoError(t, err)
+		var values []uint64
+		for {
+			row, err := rs.NextRow(ctx)
+			if xerrors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			var a uint64
+			require.NoError(t, row.Scan(&a))
+			values = append(values, a)
+		}
+		require.Equal(t, []uint64{0, 1, 2, 3, 4, 5}, values)
+	})
+	t.Run("WrongSize", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		_, err := execute(xtest.Context(t), "123", client, "", options.ExecuteSettings(options.WithResultPageSize(0)))
+		require.Error(t, err)
+	})
+}
+
+func TestReadRowMode(t *testing.T) {
+	queryRow := func(t *testing.T, rowsCount int, opts ...options.Execute) (query.Row, error) {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		rows := make([]*Ydb.Value, 0, rowsCount)
+		for i := 0; i < rowsCount; i++ {
+			rows = append(rows, &Ydb.Value{
+				Items: []*Ydb.Value{{
+					Value: &Ydb.Value_Uint64Value{
+						Uint64Value: uint64(i),
+					},
+				}},
+			})
+		}
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: 0,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{
+						Name: "a",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UINT64,
+							},
+						},
+					},
+				},
+				Rows: rows,
+			},
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+		s := newTestSessionWithClient("123", client, false)
+
+		return s.queryRow(xtest.Context(t), "", options.ExecuteSettings(opts...))
+	}
+	for _, tt := range []struct {
+		name    string
+		opts    []options.Execute
+		noRowIs func(t *testing.T, err error)
+	}{
+		{
+			name: "Default",
+			noRowIs: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, io.EOF)
+				require.NotErrorIs(t, err, query.ErrNoRow)
+			},
+		},
+		{
+			name: "OptionalRow",
+			opts: []options.Execute{options.WithOptionalRow()},
+			noRowIs: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, query.ErrNoRow)
+				require.False(t, xerrors.As(err, &query.NoRowError{}))
+			},
+		},
+		{
+			name: "RowMustExist",
+			opts: []options.Execute{options.WithRowMustExist()},
+			noRowIs: func(t *testing.T, err error) {
+				require.True(t, xerrors.As(err, &query.NoRowError{}))
+				require.NotErrorIs(t, err, query.ErrNoRow)
+				require.NotErrorIs(t, err, io.EOF)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("ZeroRows", func(t *testing.T) {
+				row, err := queryRow(t, 0, tt.opts...)
+				require.Error(t, err)
+				require.Nil(t, row)
+				tt.noRowIs(t, err)
+			})
+			t.Run("OneRow", func(t *testing.T) {
+				row, err := queryRow(t, 1, tt.opts...)
+				require.NoError(t, err)
+				var a uint64
+				require.NoError(t, row.Scan(&a))
+				require.EqualValues(t, 0, a)
+			})
+			t.Run("ManyRows", func(t *testing.T) {
+				row, err := queryRow(t, 2, tt.opts...)
+				require.ErrorIs(t, err, errMoreThanOneRow)
+				require.Nil(t, row)
+			})
+		})
+	}
+}
+
+func TestColumnRename(t *testing.T) {
+	queryRenamed := func(t *testing.T, rename map[string]string) *streamResult {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: 0,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{
+						Name: "id",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UINT64,
+							},
+						},
+					},
+					{
+						Name: "title",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UTF8,
+							},
+						},
+					},
+				},
+				Rows: []*Ydb.Value{
+					{
+						Items: []*Ydb.Value{{
+							Value: &Ydb.Value_Uint64Value{
+								Uint64Value: 1,
+							},
+						}, {
+							Value: &Ydb.Value_TextValue{
+								TextValue: "a",
+							},
+						}},
+					},
+				},
+			},
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+		r, err := execute(xtest.Context(t), "123", client, "",
+			options.ExecuteSettings(options.WithColumnRename(rename)),
+		)
+		require.NoError(t, err)
+
+		return r
+	}
+	type row struct {
+		ID   uint64 `sql:"id"`
+		Name string `sql:"name"`
+	}
+	rename := map[string]string{"title": "name"}
+	t.Run("Execute", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := queryRenamed(t, rename)
+		rs, err := r.NextResultSet(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "name"}, rs.Columns())
+		require.Equal(t, "name", rs.ColumnDescriptors()[1].Name)
+		next, err := rs.NextRow(ctx)
+		require.NoError(t, err)
+		var v row
+		require.NoError(t, next.ScanStruct(&v))
+		require.Equal(t, row{ID: 1, Name: "a"}, v)
+	})
+	t.Run("ReadResultSet", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		rs, err := readMaterializedResultSet(ctx, queryRenamed(t, rename))
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "name"}, rs.Columns())
+		next, err := rs.NextRow(ctx)
+		require.NoError(t, err)
+		var v row
+		require.NoError(t, next.ScanStruct(&v))
+		require.Equal(t, row{ID: 1, Name: "a"}, v)
+	})
+	t.Run("UnknownColumn", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		_, err := queryRenamed(t, map[string]string{"unknown": "name"}).NextResultSet(ctx)
+		require.ErrorIs(t, err, errUnknownRenamedColumn)
+	})
+}
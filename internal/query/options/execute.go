@@ -1,15 +1,25 @@
 package options
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 )
 
+// MaxResultPageSize is an upper bound of result page size (see WithResultPageSize).
+// Result part with this size and response framing fits in default gRPC message size limit
+const MaxResultPageSize = 48 * 1024 * 1024
+
+var errWrongResultPageSize = errors.New("result page size must be positive")
+
 var (
 	_ Execute = callOptionsOption(nil)
 	_ Execute = (*txCommitOption)(nil)
@@ -18,12 +28,17 @@ var (
 	_ Execute = syntaxOption(0)
 	_ Execute = statsModeOption{}
 	_ Execute = execModeOption(0)
+	_ Execute = inconsistentReadsOption{}
+	_ Execute = rowModeOption(0)
+	_ Execute = resultPageSizeOption(0)
+	_ Execute = columnRenameOption(nil)
 )
 
 type (
 	Syntax    Ydb_Query.Syntax
 	ExecMode  Ydb_Query.ExecMode
 	StatsMode Ydb_Query.StatsMode
+	RowMode   uint8
 
 	// executeSettings is a holder for execute settings
 	executeSettings struct {
@@ -37,6 +52,10 @@ type (
 		txControl              *tx.Control
 		retryOptions           []retry.Option
 		responsePartLimitBytes int64
+		inconsistentReads      bool
+		rowMode                RowMode
+		columnRename           map[string]string
+		err                    error
 	}
 
 	// Execute is an interface for execute method options
@@ -61,8 +80,12 @@ type (
 		mode     StatsMode
 		callback func(stats.QueryStats)
 	}
-	execModeOption         = ExecMode
-	responsePartLimitBytes int64
+	execModeOption          = ExecMode
+	responsePartLimitBytes  int64
+	resultPageSizeOption    int64
+	inconsistentReadsOption struct{}
+	rowModeOption           = RowMode
+	columnRenameOption      map[string]string
 )
 
 func (poolID resourcePool) applyExecuteOption(s *executeSettings) {
@@ -180,6 +203,23 @@ func (s *executeSettings) ResponsePartLimitSizeBytes() int64 {
 	return s.responsePartLimitBytes
 }
 
+// Err returns error of wrong execute options
+func (s *executeSettings) Err() error {
+	return s.err
+}
+
+func (s *executeSettings) InconsistentReads() bool {
+	return s.inconsistentReads
+}
+
+func (s *executeSettings) RowMode() RowMode {
+	return s.rowMode
+}
+
+func (s *executeSettings) ColumnRename() map[string]string {
+	return s.columnRename
+}
+
 func WithParameters(params params.Parameters) parametersOption {
 	return parametersOption{
 		params: params,
@@ -200,6 +240,19 @@ func WithCommit() txCommitOption {
 	return txCommitOption{}
 }
 
+// WithoutCommit returns opts without commit option
+func WithoutCommit(opts ...Execute) []Execute {
+	filtered := make([]Execute, 0, len(opts))
+	for _, opt := range opts {
+		if _, has := opt.(txCommitOption); has {
+			continue
+		}
+		filtered = append(filtered, opt)
+	}
+
+	return filtered
+}
+
 func WithResourcePool(id string) resourcePool {
 	return resourcePool(id)
 }
@@ -216,6 +269,21 @@ func (size responsePartLimitBytes) applyExecuteOption(s *executeSettings) {
 	s.responsePartLimitBytes = int64(size)
 }
 
+// WithResultPageSize sets preferred size in bytes of result part (page) in query response stream.
+// Size must be positive, size greater than MaxResultPageSize is capped to MaxResultPageSize
+func WithResultPageSize(size int64) resultPageSizeOption {
+	return resultPageSizeOption(size)
+}
+
+func (size resultPageSizeOption) applyExecuteOption(s *executeSettings) {
+	if size <= 0 {
+		s.err = xerrors.WithStackTrace(fmt.Errorf("%w: %d", errWrongResultPageSize, size))
+
+		return
+	}
+	s.responsePartLimitBytes = min(int64(size), MaxResultPageSize)
+}
+
 func WithSyntax(syntax Syntax) syntaxOption {
 	return syntax
 }
@@ -239,3 +307,45 @@ func WithCallOptions(opts ...grpc.CallOption) callOptionsOption {
 func WithTxControl(txControl *tx.Control) *txControlOption {
 	return (*txControlOption)(txControl)
 }
+
+func (inconsistentReadsOption) applyExecuteOption(s *executeSettings) {
+	s.inconsistentReads = true
+}
+
+// WithInconsistentReads allows inconsistent reads for single statement executed with
+// online read-only transaction control regardless of transaction control settings
+func WithInconsistentReads() inconsistentReadsOption {
+	return inconsistentReadsOption{}
+}
+
+const (
+	// RowModeDefault is a mode of QueryRow which returns io.EOF error on empty result
+	RowModeDefault = RowMode(iota)
+	// RowModeOptional is a mode of QueryRow which returns query.ErrNoRow on empty result
+	RowModeOptional
+	// RowModeMustExist is a mode of QueryRow which returns query.NoRowError on empty result
+	RowModeMustExist
+)
+
+func (mode RowMode) applyExecuteOption(s *executeSettings) {
+	s.rowMode = mode
+}
+
+// WithOptionalRow makes QueryRow to return query.ErrNoRow if result has no rows
+func WithOptionalRow() rowModeOption {
+	return RowModeOptional
+}
+
+// WithRowMustExist makes QueryRow to return query.NoRowError if result has no rows
+func WithRowMustExist() rowModeOption {
+	return RowModeMustExist
+}
+
+func (rename columnRenameOption) applyExecuteOption(s *executeSettings) {
+	s.columnRename = rename
+}
+
+// WithColumnRename renames columns of result sets (source name -> new name)
+func WithColumnRename(rename map[string]string) columnRenameOption {
+	return rename
+}
@@ -158,6 +158,50 @@ func TestExecuteSettings(t *testing.T) {
 	}
 }
 
+func TestWithResultPageSize(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		size int64
+		part int64
+		err  error
+	}{
+		{
+			name: "Default",
+			part: 0,
+		},
+		{
+			name: "Positive",
+			size: 1024,
+			part: 1024,
+		},
+		{
+			name: "Capped",
+			size: MaxResultPageSize + 1,
+			part: MaxResultPageSize,
+		},
+		{
+			name: "Zero",
+			size: 0,
+			err:  errWrongResultPageSize,
+		},
+		{
+			name: "Negative",
+			size: -1,
+			err:  errWrongResultPageSize,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Execute
+			if tt.name != "Default" {
+				opts = append(opts, WithResultPageSize(tt.size))
+			}
+			settings := ExecuteSettings(opts...)
+			require.ErrorIs(t, settings.Err(), tt.err)
+			require.Equal(t, tt.part, settings.ResponsePartLimitSizeBytes())
+		})
+	}
+}
+
 func must[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
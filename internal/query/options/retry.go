@@ -56,6 +56,7 @@ func (s *doSettings) RetryOpts() []retry.Option {
 	return s.retryOpts
 }
 
+// TxSettings returns transaction settings or nil if settings were not specified
 func (s *doTxSettings) TxSettings() tx.Settings {
 	return s.txSettings
 }
@@ -96,6 +97,10 @@ func WithTrace(t *trace.Query) TraceOption {
 	return TraceOption{t: t}
 }
 
+func WithRetryTrace(t *trace.Retry) RetryOptionsOption {
+	return []retry.Option{retry.WithTrace(t)}
+}
+
 func WithRetryBudget(b budget.Budget) RetryOptionsOption {
 	return []retry.Option{retry.WithBudget(b)}
 }
@@ -116,7 +121,6 @@ func ParseDoOpts(t *trace.Query, opts ...DoOption) (s *doSettings) {
 
 func ParseDoTxOpts(t *trace.Query, opts ...DoTxOption) (s *doTxSettings) {
 	s = &doTxSettings{
-		txSettings: tx.NewSettings(tx.WithDefaultTxMode()),
 		doSettings: doSettings{
 			trace: t,
 		},
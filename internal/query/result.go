@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -22,25 +24,36 @@ import (
 var errReadNextResultSet = xerrors.Wrap(errors.New("ydb: stop read the result set because see part of next result set"))
 
 var (
-	_ result.Result = (*streamResult)(nil)
-	_ result.Result = (*materializedResult)(nil)
+	_ result.RowsAffectedResult = (*streamResult)(nil)
+	_ result.RowsAffectedResult = (*materializedResult)(nil)
+	_ result.CancelableResult   = (*streamResult)(nil)
+	_ result.CancelableResult   = (*materializedResult)(nil)
 )
 
 type (
 	materializedResult struct {
-		resultSets []result.Set
-		idx        int
+		resultSets   []result.Set
+		idx          int
+		rowsAffected int64
+		err          error // error of RowsAffected
+		canceled     atomic.Bool
 	}
 	streamResult struct {
 		stream         Ydb_Query_V1.QueryService_ExecuteQueryClient
 		closeOnce      func()
+		cancelOnce     func()
 		lastPart       *Ydb_Query.ExecuteQueryResponsePart
 		resultSetIndex int64
 		closed         chan struct{}
+		canceled       chan struct{}
 		trace          *trace.Query
 		statsCallback  func(queryStats stats.QueryStats)
 		onNextPartErr  []func(err error)
 		onTxMeta       []func(txMeta *Ydb_Query.TransactionMeta)
+		ctxErr         func() error
+		cancel         func()
+		execStats      atomic.Pointer[Ydb_TableStats.QueryStats] // the last reported execution stats
+		columnRename   map[string]string
 	}
 	resultOption func(s *streamResult)
 )
@@ -74,7 +87,15 @@ func (r *materializedResult) Close(ctx context.Context) error {
 	return nil
 }
 
+func (r *materializedResult) Cancel() {
+	r.canceled.Store(true)
+}
+
 func (r *materializedResult) NextResultSet(ctx context.Context) (result.Set, error) {
+	if r.canceled.Load() {
+		return nil, xerrors.WithStackTrace(query.ErrResultCanceled)
+	}
+
 	if r.idx == len(r.resultSets) {
 		return nil, xerrors.WithStackTrace(io.EOF)
 	}
@@ -98,6 +119,21 @@ func withStatsCallback(callback func(queryStats stats.QueryStats)) resultOption
 	}
 }
 
+// withStreamCancel binds result to the context of execute call: cancel releases stream
+// context and ctx error replaces the stream error after ctx is done
+func withStreamCancel(ctx context.Context, cancel func()) resultOption {
+	return func(s *streamResult) {
+		s.ctxErr = ctx.Err
+		s.cancel = cancel
+	}
+}
+
+func withColumnRename(rename map[string]string) resultOption {
+	return func(s *streamResult) {
+		s.columnRename = rename
+	}
+}
+
 func onNextPartErr(callback func(err error)) resultOption {
 	return func(s *streamResult) {
 		s.onNextPartErr = append(s.onNextPartErr, callback)
@@ -118,11 +154,18 @@ func newResult(
 	r := streamResult{
 		stream:         stream,
 		closed:         make(chan struct{}),
+		canceled:       make(chan struct{}),
 		resultSetIndex: -1,
 	}
 	r.closeOnce = sync.OnceFunc(func() {
 		close(r.closed)
 		r.stream = nil
+		if r.cancel != nil {
+			r.cancel()
+		}
+	})
+	r.cancelOnce = sync.OnceFunc(func() {
+		close(r.canceled)
 	})
 
 	for _, opt := range opts {
@@ -142,6 +185,8 @@ func newResult(
 
 	select {
 	case <-ctx.Done():
+		r.closeOnce()
+
 		return nil, xerrors.WithStackTrace(ctx.Err())
 	default:
 		part, err := r.nextPart(ctx)
@@ -173,12 +218,21 @@ func (r *streamResult) nextPart(ctx context.Context) (
 
 	select {
 	case <-r.closed:
-		return nil, xerrors.WithStackTrace(io.EOF)
+		return nil, r.closedErr()
 	default:
 		part, err = nextPart(r.stream)
+		if execStats := part.GetExecStats(); execStats != nil {
+			r.execStats.Store(execStats)
+		}
 		if err != nil {
 			r.closeOnce()
 
+			if r.ctxErr != nil {
+				if ctxErr := r.ctxErr(); ctxErr != nil {
+					err = ctxErr
+				}
+			}
+
 			for _, callback := range r.onNextPartErr {
 				callback(err)
 			}
@@ -236,19 +290,44 @@ func (r *streamResult) Close(ctx context.Context) (finalErr error) {
 	}
 }
 
+// Cancel aborts stream of result without reading of remaining parts
+func (r *streamResult) Cancel() {
+	r.cancelOnce()
+	r.closeOnce()
+}
+
+// closedErr returns error of reading of closed result
+func (r *streamResult) closedErr() error {
+	select {
+	case <-r.canceled:
+		return xerrors.WithStackTrace(query.ErrResultCanceled)
+	default:
+		return xerrors.WithStackTrace(io.EOF)
+	}
+}
+
 func (r *streamResult) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
 	nextResultSetIndex := r.resultSetIndex + 1
 	for {
 		select {
 		case <-r.closed:
-			return nil, xerrors.WithStackTrace(io.EOF)
+			return nil, r.closedErr()
 		case <-ctx.Done():
 			return nil, xerrors.WithStackTrace(ctx.Err())
 		default:
 			if resultSetIndex := r.lastPart.GetResultSetIndex(); resultSetIndex >= nextResultSetIndex {
 				r.resultSetIndex = resultSetIndex
 
-				return newResultSet(r.nextPartFunc(ctx, nextResultSetIndex), r.lastPart), nil
+				rs := newResultSet(r.nextPartFunc(ctx, nextResultSetIndex), r.lastPart)
+				rs.canceled = r.canceled
+				if len(r.columnRename) > 0 {
+					rs.columns, err = renameColumns(rs.columns, r.columnRename)
+					if err != nil {
+						return nil, xerrors.WithStackTrace(err)
+					}
+				}
+
+				return rs, nil
 			}
 			if r.stream == nil {
 				return nil, xerrors.WithStackTrace(io.EOF)
@@ -284,7 +363,7 @@ func (r *streamResult) nextPartFunc(
 	return func() (_ *Ydb_Query.ExecuteQueryResponsePart, err error) {
 		select {
 		case <-r.closed:
-			return nil, xerrors.WithStackTrace(io.EOF)
+			return nil, r.closedErr()
 		default:
 			if r.stream == nil {
 				return nil, xerrors.WithStackTrace(io.EOF)
@@ -423,7 +502,34 @@ func resultToMaterializedResult(ctx context.Context, r result.Result) (result.Re
 		resultSets = append(resultSets, MaterializedResultSet(rs.Index(), rs.Columns(), rs.ColumnTypes(), rows))
 	}
 
+	rowsAffected, err := int64(-1), xerrors.WithStackTrace(errNoRowsAffected)
+	if r, ok := r.(result.RowsAffectedResult); ok {
+		rowsAffected, err = r.RowsAffected()
+	}
+
 	return &materializedResult{
-		resultSets: resultSets,
+		resultSets:   resultSets,
+		rowsAffected: rowsAffected,
+		err:          err,
 	}, nil
 }
+
+func (r *materializedResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, r.err
+}
+
+func (r *streamResult) RowsAffected() (int64, error) {
+	execStats := r.execStats.Load()
+	if execStats == nil {
+		return -1, xerrors.WithStackTrace(errNoRowsAffected)
+	}
+
+	var rowsAffected uint64
+	for _, phase := range execStats.GetQueryPhases() {
+		for _, tableAccess := range phase.GetTableAccess() {
+			rowsAffected += tableAccess.GetUpdates().GetRows() + tableAccess.GetDeletes().GetRows()
+		}
+	}
+
+	return int64(rowsAffected), nil
+}
@@ -20,10 +20,35 @@ type (
 		// with Go version 1.23+
 		ResultSets(ctx context.Context) xiter.Seq2[Set, error]
 	}
+	// RowsAffectedResult is an optional interface of Result which reports number of affected rows.
+	// Results of query service client implement RowsAffectedResult
+	RowsAffectedResult interface {
+		Result
+
+		// RowsAffected returns number of rows changed by DML statements of query.
+		// Server reports it with execution stats only (see query.WithStatsMode), so RowsAffected
+		// returns -1 and error if query executed without stats or result is not read to the end
+		RowsAffected() (int64, error)
+	}
+	// CancelableResult is an optional interface of Result which may be canceled before reading to the end.
+	// Results of query service client implement CancelableResult
+	CancelableResult interface {
+		Result
+
+		// Cancel stops reading of result: stream of result is aborted on server side without reading
+		// of remaining parts and session of result becomes free for next queries.
+		// Reading of result after Cancel returns query.ErrResultCanceled
+		Cancel()
+	}
 	Set interface {
 		Index() int
 		Columns() []string
 		ColumnTypes() []types.Type
+
+		// ColumnDescriptors returns ordered descriptors of result set columns.
+		// Descriptors are available before iterating rows
+		ColumnDescriptors() []Column
+
 		NextRow(ctx context.Context) (Row, error)
 
 		// Rows is experimental API for range iterators available with Go version 1.23+
@@ -33,6 +58,11 @@ type (
 		Set
 		closer.Closer
 	}
+	// Column describes column of result set
+	Column struct {
+		Name string
+		Type types.Type // use Type.Yql() for YDB type string, such as Optional<Utf8>
+	}
 	Row interface {
 		Scan(dst ...interface{}) error
 		ScanNamed(dst ...scanner.NamedDestination) error
@@ -36,6 +36,7 @@ type (
 		currentPart         *Ydb_Query.ExecuteQueryResponsePart
 		rowIndex            int
 		done                chan struct{}
+		canceled            <-chan struct{} // closed on cancel of result, nil for result set without result
 		mustBeLastResultSet bool
 	}
 	resultSetWithClose struct {
@@ -103,6 +104,50 @@ func (rs *resultSet) Columns() (columnNames []string) {
 	return columnNames
 }
 
+// renameColumns returns copy of columns with names replaced by rename (source name -> new name)
+func renameColumns(columns []*Ydb.Column, rename map[string]string) ([]*Ydb.Column, error) {
+	renamed := make([]*Ydb.Column, len(columns))
+	copy(renamed, columns)
+
+	for from, to := range rename {
+		found := false
+		for i := range columns {
+			if columns[i].GetName() == from {
+				renamed[i] = &Ydb.Column{
+					Name: to,
+					Type: columns[i].GetType(),
+				}
+				found = true
+			}
+		}
+		if !found {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errUnknownRenamedColumn, from))
+		}
+	}
+
+	return renamed, nil
+}
+
+func (rs *materializedResultSet) ColumnDescriptors() []result.Column {
+	return columnDescriptors(rs.columnNames, rs.columnTypes)
+}
+
+func (rs *resultSet) ColumnDescriptors() []result.Column {
+	return columnDescriptors(rs.Columns(), rs.ColumnTypes())
+}
+
+func columnDescriptors(columnNames []string, columnTypes []types.Type) []result.Column {
+	columns := make([]result.Column, len(columnNames))
+	for i := range columnNames {
+		columns[i] = result.Column{
+			Name: columnNames[i],
+			Type: columnTypes[i],
+		}
+	}
+
+	return columns
+}
+
 func (rs *materializedResultSet) NextRow(ctx context.Context) (query.Row, error) {
 	if rs.rowIndex == len(rs.rows) {
 		return nil, xerrors.WithStackTrace(io.EOF)
@@ -157,6 +202,8 @@ func (rs *resultSet) nextRow(ctx context.Context) (*Row, error) {
 		select {
 		case <-rs.done:
 			return nil, io.EOF
+		case <-rs.canceled:
+			return nil, xerrors.WithStackTrace(query.ErrResultCanceled)
 		case <-ctx.Done():
 			return nil, xerrors.WithStackTrace(ctx.Err())
 		default:
@@ -959,4 +959,13 @@ func TestResultSetNext(t *testing.T) {
 		}
 		require.EqualValues(t, []string{"Uint64", "Utf8"}, types)
 	})
+	t.Run("ColumnDescriptors", func(t *testing.T) {
+		columns := rs.ColumnDescriptors()
+		require.Len(t, columns, 2)
+		require.Equal(t, "a", columns[0].Name)
+		require.Equal(t, "Uint64", columns[0].Type.Yql())
+		require.Equal(t, "b", columns[1].Name)
+		require.Equal(t, "Utf8", columns[1].Type.Yql())
+		require.Equal(t, columns, MaterializedResultSet(0, rs.Columns(), rs.ColumnTypes(), nil).ColumnDescriptors())
+	})
 }
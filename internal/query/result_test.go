@@ -13,7 +13,10 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
@@ -4446,3 +4449,198 @@ func TestMaterializedResultStats(t *testing.T) {
 		})
 	})
 }
+
+func TestResultRowsAffected(t *testing.T) {
+	execStats := &Ydb_TableStats.QueryStats{
+		QueryPhases: []*Ydb_TableStats.QueryPhaseStats{
+			{
+				TableAccess: []*Ydb_TableStats.TableAccessStats{
+					{
+						Name:    "a",
+						Reads:   &Ydb_TableStats.OperationStats{Rows: 100},
+						Deletes: &Ydb_TableStats.OperationStats{Rows: 3},
+					},
+				},
+			},
+			{
+				TableAccess: []*Ydb_TableStats.TableAccessStats{
+					{
+						Name:    "b",
+						Updates: &Ydb_TableStats.OperationStats{Rows: 2},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range []struct {
+		name         string
+		parts        []*Ydb_Query.ExecuteQueryResponsePart
+		materialize  bool
+		rowsAffected int64
+		err          error
+	}{
+		{
+			name: "WithStats",
+			parts: []*Ydb_Query.ExecuteQueryResponsePart{
+				{Status: Ydb.StatusIds_SUCCESS},
+				{Status: Ydb.StatusIds_SUCCESS, ExecStats: execStats},
+			},
+			rowsAffected: 5,
+		},
+		{
+			name: "MaterializedWithStats",
+			parts: []*Ydb_Query.ExecuteQueryResponsePart{
+				{Status: Ydb.StatusIds_SUCCESS},
+				{Status: Ydb.StatusIds_SUCCESS, ExecStats: execStats},
+			},
+			materialize:  true,
+			rowsAffected: 5,
+		},
+		{
+			name: "WithoutDML",
+			parts: []*Ydb_Query.ExecuteQueryResponsePart{
+				{Status: Ydb.StatusIds_SUCCESS, ExecStats: &Ydb_TableStats.QueryStats{}},
+			},
+			rowsAffected: 0,
+		},
+		{
+			name: "WithoutStats",
+			parts: []*Ydb_Query.ExecuteQueryResponsePart{
+				{Status: Ydb.StatusIds_SUCCESS},
+			},
+			rowsAffected: -1,
+			err:          errNoRowsAffected,
+		},
+		{
+			name: "MaterializedWithoutStats",
+			parts: []*Ydb_Query.ExecuteQueryResponsePart{
+				{Status: Ydb.StatusIds_SUCCESS},
+			},
+			materialize:  true,
+			rowsAffected: -1,
+			err:          errNoRowsAffected,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			ctrl := gomock.NewController(t)
+			stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+			for _, part := range tt.parts {
+				stream.EXPECT().Recv().Return(part, nil)
+			}
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+
+			var r query.Result
+			r, err := newResult(ctx, stream, nil)
+			require.NoError(t, err)
+			if tt.materialize {
+				r, err = resultToMaterializedResult(ctx, r)
+				require.NoError(t, err)
+			} else {
+				for {
+					_, err = r.NextResultSet(ctx)
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					require.NoError(t, err)
+				}
+			}
+
+			ra, ok := r.(query.RowsAffectedResult)
+			require.True(t, ok)
+			rowsAffected, err := ra.RowsAffected()
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.rowsAffected, rowsAffected)
+		})
+	}
+}
+
+func TestResultCancel(t *testing.T) {
+	part := func(rows ...uint64) *Ydb_Query.ExecuteQueryResponsePart {
+		values := make([]*Ydb.Value, 0, len(rows))
+		for _, v := range rows {
+			values = append(values, &Ydb.Value{
+				Items: []*Ydb.Value{{
+					Value: &Ydb.Value_Uint64Value{
+						Uint64Value: v,
+					},
+				}},
+			})
+		}
+
+		return &Ydb_Query.ExecuteQueryResponsePart{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: 0,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{{
+					Name: "a",
+					Type: &Ydb.Type{
+						Type: &Ydb.Type_TypeId{
+							TypeId: Ydb.Type_UINT64,
+						},
+					},
+				}},
+				Rows: values,
+			},
+		}
+	}
+	t.Run("Stream", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		var streamCtx context.Context
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		// the first part only: rest of parts are never received after cancel
+		stream.EXPECT().Recv().Return(part(1, 2), nil)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ *Ydb_Query.ExecuteQueryRequest, _ ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				streamCtx = ctx
+
+				return stream, nil
+			},
+		)
+		r, err := execute(ctx, "123", client, "", options.ExecuteSettings())
+		require.NoError(t, err)
+		rs, err := r.NextResultSet(ctx)
+		require.NoError(t, err)
+		_, err = rs.NextRow(ctx)
+		require.NoError(t, err)
+		require.NoError(t, streamCtx.Err())
+
+		r.Cancel()
+		require.ErrorIs(t, streamCtx.Err(), context.Canceled)
+
+		_, err = rs.NextRow(ctx)
+		require.ErrorIs(t, err, query.ErrResultCanceled)
+		_, err = r.NextResultSet(ctx)
+		require.ErrorIs(t, err, query.ErrResultCanceled)
+		require.NoError(t, r.Close(ctx))
+		r.Cancel()
+		_, err = r.NextResultSet(ctx)
+		require.ErrorIs(t, err, query.ErrResultCanceled)
+	})
+	t.Run("StreamAfterEnd", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(part(1), nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+		r, err := newResult(ctx, stream)
+		require.NoError(t, err)
+		require.NoError(t, readAll(ctx, r))
+
+		r.Cancel()
+		_, err = r.NextResultSet(ctx)
+		require.ErrorIs(t, err, query.ErrResultCanceled)
+	})
+	t.Run("Materialized", func(t *testing.T) {
+		r := &materializedResult{
+			resultSets: []result.Set{MaterializedResultSet(0, nil, nil, nil)},
+		}
+		r.Cancel()
+		_, err := r.NextResultSet(xtest.Context(t))
+		require.ErrorIs(t, err, query.ErrResultCanceled)
+	})
+}
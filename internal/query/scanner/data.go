@@ -2,6 +2,7 @@ package scanner
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
@@ -31,6 +32,26 @@ func (s data) seekByName(name string) (value.Value, error) {
 	return nil, xerrors.WithStackTrace(fmt.Errorf("'%s': %w", name, ErrColumnsNotFoundInRow))
 }
 
+func (s data) indexByName(name string) int {
+	for i := range s.columns {
+		if s.columns[i].GetName() == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s data) indexByNameFold(name string) int {
+	for i := range s.columns {
+		if strings.EqualFold(s.columns[i].GetName(), name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
 func (s data) seekByIndex(idx int) value.Value {
 	return value.FromYDB(s.columns[idx].GetType(), s.values[idx])
 }
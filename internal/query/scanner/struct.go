@@ -25,12 +25,26 @@ func Struct(data *data) StructScanner {
 	}
 }
 
+// ydbTagName is a fallback tag name for lookup column name of struct field
+const ydbTagName = "ydb"
+
 func fieldName(f reflect.StructField, tagName string) string { //nolint:gocritic
+	name, _ := lookupFieldName(f, tagName)
+
+	return name
+}
+
+// lookupFieldName returns column name for struct field and flag which reports whether the name
+// was defined by struct tag
+func lookupFieldName(f reflect.StructField, tagName string) (name string, tagged bool) { //nolint:gocritic
 	if name, has := f.Tag.Lookup(tagName); has {
-		return name
+		return name, true
+	}
+	if name, has := f.Tag.Lookup(ydbTagName); has {
+		return name, true
 	}
 
-	return f.Name
+	return f.Name, false
 }
 
 func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (err error) {
@@ -55,16 +69,21 @@ func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (er
 	missingColumns := make([]string, 0, len(s.data.columns))
 	existingFields := make(map[string]struct{}, tt.NumField())
 	for i := 0; i < tt.NumField(); i++ {
-		name := fieldName(tt.Field(i), settings.TagName)
+		name, tagged := lookupFieldName(tt.Field(i), settings.TagName)
 		if name == "-" {
 			continue
 		}
 
-		v, err := s.data.seekByName(name)
-		if err != nil {
+		idx := s.data.indexByName(name)
+		if idx < 0 && !tagged {
+			// untagged struct fields matches to columns case-insensitive
+			idx = s.data.indexByNameFold(name)
+		}
+		if idx < 0 {
 			missingColumns = append(missingColumns, name)
 		} else {
-			if err = value.CastTo(v, ptr.Elem().Field(i).Addr().Interface()); err != nil {
+			name = s.data.columns[idx].GetName()
+			if err = value.CastTo(s.data.seekByIndex(idx), ptr.Elem().Field(i).Addr().Interface()); err != nil {
 				return xerrors.WithStackTrace(fmt.Errorf("scan error on struct field name '%s': %w", name, err))
 			}
 			existingFields[name] = struct{}{}
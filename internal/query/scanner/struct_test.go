@@ -32,6 +32,20 @@ func TestFieldName(t *testing.T) {
 			}{},
 			out: "col0",
 		},
+		{
+			name: xtest.CurrentFileLine(),
+			in: struct {
+				Col0 string `ydb:"col0"`
+			}{},
+			out: "col0",
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			in: struct {
+				Col0 string `sql:"col0" ydb:"col1"`
+			}{},
+			out: "col0",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			require.Equal(t, tt.out, fieldName(reflect.ValueOf(tt.in).Type().Field(0), "sql"))
@@ -852,6 +866,127 @@ func TestStructWithTagName(t *testing.T) {
 	require.Equal(t, "CC", row.C)
 }
 
+func TestStructCaseInsensitiveNames(t *testing.T) {
+	scanner := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "user_id",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_TypeId{
+						TypeId: Ydb.Type_UINT64,
+					},
+				},
+			},
+			{
+				Name: "NAME",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_TypeId{
+						TypeId: Ydb.Type_UTF8,
+					},
+				},
+			},
+			{
+				Name: "email",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_OptionalType{
+						OptionalType: &Ydb.OptionalType{
+							Item: &Ydb.Type{
+								Type: &Ydb.Type_TypeId{
+									TypeId: Ydb.Type_UTF8,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		[]*Ydb.Value{
+			{
+				Value: &Ydb.Value_Uint64Value{
+					Uint64Value: 42,
+				},
+			},
+			{
+				Value: &Ydb.Value_TextValue{
+					TextValue: "test",
+				},
+			},
+			{
+				Value: &Ydb.Value_TextValue{
+					TextValue: "test@example.com",
+				},
+			},
+		},
+	))
+	var row struct {
+		ID    uint64 `ydb:"user_id"`
+		Name  string
+		Email *string
+	}
+	err := scanner.ScanStruct(&row)
+	require.NoError(t, err)
+	require.EqualValues(t, 42, row.ID)
+	require.Equal(t, "test", row.Name)
+	require.NotNil(t, row.Email)
+	require.Equal(t, "test@example.com", *row.Email)
+}
+
+func TestStructTaggedNamesAreCaseSensitive(t *testing.T) {
+	scanner := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "NAME",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_TypeId{
+						TypeId: Ydb.Type_UTF8,
+					},
+				},
+			},
+		},
+		[]*Ydb.Value{
+			{
+				Value: &Ydb.Value_TextValue{
+					TextValue: "test",
+				},
+			},
+		},
+	))
+	var row struct {
+		Name string `sql:"name"`
+	}
+	err := scanner.ScanStruct(&row)
+	require.ErrorIs(t, err, ErrColumnsNotFoundInRow)
+	require.ErrorContains(t, err, "'name'")
+}
+
+func TestStructCastFailedErrMsgCaseInsensitive(t *testing.T) {
+	scanner := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "amount",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_TypeId{
+						TypeId: Ydb.Type_UTF8,
+					},
+				},
+			},
+		},
+		[]*Ydb.Value{
+			{
+				Value: &Ydb.Value_TextValue{
+					TextValue: "test",
+				},
+			},
+		},
+	))
+	var row struct {
+		Amount uint64
+	}
+	err := scanner.ScanStruct(&row)
+	require.ErrorIs(t, err, value.ErrCannotCast)
+	require.ErrorContains(t, err, "scan error on struct field name 'amount': cast failed")
+}
+
 func TestScannerStructOrdering(t *testing.T) {
 	scanner := Struct(Data(
 		[]*Ydb.Column{
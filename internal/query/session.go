@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 
@@ -12,6 +13,7 @@ import (
 	baseTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -24,6 +26,8 @@ type (
 		client Ydb_Query_V1.QueryServiceClient
 		trace  *trace.Query
 		laztTx bool
+
+		retryBudget budget.Budget // budget of commit retries (see query.WithCommitRetry)
 	}
 )
 
@@ -57,7 +61,7 @@ func (s *Session) queryRow(
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	row, err = readRow(ctx, r)
+	row, err = readRow(ctx, r, settings.RowMode())
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -111,24 +115,43 @@ func (s *Session) Begin(
 		}
 	}()
 
-	if s.laztTx {
+	if s.laztTx || txSettings.IsLazyBegin() {
 		return &Transaction{
 			s:          s,
 			txSettings: txSettings,
 		}, nil
 	}
 
-	txID, err := begin(ctx, s.client, s.ID(), txSettings)
+	txID, err := s.beginTx(ctx, txSettings)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
 	return &Transaction{
-		LazyID: baseTx.ID(txID),
-		s:      s,
+		LazyID:     baseTx.ID(txID),
+		s:          s,
+		txSettings: txSettings,
 	}, nil
 }
 
+func (s *Session) beginTx(ctx context.Context, txSettings query.TransactionSettings) (txID string, finalErr error) {
+	start := time.Now()
+	onDone := trace.QueryOnTxBegin(s.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Session).beginTx"), s,
+		txSettings.TxMode(),
+	)
+	defer func() {
+		onDone(finalErr, txID, time.Since(start))
+	}()
+
+	txID, err := begin(ctx, s.client, s.ID(), txSettings)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return txID, nil
+}
+
 func (s *Session) Exec(
 	ctx context.Context, q string, opts ...options.Execute,
 ) (finalErr error) {
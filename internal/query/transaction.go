@@ -3,24 +3,34 @@ package query
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	grpcCodes "google.golang.org/grpc/codes"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/session"
 	queryTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	baseTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+// rollbackOnCommitTimeout limits best effort rollback after commit interrupted by context
+const rollbackOnCommitTimeout = time.Second
+
 var (
 	_ query.Transaction  = (*Transaction)(nil)
 	_ baseTx.Transaction = (*Transaction)(nil)
@@ -40,6 +50,46 @@ type (
 	}
 )
 
+type (
+	// outerTxKey is a context key of outer transaction of session pool. Key is unique for each pool,
+	// so doTx over another client (session pool) doesn't join transaction of foreign client
+	outerTxKey struct {
+		pool sessionPool
+	}
+	outerTx struct {
+		tx         query.Transaction
+		txSettings query.TransactionSettings
+		// done is set after return of operation of outer doTx, so context which outlives
+		// the operation doesn't join completed transaction
+		done atomic.Bool
+	}
+)
+
+// withOuterTx stores transaction over session of pool into context so nested doTx calls over the same pool join it.
+// Returned done func must be called after return of operation of outer doTx
+func withOuterTx(
+	ctx context.Context, pool sessionPool, tx query.Transaction, txSettings query.TransactionSettings,
+) (_ context.Context, done func()) {
+	outer := &outerTx{
+		tx:         tx,
+		txSettings: txSettings,
+	}
+
+	return context.WithValue(ctx, outerTxKey{pool: pool}, outer), func() {
+		outer.done.Store(true)
+	}
+}
+
+// outerTxFromContext returns live outer transaction over session of pool
+func outerTxFromContext(ctx context.Context, pool sessionPool) (*outerTx, bool) {
+	outer, has := ctx.Value(outerTxKey{pool: pool}).(*outerTx)
+	if !has || outer.done.Load() {
+		return nil, false
+	}
+
+	return outer, true
+}
+
 func begin(
 	ctx context.Context,
 	client Ydb_Query_V1.QueryServiceClient,
@@ -66,7 +116,7 @@ func (tx *Transaction) UnLazy(ctx context.Context) error {
 		return nil
 	}
 
-	txID, err := begin(ctx, tx.s.client, tx.s.ID(), tx.txSettings)
+	txID, err := tx.s.beginTx(ctx, tx.txSettings)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -168,7 +218,7 @@ func (tx *Transaction) QueryRow(
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	row, err = readRow(ctx, r)
+	row, err = readRow(ctx, r, settings.RowMode())
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -193,10 +243,13 @@ func (tx *Transaction) txControl() *queryTx.Control {
 func (tx *Transaction) Exec(ctx context.Context, q string, opts ...options.Execute) (
 	finalErr error,
 ) {
+	start := time.Now()
 	onDone := trace.QueryOnTxExec(tx.s.trace, &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Exec"), tx.s, tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Exec"), tx.s, tx, q,
+		tx.txSettings.TxMode(),
+	)
 	defer func() {
-		onDone(finalErr)
+		onDone(finalErr, time.Since(start))
 	}()
 
 	if tx.completed {
@@ -262,10 +315,13 @@ func (tx *Transaction) executeSettings(opts ...options.Execute) (_ executeSettin
 func (tx *Transaction) Query(ctx context.Context, q string, opts ...options.Execute) (
 	_ query.Result, finalErr error,
 ) {
+	start := time.Now()
 	onDone := trace.QueryOnTxQuery(tx.s.trace, &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Query"), tx.s, tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Query"), tx.s, tx, q,
+		tx.txSettings.TxMode(),
+	)
 	defer func() {
-		onDone(finalErr)
+		onDone(finalErr, time.Since(start))
 	}()
 
 	if tx.completed {
@@ -305,6 +361,63 @@ func (tx *Transaction) Query(ctx context.Context, q string, opts ...options.Exec
 	return r, nil
 }
 
+// ExecuteBatch executes statements one by one in the transaction and returns materialized result
+// for each statement in the same order. Query service doesn't map result sets of multi-statement query
+// to statements (statement without result sets yields nothing), so each statement is executed with
+// own request. If some statement fails, ExecuteBatch returns results of the previous statements
+// and the error. Commit option (if any) applies to the last statement only.
+func (tx *Transaction) ExecuteBatch(ctx context.Context, queries []string, opts ...options.Execute) (
+	[]query.Result, error,
+) {
+	results := make([]query.Result, 0, len(queries))
+	for i, q := range queries {
+		queryOpts := opts
+		if i < len(queries)-1 {
+			queryOpts = options.WithoutCommit(opts...)
+		}
+
+		r, err := tx.Query(ctx, q, queryOpts...)
+		if err != nil {
+			return results, xerrors.WithStackTrace(err)
+		}
+
+		materialized, err := resultToMaterializedResult(ctx, r)
+		if err != nil {
+			return results, xerrors.WithStackTrace(err)
+		}
+
+		results = append(results, materialized)
+	}
+
+	return results, nil
+}
+
+// RunAll executes statements one by one in the transaction and commits transaction on success.
+// If some statement fails, RunAll rolls back transaction and returns *query.TxStatementError
+// with index of failed statement
+func (tx *Transaction) RunAll(ctx context.Context, queries []string, opts ...options.Execute) error {
+	opts = options.WithoutCommit(opts...)
+	for i, q := range queries {
+		if err := tx.Exec(ctx, q, opts...); err != nil {
+			err = &query.TxStatementError{
+				Index: i,
+				Err:   err,
+			}
+			if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+				return xerrors.WithStackTrace(xerrors.Join(err, rollbackErr))
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	if err := tx.CommitTx(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
 func commitTx(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessionID, txID string) error {
 	_, err := client.CommitTransaction(ctx, &Ydb_Query.CommitTransactionRequest{
 		SessionId: sessionID,
@@ -336,18 +449,78 @@ func (tx *Transaction) CommitTx(ctx context.Context) (finalErr error) {
 		return err
 	}
 
-	err = commitTx(ctx, tx.s.client, tx.s.ID(), tx.ID())
+	start := time.Now()
+	onDone := trace.QueryOnTxCommit(tx.s.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).CommitTx"), tx.s, tx,
+		tx.txSettings.TxMode(),
+	)
+	defer func() {
+		onDone(finalErr, time.Since(start))
+	}()
+
+	err = tx.commitWithRetry(ctx)
 	if err != nil {
 		if xerrors.IsOperationError(err, Ydb.StatusIds_BAD_SESSION) {
 			tx.s.SetStatus(session.StatusClosed)
 		}
 
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			// commit may be applied on server side, rollback is a best effort for not committed transaction
+			rollbackCtx, cancel := xcontext.WithTimeout(xcontext.ValueOnly(ctx), rollbackOnCommitTimeout)
+			defer cancel()
+
+			_ = rollback(rollbackCtx, tx.s.client, tx.s.ID(), tx.ID())
+
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %w", query.ErrCommitOutcomeUnknown, ctxErr))
+		}
+
 		return xerrors.WithStackTrace(err)
 	}
 
 	return nil
 }
 
+// commitWithRetry commits transaction and retries commit errors which are raised before processing
+// of commit request (see isCommitNotSent) up to number of times from WithCommitRetry option.
+// Each retry acquires quota from retry budget of session
+func (tx *Transaction) commitWithRetry(ctx context.Context) error {
+	err := commitTx(ctx, tx.s.client, tx.s.ID(), tx.ID())
+	for attempt := 0; err != nil && attempt < tx.txSettings.CommitRetry(); attempt++ {
+		if !isCommitNotSent(err) || ctx.Err() != nil {
+			return err
+		}
+
+		t := time.NewTimer(backoff.Delay(retry.Check(err).BackoffType(), attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+
+			return err
+		case <-t.C:
+		}
+
+		if tx.s.retryBudget != nil {
+			if acquireErr := tx.s.retryBudget.Acquire(ctx); acquireErr != nil {
+				return xerrors.WithStackTrace(xerrors.Join(err, acquireErr))
+			}
+		}
+
+		err = commitTx(ctx, tx.s.client, tx.s.ID(), tx.ID())
+	}
+
+	return err
+}
+
+// isCommitNotSent reports whether commit request wasn't sent to server or was rejected by server
+// before processing, so transaction is still alive and commit of the same transaction may be repeated.
+// Other errors (such as ABORTED, UNDETERMINED, TIMEOUT or broken connection) mean that transaction is
+// already aborted or may be committed, so repeated commit returns wrong result
+func isCommitNotSent(err error) bool {
+	return xerrors.Is(err, balancer.ErrNoEndpoints) ||
+		xerrors.IsTransportError(err, grpcCodes.ResourceExhausted) ||
+		xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED)
+}
+
 func rollback(ctx context.Context, client Ydb_Query_V1.QueryServiceClient, sessionID, txID string) error {
 	_, err := client.RollbackTransaction(ctx, &Ydb_Query.RollbackTransactionRequest{
 		SessionId: sessionID,
@@ -374,6 +547,15 @@ func (tx *Transaction) Rollback(ctx context.Context) (finalErr error) {
 
 	tx.notifyOnCompleted(ErrTransactionRollingBack)
 
+	start := time.Now()
+	onDone := trace.QueryOnTxRollback(tx.s.trace, &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Transaction).Rollback"), tx.s, tx,
+		tx.txSettings.TxMode(),
+	)
+	defer func() {
+		onDone(finalErr, time.Since(start))
+	}()
+
 	err := rollback(ctx, tx.s.client, tx.s.ID(), tx.ID())
 	if err != nil {
 		if xerrors.IsOperationError(err, Ydb.StatusIds_BAD_SESSION) {
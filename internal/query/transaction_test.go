@@ -12,6 +12,7 @@ import (
 	"github.com/rekby/fixenv"
 	"github.com/rekby/fixenv/sf"
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"go.uber.org/mock/gomock"
@@ -19,11 +20,13 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	baseTx "github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 var _ baseTx.Transaction = &Transaction{}
@@ -68,6 +71,363 @@ func TestBegin(t *testing.T) {
 	})
 }
 
+func TestLazyBegin(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	client := NewMockQueryServiceClient(ctrl)
+	s := newTestSessionWithClient("123", client, false)
+
+	tx, err := s.Begin(ctx, query.TxSettings(query.WithSerializableReadWrite(), query.WithLazyBegin()))
+	require.NoError(t, err)
+	require.Equal(t, query.LazyTxID, tx.ID())
+
+	client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			require.NotNil(t, request.GetTxControl().GetBeginTx().GetSerializableReadWrite())
+			stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+			stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+				Status: Ydb.StatusIds_SUCCESS,
+				TxMeta: &Ydb_Query.TransactionMeta{
+					Id: "456",
+				},
+			}, nil)
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+
+			return stream, nil
+		},
+	)
+	require.NoError(t, tx.Exec(ctx, ""))
+	require.Equal(t, "456", tx.ID())
+
+	client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			require.Equal(t, "456", request.GetTxControl().GetTxId())
+			stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+			stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil)
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+
+			return stream, nil
+		},
+	)
+	require.NoError(t, tx.Exec(ctx, ""))
+	require.Equal(t, "456", tx.ID())
+}
+
+func TestExecuteBatch(t *testing.T) {
+	selectStream := func(ctrl *gomock.Controller, txID string, v uint64) Ydb_Query_V1.QueryService_ExecuteQueryClient {
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+			TxMeta: &Ydb_Query.TransactionMeta{
+				Id: txID,
+			},
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{
+					{
+						Name: "v",
+						Type: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_UINT64,
+							},
+						},
+					},
+				},
+				Rows: []*Ydb.Value{
+					{
+						Items: []*Ydb.Value{{
+							Value: &Ydb.Value_Uint64Value{
+								Uint64Value: v,
+							},
+						}},
+					},
+				},
+			},
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+
+		return stream
+	}
+	execStream := func(ctrl *gomock.Controller, txID string) Ydb_Query_V1.QueryService_ExecuteQueryClient {
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+			TxMeta: &Ydb_Query.TransactionMeta{
+				Id: txID,
+			},
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+
+		return stream
+	}
+	failedStream := func(ctrl *gomock.Controller) Ydb_Query_V1.QueryService_ExecuteQueryClient {
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(nil,
+			xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST)),
+		)
+
+		return stream
+	}
+	readValue := func(t *testing.T, ctx context.Context, r query.Result) (v uint64) {
+		rs, err := r.NextResultSet(ctx)
+		require.NoError(t, err)
+		row, err := rs.NextRow(ctx)
+		require.NoError(t, err)
+		require.NoError(t, row.Scan(&v))
+		_, err = r.NextResultSet(ctx)
+		require.ErrorIs(t, err, io.EOF)
+
+		return v
+	}
+	t.Run("OK", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		queries := []string{
+			"SELECT 1",
+			"UPSERT INTO t (id) VALUES (1) -- without result sets",
+			"SELECT 3",
+		}
+		var commits []bool
+		for i := range queries {
+			i := i
+			client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+					Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+				) {
+					require.Equal(t, queries[i], request.GetQueryContent().GetText())
+					commits = append(commits, request.GetTxControl().GetCommitTx())
+					if i == 1 {
+						return execStream(ctrl, "456"), nil
+					}
+
+					return selectStream(ctrl, "456", uint64(i+1)), nil
+				},
+			)
+		}
+		s := newTestSessionWithClient("123", client, true)
+		tx, err := s.Begin(ctx, query.TxSettings(query.WithSerializableReadWrite()))
+		require.NoError(t, err)
+		results, err := tx.ExecuteBatch(ctx, queries, options.WithCommit())
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.EqualValues(t, 1, readValue(t, ctx, results[0]))
+		for {
+			rs, err := results[1].NextResultSet(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			_, err = rs.NextRow(ctx)
+			require.ErrorIs(t, err, io.EOF, "statement without result sets has no rows")
+		}
+		require.EqualValues(t, 3, readValue(t, ctx, results[2]))
+		require.Equal(t, []bool{false, false, true}, commits)
+	})
+	t.Run("FailedInTheMiddle", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				return selectStream(ctrl, "456", 1), nil
+			},
+		)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.Equal(t, "456", request.GetTxControl().GetTxId())
+
+				return failedStream(ctrl), nil
+			},
+		)
+		s := newTestSessionWithClient("123", client, true)
+		tx, err := s.Begin(ctx, query.TxSettings(query.WithSerializableReadWrite()))
+		require.NoError(t, err)
+		results, err := tx.ExecuteBatch(ctx, []string{"SELECT 1", "SELECT x FROM unknown", "SELECT 3"})
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+		require.Len(t, results, 1)
+		require.EqualValues(t, 1, readValue(t, ctx, results[0]))
+	})
+}
+
+func TestRunAll(t *testing.T) {
+	execStream := func(ctrl *gomock.Controller, err error) Ydb_Query_V1.QueryService_ExecuteQueryClient {
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		if err != nil {
+			stream.EXPECT().Recv().Return(nil, err)
+
+			return stream
+		}
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+			TxMeta: &Ydb_Query.TransactionMeta{
+				Id: "456",
+			},
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+
+		return stream
+	}
+	expectExec := func(ctrl *gomock.Controller, client *MockQueryServiceClient, q string, err error) {
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.Equal(t, q, request.GetQueryContent().GetText())
+				require.False(t, request.GetTxControl().GetCommitTx())
+
+				return execStream(ctrl, err), nil
+			},
+		)
+	}
+	t.Run("OK", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		expectExec(ctrl, client, "UPSERT 1", nil)
+		expectExec(ctrl, client, "UPSERT 2", nil)
+		client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.CommitTransactionRequest, opts ...grpc.CallOption) (
+				*Ydb_Query.CommitTransactionResponse, error,
+			) {
+				require.Equal(t, "456", request.GetTxId())
+
+				return &Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil
+			},
+		)
+		s := newTestSessionWithClient("123", client, true)
+		tx, err := s.Begin(ctx, query.TxSettings(query.WithSerializableReadWrite()))
+		require.NoError(t, err)
+		require.NoError(t, tx.RunAll(ctx, []string{"UPSERT 1", "UPSERT 2"}, options.WithCommit()))
+	})
+	t.Run("FailedInTheMiddle", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		expectExec(ctrl, client, "UPSERT 1", nil)
+		expectExec(ctrl, client, "UPSERT x", xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST)))
+		client.EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.RollbackTransactionRequest, opts ...grpc.CallOption) (
+				*Ydb_Query.RollbackTransactionResponse, error,
+			) {
+				require.Equal(t, "456", request.GetTxId())
+
+				return &Ydb_Query.RollbackTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil
+			},
+		)
+		s := newTestSessionWithClient("123", client, true)
+		tx, err := s.Begin(ctx, query.TxSettings(query.WithSerializableReadWrite()))
+		require.NoError(t, err)
+		err = tx.RunAll(ctx, []string{"UPSERT 1", "UPSERT x", "UPSERT 3"})
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+		var statementErr *query.TxStatementError
+		require.ErrorAs(t, err, &statementErr)
+		require.Equal(t, 1, statementErr.Index)
+		require.Contains(t, err.Error(), "statement #1 failed")
+	})
+	t.Run("CommitFailed", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		expectExec(ctrl, client, "UPSERT 1", nil)
+		client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(nil,
+			xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED)),
+		)
+		s := newTestSessionWithClient("123", client, true)
+		tx, err := s.Begin(ctx, query.TxSettings(query.WithSerializableReadWrite()))
+		require.NoError(t, err)
+		err = tx.RunAll(ctx, []string{"UPSERT 1"})
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_ABORTED))
+		var statementErr *query.TxStatementError
+		require.False(t, errors.As(err, &statementErr))
+	})
+}
+
+func TestTxLifecycleTrace(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	client := NewMockQueryServiceClient(ctrl)
+	client.EXPECT().BeginTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.BeginTransactionResponse{
+		Status: Ydb.StatusIds_SUCCESS,
+		TxMeta: &Ydb_Query.TransactionMeta{
+			Id: "456",
+		},
+	}, nil)
+	client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+			stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+				Status: Ydb.StatusIds_SUCCESS,
+			}, nil)
+			stream.EXPECT().Recv().Return(nil, io.EOF)
+
+			return stream, nil
+		},
+	)
+	client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).Return(&Ydb_Query.CommitTransactionResponse{
+		Status: Ydb.StatusIds_SUCCESS,
+	}, nil)
+
+	var events []string
+	s := newTestSessionWithClient("123", client, false)
+	s.trace = &trace.Query{
+		OnTxBegin: func(info trace.QueryTxBeginStartInfo) func(trace.QueryTxBeginDoneInfo) {
+			require.Equal(t, "SnapshotReadOnly", info.TxMode)
+
+			return func(info trace.QueryTxBeginDoneInfo) {
+				require.Positive(t, info.Duration)
+				events = append(events, "begin:"+info.TxID)
+			}
+		},
+		OnTxExec: func(info trace.QueryTxExecStartInfo) func(trace.QueryTxExecDoneInfo) {
+			events = append(events, "exec:"+info.Tx.ID()+":"+info.TxMode)
+
+			return func(info trace.QueryTxExecDoneInfo) {
+				require.Positive(t, info.Duration)
+			}
+		},
+		OnTxCommit: func(info trace.QueryTxCommitStartInfo) func(trace.QueryTxCommitDoneInfo) {
+			events = append(events, "commit:"+info.Tx.ID()+":"+info.TxMode)
+
+			return func(info trace.QueryTxCommitDoneInfo) {
+				require.Positive(t, info.Duration)
+			}
+		},
+		OnTxRollback: func(info trace.QueryTxRollbackStartInfo) func(trace.QueryTxRollbackDoneInfo) {
+			events = append(events, "rollback:"+info.Tx.ID()+":"+info.TxMode)
+
+			return func(info trace.QueryTxRollbackDoneInfo) {
+				require.Positive(t, info.Duration)
+			}
+		},
+	}
+
+	tx, err := s.Begin(ctx, query.TxSettings(query.WithSnapshotReadOnly()))
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, ""))
+	require.NoError(t, tx.CommitTx(ctx))
+	require.NoError(t, tx.Rollback(ctx))
+	require.Equal(t, []string{"begin:456", "exec:456:SnapshotReadOnly", "commit:456:SnapshotReadOnly"}, events)
+}
+
 func TestCommitTx(t *testing.T) {
 	t.Run("HappyWay", func(t *testing.T) {
 		ctx := xtest.Context(t)
@@ -108,6 +468,114 @@ func TestCommitTx(t *testing.T) {
 	})
 }
 
+type countingBudget struct {
+	acquired int
+	err      error
+}
+
+func (b *countingBudget) Acquire(context.Context) error {
+	b.acquired++
+
+	return b.err
+}
+
+func TestCommitRetry(t *testing.T) {
+	commit := func(t *testing.T, b *countingBudget, settings query.TransactionSettings, errs ...error) (int, error) {
+		t.Helper()
+
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		calls := 0
+		client.EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(context.Context, *Ydb_Query.CommitTransactionRequest, ...grpc.CallOption) (
+				*Ydb_Query.CommitTransactionResponse, error,
+			) {
+				calls++
+				if calls <= len(errs) {
+					return nil, errs[calls-1]
+				}
+
+				return &Ydb_Query.CommitTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil
+			},
+		).AnyTimes()
+		s := newTestSessionWithClient("123", client, false)
+		s.retryBudget = b
+		tx := &Transaction{
+			LazyID:     baseTx.ID("456"),
+			s:          s,
+			txSettings: settings,
+		}
+
+		return calls, tx.CommitTx(xtest.Context(t))
+	}
+	overloaded := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+	t.Run("NotSentThenSuccess", func(t *testing.T) {
+		b := &countingBudget{}
+		calls, err := commit(t, b, query.TxSettings(query.WithCommitRetry(3)),
+			xerrors.WithStackTrace(balancer.ErrNoEndpoints),
+			xerrors.Transport(grpcStatus.Error(grpcCodes.ResourceExhausted, "")),
+			overloaded,
+		)
+		require.NoError(t, err)
+		require.Equal(t, 4, calls)
+		require.Equal(t, 3, b.acquired)
+	})
+	for _, tt := range []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "Aborted",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED)),
+		},
+		{
+			name: "Undetermined",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNDETERMINED)),
+		},
+		{
+			name: "Timeout",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_TIMEOUT)),
+		},
+		{
+			name: "PreconditionFailed",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_PRECONDITION_FAILED)),
+		},
+		{
+			name: "TransportUnavailable",
+			err:  xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &countingBudget{}
+			calls, err := commit(t, b, query.TxSettings(query.WithCommitRetry(3)), tt.err)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, 1, calls, "commit with possible outcome mustn't be repeated")
+			require.Equal(t, 0, b.acquired)
+		})
+	}
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		b := &countingBudget{}
+		calls, err := commit(t, b, query.TxSettings(query.WithCommitRetry(1)), overloaded, overloaded, overloaded)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+		require.Equal(t, 2, calls)
+		require.Equal(t, 1, b.acquired)
+	})
+	t.Run("NoQuota", func(t *testing.T) {
+		errNoQuota := errors.New("no quota")
+		b := &countingBudget{err: errNoQuota}
+		calls, err := commit(t, b, query.TxSettings(query.WithCommitRetry(3)), overloaded)
+		require.ErrorIs(t, err, errNoQuota)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("WithoutOption", func(t *testing.T) {
+		calls, err := commit(t, &countingBudget{}, query.TxSettings(), overloaded)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+		require.Equal(t, 1, calls)
+	})
+}
+
 func TestTxOnCompleted(t *testing.T) {
 	t.Run("OnCommitTxSuccess", func(t *testing.T) {
 		e := fixenv.New(t)
@@ -145,9 +613,61 @@ func TestTxOnCompleted(t *testing.T) {
 		})
 		err := tx.CommitTx(sf.Context(e))
 		require.ErrorIs(t, err, testError)
+		require.NotErrorIs(t, err, query.ErrCommitOutcomeUnknown)
 		require.Len(t, completed, 1)
 		require.ErrorIs(t, completed[0], err)
 	})
+	t.Run("OnCommitTxContextDone", func(t *testing.T) {
+		e := fixenv.New(t)
+
+		QueryGrpcMock(e).EXPECT().CommitTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(
+				ctx context.Context,
+				request *Ydb_Query.CommitTransactionRequest,
+				option ...grpc.CallOption,
+			) (
+				*Ydb_Query.CommitTransactionResponse,
+				error,
+			) {
+				// slow server
+				<-ctx.Done()
+
+				return nil, grpcStatus.FromContextError(ctx.Err()).Err()
+			})
+
+		tx := TransactionOverGrpcMock(e)
+
+		QueryGrpcMock(e).EXPECT().RollbackTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(
+				ctx context.Context,
+				request *Ydb_Query.RollbackTransactionRequest,
+				option ...grpc.CallOption,
+			) (
+				*Ydb_Query.RollbackTransactionResponse,
+				error,
+			) {
+				require.NoError(t, ctx.Err())
+				require.Equal(t, tx.ID(), request.GetTxId())
+
+				return &Ydb_Query.RollbackTransactionResponse{
+					Status: Ydb.StatusIds_SUCCESS,
+				}, nil
+			})
+
+		var completed []error
+		tx.OnCompleted(func(transactionResult error) {
+			completed = append(completed, transactionResult)
+		})
+
+		ctx, cancel := context.WithTimeout(sf.Context(e), 10*time.Millisecond)
+		defer cancel()
+
+		err := tx.CommitTx(ctx)
+		require.ErrorIs(t, err, query.ErrCommitOutcomeUnknown)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Len(t, completed, 1)
+		require.ErrorIs(t, completed[0], query.ErrCommitOutcomeUnknown)
+	})
 	t.Run("OnRollback", func(t *testing.T) {
 		e := fixenv.New(t)
 
@@ -43,6 +43,20 @@ func (ctrl *Control) Selector() Selector {
 	return ctrl.selector
 }
 
+// BeginTx reports whether ctrl begins a new transaction
+func (ctrl *Control) BeginTx() bool {
+	if ctrl == nil {
+		return false
+	}
+
+	switch ctrl.selector.(type) {
+	case beginTxOptions, Settings:
+		return true
+	default:
+		return false
+	}
+}
+
 var (
 	_ ControlOption = beginTxOptions{}
 	_ Selector      = beginTxOptions{}
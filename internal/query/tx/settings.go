@@ -54,6 +54,54 @@ func (opts Settings) ToYDB(a *allocator.Allocator) *Ydb_Query.TransactionSetting
 	return txSettings
 }
 
+// IsolationStrength returns relative strength of isolation guarantees of transaction mode.
+// Greater value means stronger guarantees: consistent online reads are stronger than
+// possibly stale reads, which are stronger than inconsistent online reads
+func (opts Settings) IsolationStrength() int {
+	a := allocator.New()
+	defer a.Free()
+
+	switch mode := opts.ToYDB(a).GetTxMode().(type) {
+	case *Ydb_Query.TransactionSettings_SerializableReadWrite:
+		return 4 //nolint:gomnd
+	case *Ydb_Query.TransactionSettings_SnapshotReadOnly:
+		return 3 //nolint:gomnd
+	case *Ydb_Query.TransactionSettings_OnlineReadOnly:
+		if mode.OnlineReadOnly.GetAllowInconsistentReads() {
+			return 0
+		}
+
+		return 2 //nolint:gomnd
+	case *Ydb_Query.TransactionSettings_StaleReadOnly:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// TxMode returns name of transaction mode or empty string if mode is not specified
+func (opts Settings) TxMode() string {
+	a := allocator.New()
+	defer a.Free()
+
+	switch mode := opts.ToYDB(a).GetTxMode().(type) {
+	case *Ydb_Query.TransactionSettings_SerializableReadWrite:
+		return "SerializableReadWrite"
+	case *Ydb_Query.TransactionSettings_SnapshotReadOnly:
+		return "SnapshotReadOnly"
+	case *Ydb_Query.TransactionSettings_StaleReadOnly:
+		return "StaleReadOnly"
+	case *Ydb_Query.TransactionSettings_OnlineReadOnly:
+		if mode.OnlineReadOnly.GetAllowInconsistentReads() {
+			return "OnlineReadOnlyInconsistentReads"
+		}
+
+		return "OnlineReadOnly"
+	default:
+		return ""
+	}
+}
+
 // NewSettings returns transaction settings
 func NewSettings(opts ...Option) Settings {
 	return opts
@@ -147,3 +195,56 @@ func (opts onlineReadOnlySettingsOption) ApplyTxSettingsOption(
 func WithOnlineReadOnly(opts ...OnlineReadOnlyOption) onlineReadOnlySettingsOption {
 	return opts
 }
+
+var _ Option = lazyBeginTxSettingsOption{}
+
+type lazyBeginTxSettingsOption struct{}
+
+// ApplyTxSettingsOption does nothing because lazy begin is a client-side behaviour
+func (lazyBeginTxSettingsOption) ApplyTxSettingsOption(
+	a *allocator.Allocator, settings *Ydb_Query.TransactionSettings,
+) {
+}
+
+// WithLazyBegin defers begin of transaction until the first query in transaction.
+// Begin flag attaches to request of the first query instead of separated BeginTransaction call.
+func WithLazyBegin() Option {
+	return lazyBeginTxSettingsOption{}
+}
+
+// IsLazyBegin reports whether settings contains WithLazyBegin option
+func (opts Settings) IsLazyBegin() bool {
+	for _, opt := range opts {
+		if _, has := opt.(lazyBeginTxSettingsOption); has {
+			return true
+		}
+	}
+
+	return false
+}
+
+var _ Option = commitRetryTxSettingsOption(0)
+
+type commitRetryTxSettingsOption int
+
+// ApplyTxSettingsOption does nothing because commit retry is a client-side behaviour
+func (commitRetryTxSettingsOption) ApplyTxSettingsOption(
+	a *allocator.Allocator, settings *Ydb_Query.TransactionSettings,
+) {
+}
+
+// WithCommitRetry makes commit of transaction retry errors raised before processing of commit up to n times
+func WithCommitRetry(n int) Option {
+	return commitRetryTxSettingsOption(n)
+}
+
+// CommitRetry returns max number of commit retries from WithCommitRetry option (0 without option)
+func (opts Settings) CommitRetry() (n int) {
+	for _, opt := range opts {
+		if commitRetry, has := opt.(commitRetryTxSettingsOption); has {
+			n = int(commitRetry)
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,41 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsolationStrength(t *testing.T) {
+	// modes in order from the weakest to the strongest isolation guarantees
+	for i, tt := range []struct {
+		name     string
+		settings Settings
+	}{
+		{
+			name:     "OnlineReadOnlyInconsistentReads",
+			settings: NewSettings(WithOnlineReadOnly(WithInconsistentReads())),
+		},
+		{
+			name:     "StaleReadOnly",
+			settings: NewSettings(WithStaleReadOnly()),
+		},
+		{
+			name:     "OnlineReadOnly",
+			settings: NewSettings(WithOnlineReadOnly()),
+		},
+		{
+			name:     "SnapshotReadOnly",
+			settings: NewSettings(WithSnapshotReadOnly()),
+		},
+		{
+			name:     "SerializableReadWrite",
+			settings: NewSettings(WithSerializableReadWrite()),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.name, tt.settings.TxMode())
+			require.Equal(t, i, tt.settings.IsolationStrength())
+		})
+	}
+}
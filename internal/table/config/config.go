@@ -154,6 +154,14 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithTxQueryCache enables cache of compiled queries within transaction. Repeated execution of
+// the same query text within transaction executes query by id of compiled query
+func WithTxQueryCache() Option {
+	return func(c *Config) {
+		c.txQueryCache = true
+	}
+}
+
 // WithClock replaces default clock
 func WithClock(clock clockwork.Clock) Option {
 	return func(c *Config) {
@@ -173,6 +181,7 @@ type Config struct {
 	idleThreshold        time.Duration
 
 	ignoreTruncated bool
+	txQueryCache    bool
 
 	trace *trace.Table
 
@@ -217,6 +226,11 @@ func (c *Config) IgnoreTruncated() bool {
 	return c.ignoreTruncated
 }
 
+// TxQueryCache specifies cache of compiled queries within transaction
+func (c *Config) TxQueryCache() bool {
+	return c.txQueryCache
+}
+
 // IdleKeepAliveThreshold is a number of keepAlive messages to call before the
 // session is removed if it is an excess session (see KeepAliveMinSize)
 // This means that session will be deleted after the expiration of lifetime = IdleThreshold * IdleKeepAliveThreshold
@@ -790,6 +790,19 @@ func (s *session) Execute(ctx context.Context, txControl *table.TransactionContr
 	opts ...options.ExecuteDataQueryOption,
 ) (
 	txr table.Transaction, r result.Result, err error,
+) {
+	txr, r, _, err = s.executeText(ctx, txControl, sql, params, false, opts...)
+
+	return txr, r, err
+}
+
+// executeText executes data query represented by text and returns id of compiled query
+// if query is kept in server cache of session. Queries with params are always kept in cache,
+// keepInCache forces caching of queries without params. Options of query override cache policy
+func (s *session) executeText(ctx context.Context, txControl *table.TransactionControl, sql string,
+	params *params.Params, keepInCache bool, opts ...options.ExecuteDataQueryOption,
+) (
+	txr table.Transaction, r result.Result, queryID string, err error,
 ) {
 	var (
 		a       = allocator.New()
@@ -804,7 +817,7 @@ func (s *session) Execute(ctx context.Context, txControl *table.TransactionContr
 
 	parameters, err := params.ToYDB(a)
 	if err != nil {
-		return nil, nil, xerrors.WithStackTrace(err)
+		return nil, nil, "", xerrors.WithStackTrace(err)
 	}
 
 	request.SessionId = s.id
@@ -812,7 +825,7 @@ func (s *session) Execute(ctx context.Context, txControl *table.TransactionContr
 	request.Parameters = parameters
 	request.Query = q.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
-	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
+	request.QueryCachePolicy.KeepInCache = keepInCache || len(request.Parameters) > 0
 	request.OperationParams = operation.Params(ctx,
 		s.config.OperationTimeout(),
 		s.config.OperationCancelAfter(),
@@ -837,10 +850,17 @@ func (s *session) Execute(ctx context.Context, txControl *table.TransactionContr
 
 	result, err := s.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
 	if err != nil {
-		return nil, nil, xerrors.WithStackTrace(err)
+		return nil, nil, "", xerrors.WithStackTrace(err)
+	}
+
+	queryID = result.GetQueryMeta().GetId()
+
+	txr, r, err = s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated)
+	if err != nil {
+		return nil, nil, "", xerrors.WithStackTrace(err)
 	}
 
-	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated)
+	return txr, r, queryID, nil
 }
 
 // executeQueryResult returns Transaction and result built from received
@@ -397,6 +397,65 @@ func TestSessionOperationModeOnExecuteDataQuery(t *testing.T) {
 	}
 }
 
+func TestSessionExecuteKeepInCache(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		params      *table.QueryParameters
+		opts        []options.ExecuteDataQueryOption
+		keepInCache bool
+	}{
+		{
+			name:        "WithoutParams",
+			params:      table.NewQueryParameters(),
+			keepInCache: false,
+		},
+		{
+			name:        "WithParams",
+			params:      table.NewQueryParameters(table.ValueParam("$a", value.Int32Value(1))),
+			keepInCache: true,
+		},
+		{
+			name:        "WithParamsWithoutKeepInCache",
+			params:      table.NewQueryParameters(table.ValueParam("$a", value.Int32Value(1))),
+			opts:        []options.ExecuteDataQueryOption{options.WithoutKeepInCache()},
+			keepInCache: false,
+		},
+		{
+			name:        "WithoutParamsWithKeepInCache",
+			params:      table.NewQueryParameters(),
+			opts:        []options.ExecuteDataQueryOption{options.WithKeepInCache(true)},
+			keepInCache: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var keepInCache *bool
+			s := &session{
+				client: Ydb_Table_V1.NewTableServiceClient(testutil.NewBalancer(
+					testutil.WithInvokeHandlers(
+						testutil.InvokeHandlers{
+							testutil.TableExecuteDataQuery: func(request interface{}) (proto.Message, error) {
+								r, ok := request.(*Ydb_Table.ExecuteDataQueryRequest)
+								require.True(t, ok)
+								v := r.GetQueryCachePolicy().GetKeepInCache()
+								keepInCache = &v
+
+								return &Ydb_Table.ExecuteQueryResult{
+									TxMeta: &Ydb_Table.TransactionMeta{},
+								}, nil
+							},
+						},
+					),
+				)),
+				config: config.New(),
+			}
+			_, _, err := s.Execute(xtest.Context(t), table.TxControl(), "", tt.params, tt.opts...)
+			require.NoError(t, err)
+			require.NotNil(t, keepInCache)
+			require.Equal(t, tt.keepInCache, *keepInCache)
+		})
+	}
+}
+
 func TestCreateTableRegression(t *testing.T) {
 	client := New(context.Background(), testutil.NewBalancer(
 		testutil.WithInvokeHandlers(
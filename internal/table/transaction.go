@@ -14,6 +14,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
@@ -53,6 +54,9 @@ type transaction struct {
 	s       *session
 	control *table.TransactionControl
 	state   txState
+
+	// queries are ids of compiled queries by query text (see config.WithTxQueryCache)
+	queries xsync.Map[string, string]
 }
 
 // Execute executes query represented by text within transaction tx.
@@ -74,17 +78,56 @@ func (tx *transaction) Execute(ctx context.Context, sql string, params *params.P
 	case txStateRollbacked:
 		return nil, xerrors.WithStackTrace(errTxRollbackedEarly)
 	default:
-		_, r, err = tx.s.Execute(ctx, tx.control, sql, params, opts...)
+		if tx.s.config.TxQueryCache() {
+			r, err = tx.executeCached(ctx, sql, params, opts...)
+		} else {
+			_, r, err = tx.s.Execute(ctx, tx.control, sql, params, opts...)
+		}
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
 		}
 
 		if tx.control.Desc().GetCommitTx() {
 			tx.state.Store(txStateCommitted)
+			tx.queries.Clear()
+		}
+
+		return r, nil
+	}
+}
+
+// executeCached executes query by id of compiled query if the same query text was executed
+// within transaction before. Otherwise query text is executed with keeping of compiled query
+// in server cache of session
+func (tx *transaction) executeCached(ctx context.Context, sql string, params *params.Params,
+	opts ...options.ExecuteDataQueryOption,
+) (result.Result, error) {
+	if id, has := tx.queries.Get(sql); has {
+		stmt := &statement{
+			session: tx.s,
+			query:   queryPrepared(id, sql),
+		}
+		_, r, err := stmt.Execute(ctx, tx.control, params, opts...)
+		if err != nil {
+			// compiled query may be evicted from server cache, so query text is compiled again on next execution
+			tx.queries.Delete(sql)
+
+			return nil, xerrors.WithStackTrace(err)
 		}
 
 		return r, nil
 	}
+
+	_, r, id, err := tx.s.executeText(ctx, tx.control, sql, params, true, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if id != "" {
+		tx.queries.Set(sql, id)
+	}
+
+	return r, nil
 }
 
 // ExecuteStatement executes prepared statement stmt within transaction tx.
@@ -181,6 +224,7 @@ func (tx *transaction) CommitTx(
 		}
 
 		tx.state.Store(txStateCommitted)
+		tx.queries.Clear()
 
 		return scanner.NewUnary(
 			nil,
@@ -224,6 +268,7 @@ func (tx *transaction) Rollback(ctx context.Context) (err error) {
 		}
 
 		tx.state.Store(txStateRollbacked)
+		tx.queries.Clear()
 
 		return nil
 	}
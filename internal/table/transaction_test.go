@@ -4,11 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
@@ -125,3 +127,97 @@ func TestTxSkipRollbackForCommitted(t *testing.T) {
 		}
 	}
 }
+
+func TestTxQueryCache(t *testing.T) {
+	var requests []*Ydb_Table.ExecuteDataQueryRequest
+	newTestSession := func(t *testing.T, opts ...config.Option) *session {
+		requests = nil
+		s, err := newSession(context.Background(), testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableBeginTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{Id: "tx"},
+						}, nil
+					},
+					testutil.TableExecuteDataQuery: func(request interface{}) (proto.Message, error) {
+						r, ok := request.(*Ydb_Table.ExecuteDataQueryRequest)
+						if !ok {
+							t.Fatalf("cannot cast request '%T' to *Ydb_Table.ExecuteDataQueryRequest", request)
+						}
+						// request is recycled by allocator after call
+						r, ok = proto.Clone(r).(*Ydb_Table.ExecuteDataQueryRequest)
+						require.True(t, ok)
+						requests = append(requests, r)
+						result := &Ydb_Table.ExecuteQueryResult{
+							TxMeta: &Ydb_Table.TransactionMeta{Id: "tx"},
+						}
+						if r.GetQueryCachePolicy().GetKeepInCache() {
+							result.QueryMeta = &Ydb_Table.QueryMeta{Id: "compiled-" + r.GetQuery().GetYqlText()}
+						}
+
+						return result, nil
+					},
+					testutil.TableCommitTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CommitTransactionResult{}, nil
+					},
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+				},
+			),
+		), config.New(opts...))
+		require.NoError(t, err)
+
+		return s
+	}
+	ctx := context.Background()
+
+	t.Run("Enabled", func(t *testing.T) {
+		s := newTestSession(t, config.WithTxQueryCache())
+		x, err := s.BeginTransaction(ctx, table.TxSettings())
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			_, err = x.Execute(ctx, "SELECT 1", nil)
+			require.NoError(t, err)
+		}
+		_, err = x.Execute(ctx, "SELECT 2", nil)
+		require.NoError(t, err)
+
+		require.Len(t, requests, 4)
+		require.Equal(t, "SELECT 1", requests[0].GetQuery().GetYqlText())
+		require.True(t, requests[0].GetQueryCachePolicy().GetKeepInCache())
+		require.Equal(t, "compiled-SELECT 1", requests[1].GetQuery().GetId())
+		require.Equal(t, "compiled-SELECT 1", requests[2].GetQuery().GetId())
+		require.Equal(t, "SELECT 2", requests[3].GetQuery().GetYqlText())
+
+		tx, ok := x.(*transaction)
+		require.True(t, ok)
+		require.Equal(t, 2, tx.queries.Len())
+		_, err = x.CommitTx(ctx)
+		require.NoError(t, err)
+		require.Zero(t, tx.queries.Len())
+
+		x, err = s.BeginTransaction(ctx, table.TxSettings())
+		require.NoError(t, err)
+		_, err = x.Execute(ctx, "SELECT 1", nil)
+		require.NoError(t, err)
+		require.Equal(t, "SELECT 1", requests[4].GetQuery().GetYqlText(), "cache must be scoped by transaction")
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		s := newTestSession(t)
+		x, err := s.BeginTransaction(ctx, table.TxSettings())
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			_, err = x.Execute(ctx, "SELECT 1", nil)
+			require.NoError(t, err)
+		}
+		require.Len(t, requests, 2)
+		for _, r := range requests {
+			require.Equal(t, "SELECT 1", r.GetQuery().GetYqlText())
+			require.False(t, r.GetQueryCachePolicy().GetKeepInCache())
+		}
+	})
+}
@@ -1,5 +1,16 @@
 package value
 
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// structFieldTagName is a tag name of struct fields which defines name of YDB struct field
+// (same as tag name of struct args of database/sql)
+const structFieldTagName = "sql"
+
 func CastTo(v Value, dst interface{}) error {
 	if dst == nil {
 		return errNilDestination
@@ -12,3 +23,89 @@ func CastTo(v Value, dst interface{}) error {
 
 	return v.castTo(dst)
 }
+
+// containerDestination returns value of destination pointer if it points to value of kind
+func containerDestination(v Value, dst interface{}, kind reflect.Kind) (reflect.Value, error) {
+	ptr := reflect.ValueOf(dst)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != kind {
+		return reflect.Value{}, xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%s(%+v)' to '%T' destination",
+			ErrCannotCast, v.Type().Yql(), v, dst,
+		))
+	}
+
+	return ptr.Elem(), nil
+}
+
+// castListTo casts list items into slice which dst points to
+func castListTo(v Value, items []Value, dst interface{}) error {
+	slice, err := containerDestination(v, dst, reflect.Slice)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	list := reflect.MakeSlice(slice.Type(), len(items), len(items))
+	for i := range items {
+		if err := CastTo(items[i], list.Index(i).Addr().Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot cast %d item of list: %w", i, err))
+		}
+	}
+	slice.Set(list)
+
+	return nil
+}
+
+// castDictTo casts dict values into map which dst points to
+func castDictTo(v Value, values []DictValueField, dst interface{}) error {
+	m, err := containerDestination(v, dst, reflect.Map)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	dict := reflect.MakeMapWithSize(m.Type(), len(values))
+	for i := range values {
+		k := reflect.New(m.Type().Key())
+		if err := CastTo(values[i].K, k.Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot cast dict key %s: %w", values[i].K.Yql(), err))
+		}
+		vv := reflect.New(m.Type().Elem())
+		if err := CastTo(values[i].V, vv.Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot cast dict value of key %s: %w", values[i].K.Yql(), err))
+		}
+		dict.SetMapIndex(k.Elem(), vv.Elem())
+	}
+	m.Set(dict)
+
+	return nil
+}
+
+// castStructTo casts struct fields into fields of struct which dst points to.
+// Fields of destination struct are mapped by sql tag
+func castStructTo(v Value, fields []StructValueField, dst interface{}) error {
+	s, err := containerDestination(v, dst, reflect.Struct)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	index := make(map[string]int, s.NumField())
+	for i := 0; i < s.NumField(); i++ {
+		if name, has := s.Type().Field(i).Tag.Lookup(structFieldTagName); has && s.Field(i).CanSet() {
+			index[name] = i
+		}
+	}
+
+	for i := range fields {
+		j, has := index[fields[i].Name]
+		if !has {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w: struct field '%s' has no destination field with tag `%s:%q` in %T",
+				ErrCannotCast, fields[i].Name, structFieldTagName, fields[i].Name, dst,
+			))
+		}
+		if err := CastTo(fields[i].V, s.Field(j).Addr().Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot cast struct field '%s': %w", fields[i].Name, err))
+		}
+	}
+
+	return nil
+}
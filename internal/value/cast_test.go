@@ -2,12 +2,15 @@ package value
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 )
 
@@ -166,6 +169,27 @@ func TestCastTo(t *testing.T) {
 			exp:   []byte(`{"test":"text"}"`),
 			err:   nil,
 		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: JSONDocumentValue(`{"test":"text"}`),
+			dst:   ptr[json.RawMessage](),
+			exp:   json.RawMessage(`{"test":"text"}`),
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: JSONValue(`{"test":"text"}`),
+			dst:   ptr[json.RawMessage](),
+			exp:   json.RawMessage(`{"test":"text"}`),
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: OptionalValue(JSONValue(`{"test":"text"}`)),
+			dst:   ptr[*json.RawMessage](),
+			exp:   value2ptr(json.RawMessage(`{"test":"text"}`)),
+			err:   nil,
+		},
 
 		{
 			name:  xtest.CurrentFileLine(),
@@ -428,6 +452,97 @@ func TestCastTo(t *testing.T) {
 			exp:   DateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
 			err:   nil,
 		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: DecimalValue([16]byte{15: 1}, 22, 9),
+			dst:   ptr[decimal.Decimal](),
+			exp:   decimal.Decimal{Bytes: [16]byte{15: 1}, Precision: 22, Scale: 9},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: OptionalValue(DecimalValue([16]byte{15: 1}, 22, 9)),
+			dst:   ptr[*decimal.Decimal](),
+			exp:   &decimal.Decimal{Bytes: [16]byte{15: 1}, Precision: 22, Scale: 9},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: ListValue(Int32Value(1), Int32Value(2), Int32Value(3)),
+			dst:   ptr[[]int32](),
+			exp:   []int32{1, 2, 3},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: ListValue(OptionalValue(Int32Value(1)), NullValue(types.Int32)),
+			dst:   ptr[[]*int32](),
+			exp:   []*int32{value2ptr(int32(1)), nil},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: ZeroValue(types.NewList(types.Int32)),
+			dst:   ptr[[]int32](),
+			exp:   []int32{},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: ListValue(TextValue("1")),
+			dst:   ptr[[]int32](),
+			err:   ErrCannotCast,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: ListValue(Int32Value(1)),
+			dst:   ptr[map[int32]int32](),
+			err:   ErrCannotCast,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			value: DictValue(
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+			),
+			dst: ptr[map[string]int32](),
+			exp: map[string]int32{"a": 1, "b": 2},
+			err: nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: ZeroValue(types.NewDict(types.Text, types.Int32)),
+			dst:   ptr[map[string]int32](),
+			exp:   map[string]int32{},
+			err:   nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			value: StructValue(
+				StructValueField{Name: "id", V: Int32Value(1)},
+				StructValueField{Name: "name", V: TextValue("test")},
+			),
+			dst: ptr[struct {
+				ID   int32  `sql:"id"`
+				Name string `sql:"name"`
+			}](),
+			exp: struct {
+				ID   int32  `sql:"id"`
+				Name string `sql:"name"`
+			}{ID: 1, Name: "test"},
+			err: nil,
+		},
+		{
+			name: xtest.CurrentFileLine(),
+			value: StructValue(
+				StructValueField{Name: "id", V: Int32Value(1)},
+				StructValueField{Name: "unknown", V: TextValue("test")},
+			),
+			dst: ptr[struct {
+				ID int32 `sql:"id"`
+			}](),
+			err: ErrCannotCast,
+		},
 	}
 	for _, tt := range testsCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -531,25 +646,31 @@ func TestCastToDriverValue(t *testing.T) {
 		{
 			name:  xtest.CurrentFileLine(),
 			value: JSONDocumentValue(`{"test": "text"}"`),
-			exp:   `{"test": "text"}"`,
+			exp:   []byte(`{"test": "text"}"`),
 			err:   nil,
 		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: JSONDocumentValue(`{"test":"text"}"`),
-			exp:   `{"test":"text"}"`,
+			exp:   []byte(`{"test":"text"}"`),
 			err:   nil,
 		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: OptionalValue(JSONDocumentValue(`{"test": "text"}"`)),
-			exp:   `{"test": "text"}"`,
+			exp:   []byte(`{"test": "text"}"`),
 			err:   nil,
 		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: JSONDocumentValue(`{"test":"text"}"`),
-			exp:   `{"test":"text"}"`,
+			exp:   []byte(`{"test":"text"}"`),
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: JSONValue(`{"test":"text"}`),
+			exp:   []byte(`{"test":"text"}`),
 			err:   nil,
 		},
 
@@ -3,6 +3,7 @@ package value
 import (
 	"database/sql/driver"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -512,6 +513,14 @@ func (v *decimalValue) castTo(dst any) error {
 	case *driver.Value:
 		*dstValue = v
 
+		return nil
+	case *decimal.Decimal:
+		*dstValue = decimal.Decimal{
+			Bytes:     v.value,
+			Precision: v.innerType.Precision(),
+			Scale:     v.innerType.Scale(),
+		}
+
 		return nil
 	default:
 		return xerrors.WithStackTrace(fmt.Errorf(
@@ -527,8 +536,10 @@ func (v *decimalValue) Yql() string {
 	buffer.WriteString(v.innerType.Name())
 	buffer.WriteByte('(')
 	buffer.WriteByte('"')
-	s := decimal.FromBytes(v.value[:], v.innerType.Precision(), v.innerType.Scale()).String()
-	buffer.WriteString(s[:len(s)-int(v.innerType.Scale())] + "." + s[len(s)-int(v.innerType.Scale()):])
+	buffer.WriteString(decimal.Format(
+		decimal.FromBytes(v.value[:], v.innerType.Precision(), v.innerType.Scale()),
+		v.innerType.Precision(), v.innerType.Scale(),
+	))
 	buffer.WriteByte('"')
 	buffer.WriteByte(',')
 	buffer.WriteString(strconv.FormatUint(uint64(v.innerType.Precision()), 10))
@@ -610,10 +621,7 @@ func (v *dictValue) castTo(dst any) error {
 
 		return nil
 	default:
-		return xerrors.WithStackTrace(fmt.Errorf(
-			"%w '%s(%+v)' to '%T' destination",
-			ErrCannotCast, v.Type().Yql(), v, dstValue,
-		))
+		return castDictTo(v, v.values, dst)
 	}
 }
 
@@ -1189,10 +1197,18 @@ func (v jsonValue) castTo(dst any) error {
 	case *string:
 		*vv = string(v)
 
+		return nil
+	case *driver.Value:
+		*vv = []byte(v)
+
 		return nil
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
 
+		return nil
+	case *json.RawMessage:
+		*vv = json.RawMessage(v)
+
 		return nil
 	default:
 		return xerrors.WithStackTrace(fmt.Errorf(
@@ -1233,12 +1249,16 @@ func (v jsonDocumentValue) castTo(dst any) error {
 
 		return nil
 	case *driver.Value:
-		*vv = string(v)
+		*vv = []byte(v)
 
 		return nil
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
 
+		return nil
+	case *json.RawMessage:
+		*vv = json.RawMessage(v)
+
 		return nil
 	default:
 		return xerrors.WithStackTrace(fmt.Errorf(
@@ -1286,10 +1306,7 @@ func (v *listValue) castTo(dst any) error {
 
 		return nil
 	default:
-		return xerrors.WithStackTrace(fmt.Errorf(
-			"%w '%s(%+v)' to '%T' destination",
-			ErrCannotCast, v.Type().Yql(), v, dstValue,
-		))
+		return castListTo(v, v.items, dst)
 	}
 }
 
@@ -1549,6 +1566,24 @@ func OptionalValue(v Value) *optionalValue {
 	}
 }
 
+// IsNull reports whether v is NULL (optional value without inner value)
+func IsNull(v Value) bool {
+	o, ok := v.(*optionalValue)
+
+	return ok && o.value == nil
+}
+
+// Unwrap returns inner value of non-null optional value (recursively) or v as is otherwise
+func Unwrap(v Value) Value {
+	for {
+		o, ok := v.(*optionalValue)
+		if !ok || o.value == nil {
+			return v
+		}
+		v = o.value
+	}
+}
+
 type (
 	StructValueField struct {
 		Name string
@@ -1576,10 +1611,7 @@ func (v *structValue) castTo(dst any) error {
 
 		return nil
 	default:
-		return xerrors.WithStackTrace(fmt.Errorf(
-			"%w '%s(%+v)' to '%T' destination",
-			ErrCannotCast, v.Type().Yql(), v, dstValue,
-		))
+		return castStructTo(v, v.fields, dst)
 	}
 }
 
@@ -2747,7 +2779,7 @@ func ZeroValue(t types.Type) Value {
 		}
 	case *types.Dict:
 		return &dictValue{
-			t: t.ValueType(),
+			t: t,
 		}
 	case *types.EmptyDict:
 		return &dictValue{
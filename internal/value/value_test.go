@@ -485,6 +485,10 @@ func TestValueYql(t *testing.T) {
 			value:   DecimalValueFromBigInt(big.NewInt(-1234567890123456), 22, 9),
 			literal: `Decimal("-1234567.890123456",22,9)`,
 		},
+		{
+			value:   DecimalValueFromBigInt(big.NewInt(12345), 35, 10),
+			literal: `Decimal("0.0000012345",35,10)`,
+		},
 		{
 			value:   DyNumberValue("-1234567890123456"),
 			literal: `DyNumber("-1234567890123456")`,
@@ -1224,3 +1228,16 @@ func TestCastOtherTypes(t *testing.T) {
 		)
 	}
 }
+
+func TestUnwrap(t *testing.T) {
+	require.Equal(t, Uint64Value(1), Unwrap(Uint64Value(1)))
+	require.Equal(t, Uint64Value(1), Unwrap(OptionalValue(Uint64Value(1))))
+	require.Equal(t, Uint64Value(1), Unwrap(OptionalValue(OptionalValue(Uint64Value(1)))))
+	require.Equal(t, NullValue(types.Uint64), Unwrap(NullValue(types.Uint64)))
+}
+
+func TestIsNull(t *testing.T) {
+	require.True(t, IsNull(NullValue(types.Text)))
+	require.False(t, IsNull(OptionalValue(TextValue("test"))))
+	require.False(t, IsNull(TextValue("")))
+}
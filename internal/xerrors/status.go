@@ -0,0 +1,81 @@
+package xerrors
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// StatusError is an error of failed call which exposes status of call with details.
+// StatusError can be recovered from wrapped error with errors.As
+type StatusError interface {
+	error
+
+	// Status returns status of failed call.
+	// Status of transport error is a gRPC status received from server (with details from server).
+	// Status of operation error has gRPC code mapped from YDB status code (see GRPCCode) and
+	// issues of operation as details (Ydb.Issue.IssueMessage)
+	Status() *grpcStatus.Status
+}
+
+var (
+	_ StatusError = (*transportError)(nil)
+	_ StatusError = (*operationError)(nil)
+)
+
+func (e *transportError) Status() *grpcStatus.Status {
+	return e.status
+}
+
+func (e *operationError) Status() *grpcStatus.Status {
+	s := &spb.Status{
+		Code:    int32(GRPCCode(e.code)),
+		Message: e.Error(),
+	}
+	for _, issue := range e.issues {
+		detail, err := anypb.New(issue)
+		if err != nil {
+			continue
+		}
+		s.Details = append(s.Details, detail)
+	}
+
+	return grpcStatus.FromProto(s)
+}
+
+// GRPCCode maps YDB status code into the closest gRPC code
+func GRPCCode(code Ydb.StatusIds_StatusCode) grpcCodes.Code {
+	switch code {
+	case Ydb.StatusIds_SUCCESS:
+		return grpcCodes.OK
+	case Ydb.StatusIds_BAD_REQUEST, Ydb.StatusIds_SCHEME_ERROR:
+		return grpcCodes.InvalidArgument
+	case Ydb.StatusIds_UNAUTHORIZED:
+		return grpcCodes.Unauthenticated
+	case Ydb.StatusIds_INTERNAL_ERROR:
+		return grpcCodes.Internal
+	case Ydb.StatusIds_ABORTED:
+		return grpcCodes.Aborted
+	case Ydb.StatusIds_UNAVAILABLE:
+		return grpcCodes.Unavailable
+	case Ydb.StatusIds_OVERLOADED:
+		return grpcCodes.ResourceExhausted
+	case Ydb.StatusIds_TIMEOUT:
+		return grpcCodes.DeadlineExceeded
+	case Ydb.StatusIds_CANCELLED:
+		return grpcCodes.Canceled
+	case Ydb.StatusIds_UNSUPPORTED:
+		return grpcCodes.Unimplemented
+	case Ydb.StatusIds_NOT_FOUND:
+		return grpcCodes.NotFound
+	case Ydb.StatusIds_ALREADY_EXISTS:
+		return grpcCodes.AlreadyExists
+	case Ydb.StatusIds_BAD_SESSION, Ydb.StatusIds_SESSION_EXPIRED, Ydb.StatusIds_SESSION_BUSY,
+		Ydb.StatusIds_PRECONDITION_FAILED:
+		return grpcCodes.FailedPrecondition
+	default:
+		return grpcCodes.Unknown
+	}
+}
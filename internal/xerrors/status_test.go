@@ -0,0 +1,72 @@
+package xerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	t.Run("Transport", func(t *testing.T) {
+		s, err := grpcStatus.New(grpcCodes.Unavailable, "node is down").WithDetails(&errdetails.RetryInfo{})
+		require.NoError(t, err)
+
+		err = fmt.Errorf("wrapped: %w", WithStackTrace(Transport(s.Err())))
+
+		var statusErr StatusError
+		require.True(t, errors.As(err, &statusErr))
+		require.Equal(t, grpcCodes.Unavailable, statusErr.Status().Code())
+		require.Equal(t, "node is down", statusErr.Status().Message())
+		require.Len(t, statusErr.Status().Details(), 1)
+		require.IsType(t, &errdetails.RetryInfo{}, statusErr.Status().Details()[0])
+	})
+	t.Run("Operation", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", WithStackTrace(Operation(
+			WithStatusCode(Ydb.StatusIds_OVERLOADED),
+			WithIssues([]*Ydb_Issue.IssueMessage{{
+				Message:   "Too many requests",
+				IssueCode: 2005,
+				Severity:  1,
+			}}),
+		)))
+
+		var statusErr StatusError
+		require.True(t, errors.As(err, &statusErr))
+		require.Equal(t, grpcCodes.ResourceExhausted, statusErr.Status().Code())
+		require.Len(t, statusErr.Status().Details(), 1)
+		issue, ok := statusErr.Status().Details()[0].(*Ydb_Issue.IssueMessage)
+		require.True(t, ok)
+		require.Equal(t, "Too many requests", issue.GetMessage())
+		require.EqualValues(t, 2005, issue.GetIssueCode())
+		require.False(t, IsTransportError(err))
+	})
+	t.Run("NotStatusError", func(t *testing.T) {
+		var statusErr StatusError
+		require.False(t, errors.As(WithStackTrace(errors.New("test")), &statusErr))
+	})
+}
+
+func TestGRPCCode(t *testing.T) {
+	for code, expected := range map[Ydb.StatusIds_StatusCode]grpcCodes.Code{
+		Ydb.StatusIds_SUCCESS:                 grpcCodes.OK,
+		Ydb.StatusIds_BAD_REQUEST:             grpcCodes.InvalidArgument,
+		Ydb.StatusIds_UNAUTHORIZED:            grpcCodes.Unauthenticated,
+		Ydb.StatusIds_OVERLOADED:              grpcCodes.ResourceExhausted,
+		Ydb.StatusIds_TIMEOUT:                 grpcCodes.DeadlineExceeded,
+		Ydb.StatusIds_BAD_SESSION:             grpcCodes.FailedPrecondition,
+		Ydb.StatusIds_NOT_FOUND:               grpcCodes.NotFound,
+		Ydb.StatusIds_GENERIC_ERROR:           grpcCodes.Unknown,
+		Ydb.StatusIds_STATUS_CODE_UNSPECIFIED: grpcCodes.Unknown,
+	} {
+		t.Run(code.String(), func(t *testing.T) {
+			require.Equal(t, expected, GRPCCode(code))
+		})
+	}
+}
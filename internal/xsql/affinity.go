@@ -0,0 +1,147 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+)
+
+type (
+	nodeAffinityOption func(query string) uint64
+	// nodeIDsProvider is a balancer which reports nodes it chooses from
+	nodeIDsProvider interface {
+		NodeIDs() []uint32
+	}
+	// currentConn is a processor of prepared statements of Conn. Statements are executed on session
+	// of conn chosen by node affinity (see Conn.affinityConn)
+	currentConn struct {
+		conn *Conn
+	}
+)
+
+func (key nodeAffinityOption) Apply(c *Connector) error {
+	c.nodeAffinity = key
+
+	return nil
+}
+
+// WithNodeAffinity makes connector route statements with the same affinity key to the same node
+func WithNodeAffinity(key func(query string) uint64) Option {
+	return nodeAffinityOption(key)
+}
+
+// affinityNodeID returns node of statement by affinity key of query. Keys are mapped to nodes
+// of balancer with rendezvous hashing, so the same key is mapped to the same node and removal
+// of node remaps only keys of removed node
+func (c *Connector) affinityNodeID(query string) (nodeID uint32, ok bool) {
+	if c.nodeAffinity == nil {
+		return 0, false
+	}
+
+	b, has := c.balancer.(nodeIDsProvider)
+	if !has {
+		return 0, false
+	}
+
+	return rendezvousNodeID(c.nodeAffinity(query), b.NodeIDs())
+}
+
+func rendezvousNodeID(key uint64, nodeIDs []uint32) (nodeID uint32, ok bool) {
+	var maxWeight uint64
+	for _, id := range nodeIDs {
+		weight := mix64(key ^ mix64(uint64(id)))
+		if !ok || weight > maxWeight || (weight == maxWeight && id < nodeID) {
+			nodeID, maxWeight, ok = id, weight, true
+		}
+	}
+
+	return nodeID, ok
+}
+
+// mix64 is a finalizer of splitmix64
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
+
+// affinityConn returns session for statement outside of transaction on node of statement affinity key.
+// Session of conn is used if statement hasn't affinity key, conn has transaction or session of conn is
+// already on node of key. Otherwise, statement is executed on additional session of conn on node of key.
+// Additional session is created once per node through client-side balancer, which falls back to another
+// node if preferred node is unavailable. If session can't be created, session of conn is used
+func (c *Conn) affinityConn(ctx context.Context, query string) iface.Conn {
+	if c.currentTx != nil {
+		return c.cc
+	}
+
+	nodeID, ok := c.connector.affinityNodeID(query)
+	if !ok || nodeID == c.cc.NodeID() {
+		return c.cc
+	}
+
+	c.affinityMtx.Lock()
+	defer c.affinityMtx.Unlock()
+
+	if c.affinityClosed {
+		return c.cc
+	}
+
+	if cc, has := c.affinityConns[nodeID]; has {
+		if cc.IsValid() {
+			return cc
+		}
+		_ = cc.Close()
+		delete(c.affinityConns, nodeID)
+	}
+
+	cc, err := c.connector.open(ctx, c.ctx, func(ctx context.Context) context.Context {
+		return endpoint.WithNodeID(ctx, nodeID)
+	}, nil)
+	if err != nil {
+		return c.cc
+	}
+
+	if c.affinityConns == nil {
+		c.affinityConns = make(map[uint32]iface.Conn)
+	}
+	c.affinityConns[nodeID] = cc
+
+	return cc
+}
+
+// closeAffinityConns closes additional sessions of conn. Sessions aren't created after close
+func (c *Conn) closeAffinityConns() error {
+	c.affinityMtx.Lock()
+	conns := c.affinityConns
+	c.affinityConns = nil
+	c.affinityClosed = true
+	c.affinityMtx.Unlock()
+
+	errs := make([]error, 0, len(conns))
+	for _, cc := range conns {
+		if err := cc.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return xerrors.Join(errs...)
+}
+
+func (cc currentConn) Exec(ctx context.Context, sql string, params *params.Params) (driver.Result, error) {
+	return cc.conn.cc.Exec(ctx, sql, params)
+}
+
+func (cc currentConn) Query(ctx context.Context, sql string, params *params.Params) (
+	driver.RowsNextResultSet, error,
+) {
+	return cc.conn.cc.Query(ctx, sql, params)
+}
@@ -0,0 +1,244 @@
+package xsql
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+type (
+	// affinityBalancer is a fake balancer with fixed set of nodes
+	affinityBalancer struct {
+		grpc.ClientConnInterface
+
+		nodeIDs []uint32
+	}
+	// affinityDriver creates sessions on preferred node if node is available and on
+	// the first available node otherwise, like client-side balancer does
+	affinityDriver struct {
+		testDriver
+		table.Client
+
+		mu        sync.Mutex
+		available []uint32
+		sessions  int
+		closed    int
+		executed  map[string][]uint32
+	}
+	affinitySession struct {
+		table.ClosableSession
+
+		d      *affinityDriver
+		id     string
+		nodeID uint32
+	}
+)
+
+func (b affinityBalancer) NodeIDs() []uint32 {
+	return b.nodeIDs
+}
+
+func (d *affinityDriver) Table() table.Client {
+	return d
+}
+
+func (d *affinityDriver) CreateSession(ctx context.Context, _ ...table.Option) (table.ClosableSession, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.sessions++
+	s := &affinitySession{
+		d:      d,
+		id:     strconv.Itoa(d.sessions),
+		nodeID: d.available[0],
+	}
+	if nodeID, has := endpoint.ContextNodeID(ctx); has {
+		for _, id := range d.available {
+			if id == nodeID {
+				s.nodeID = nodeID
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func (s *affinitySession) ID() string {
+	return s.id
+}
+
+func (s *affinitySession) NodeID() uint32 {
+	return s.nodeID
+}
+
+func (s *affinitySession) Status() table.SessionStatus {
+	return table.SessionReady
+}
+
+func (s *affinitySession) Close(context.Context) error {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	s.d.closed++
+
+	return nil
+}
+
+func (s *affinitySession) ExecuteSchemeQuery(
+	_ context.Context, sql string, _ ...options.ExecuteSchemeQueryOption,
+) error {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+
+	s.d.executed[sql] = append(s.d.executed[sql], s.nodeID)
+
+	return nil
+}
+
+func affinityKey(query string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(query))
+
+	return h.Sum64()
+}
+
+func TestNodeAffinity(t *testing.T) {
+	ctx := legacy.WithQueryMode(xtest.Context(t), legacy.SchemeQueryMode)
+	open := func(t *testing.T, nodeIDs, available []uint32) (*affinityDriver, *Conn) {
+		d := &affinityDriver{
+			available: available,
+			executed:  make(map[string][]uint32),
+		}
+		c, err := Open(d, affinityBalancer{nodeIDs: nodeIDs},
+			WithQueryService(false),
+			WithNodeAffinity(affinityKey),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+		cc, err := c.Connect(ctx)
+		require.NoError(t, err)
+
+		return d, cc.(*Conn)
+	}
+	t.Run("SameKeySameNode", func(t *testing.T) {
+		d, cc := open(t, []uint32{1, 2, 3}, []uint32{1, 2, 3})
+		for i := 0; i < 5; i++ {
+			for _, q := range []string{"q1", "q2", "q3", "q4"} {
+				_, err := cc.ExecContext(ctx, q, nil)
+				require.NoError(t, err)
+			}
+		}
+		for _, q := range []string{"q1", "q2", "q3", "q4"} {
+			nodeID, ok := rendezvousNodeID(affinityKey(q), []uint32{1, 2, 3})
+			require.True(t, ok)
+			require.Len(t, d.executed[q], 5)
+			for _, id := range d.executed[q] {
+				require.Equal(t, nodeID, id, q)
+			}
+		}
+	})
+	t.Run("DifferentKeysSpread", func(t *testing.T) {
+		d, cc := open(t, []uint32{1, 2, 3}, []uint32{1, 2, 3})
+		nodes := make(map[uint32]int)
+		for i := 0; i < 30; i++ {
+			q := "q" + strconv.Itoa(i)
+			_, err := cc.ExecContext(ctx, q, nil)
+			require.NoError(t, err)
+			nodes[d.executed[q][0]]++
+		}
+		require.Len(t, nodes, 3)
+	})
+	t.Run("PreparedStatement", func(t *testing.T) {
+		d, cc := open(t, []uint32{1, 2, 3}, []uint32{1, 2, 3})
+		for _, q := range []string{"q1", "q2", "q3", "q4"} {
+			stmt, err := cc.PrepareContext(ctx, q)
+			require.NoError(t, err)
+			_, err = stmt.(*Stmt).ExecContext(ctx, nil)
+			require.NoError(t, err)
+			nodeID, _ := rendezvousNodeID(affinityKey(q), []uint32{1, 2, 3})
+			require.Equal(t, []uint32{nodeID}, d.executed[q])
+		}
+	})
+	t.Run("UnavailableNode", func(t *testing.T) {
+		var q string
+		for i := 0; ; i++ {
+			q = "q" + strconv.Itoa(i)
+			if nodeID, _ := rendezvousNodeID(affinityKey(q), []uint32{1, 2, 3, 4}); nodeID == 4 {
+				break
+			}
+		}
+		d, cc := open(t, []uint32{1, 2, 3, 4}, []uint32{1, 2, 3})
+		for i := 0; i < 3; i++ {
+			_, err := cc.ExecContext(ctx, q, nil)
+			require.NoError(t, err)
+		}
+		require.Equal(t, []uint32{1, 1, 1}, d.executed[q])
+		// session for unavailable node is created once
+		require.Equal(t, 2, d.sessions)
+	})
+	t.Run("InTransaction", func(t *testing.T) {
+		var q string
+		for i := 0; ; i++ {
+			q = "q" + strconv.Itoa(i)
+			if nodeID, _ := rendezvousNodeID(affinityKey(q), []uint32{1, 2, 3}); nodeID != 1 {
+				break
+			}
+		}
+		d, cc := open(t, []uint32{1, 2, 3}, []uint32{1, 2, 3})
+		cc.currentTx = &Tx{}
+		require.Equal(t, cc.cc, cc.affinityConn(ctx, q))
+		require.Equal(t, 1, d.sessions)
+	})
+	t.Run("SessionPerNode", func(t *testing.T) {
+		d, cc := open(t, []uint32{1, 2, 3}, []uint32{1, 2, 3})
+		main := cc.cc
+		for i := 0; i < 30; i++ {
+			_, err := cc.ExecContext(ctx, "q"+strconv.Itoa(i%10), nil)
+			require.NoError(t, err)
+		}
+		// session of conn is kept, sessions on other nodes are created once
+		require.Equal(t, main, cc.cc)
+		require.Equal(t, 3, d.sessions)
+		require.Len(t, cc.affinityConns, 2)
+		require.Equal(t, 0, d.closed)
+		require.NoError(t, cc.Close())
+		require.Equal(t, 3, d.closed)
+		// conn doesn't create sessions after close
+		for i := 0; i < 10; i++ {
+			_ = cc.affinityConn(ctx, "q"+strconv.Itoa(i))
+		}
+		require.Equal(t, 3, d.sessions)
+	})
+}
+
+func TestRendezvousNodeID(t *testing.T) {
+	_, ok := rendezvousNodeID(1, nil)
+	require.False(t, ok)
+
+	nodeIDs := []uint32{1, 2, 3, 4, 5}
+	for key := uint64(0); key < 1000; key++ {
+		nodeID, ok := rendezvousNodeID(key, nodeIDs)
+		require.True(t, ok)
+		// order of nodes doesn't matter
+		reversed, _ := rendezvousNodeID(key, []uint32{5, 4, 3, 2, 1})
+		require.Equal(t, nodeID, reversed)
+		// removal of node remaps only keys of removed node
+		if nodeID != 3 {
+			remapped, _ := rendezvousNodeID(key, []uint32{1, 2, 4, 5})
+			require.Equal(t, nodeID, remapped)
+		}
+	}
+}
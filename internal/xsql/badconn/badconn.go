@@ -0,0 +1,73 @@
+package badconn
+
+import (
+	"database/sql/driver"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type Error struct {
+	err error
+}
+
+func (e Error) Origin() error {
+	return e.err
+}
+
+func (e Error) Error() string {
+	return e.err.Error()
+}
+
+func (e Error) Is(err error) bool {
+	//nolint:nolintlint
+	if err == driver.ErrBadConn { //nolint:errorlint
+		return true
+	}
+
+	return xerrors.Is(e.err, err)
+}
+
+func (e Error) As(target interface{}) bool {
+	switch target.(type) {
+	case Error, *Error:
+		return true
+	default:
+		return xerrors.As(e.err, target)
+	}
+}
+
+// Map maps errors which makes conn invalid to driver.ErrBadConn
+//
+// Map must be used only for errors which occurred before statement was sent to server
+// because database/sql retries the statement on a fresh conn on driver.ErrBadConn
+func Map(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case xerrors.Is(err, io.EOF):
+		return io.EOF
+	case !xerrors.IsRetryObjectValid(err):
+		return Error{err: err}
+	default:
+		return err
+	}
+}
+
+// MapSent maps to driver.ErrBadConn errors which occurred after statement was sent to server
+//
+// Only session-gone operation errors (such as BAD_SESSION or SESSION_EXPIRED) are mapped because
+// server rejects statement without execution in this case. Other errors (such as transport errors)
+// are not mapped because statement may already be executed and retry may duplicate side effects
+func MapSent(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case xerrors.Is(err, io.EOF):
+		return io.EOF
+	case xerrors.IsOperationError(err) && !xerrors.IsRetryObjectValid(err):
+		return Error{err: err}
+	default:
+		return err
+	}
+}
@@ -136,3 +136,18 @@ func Test_badConnError_As_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestMapSent(t *testing.T) {
+	for _, err := range errsToCheck {
+		t.Run(err.Error(), func(t *testing.T) {
+			require.Equal(t,
+				xerrors.IsOperationError(err,
+					Ydb.StatusIds_BAD_SESSION,
+					Ydb.StatusIds_SESSION_EXPIRED,
+					Ydb.StatusIds_SESSION_BUSY,
+				),
+				xerrors.Is(MapSent(err), driver.ErrBadConn),
+			)
+		})
+	}
+}
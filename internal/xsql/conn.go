@@ -3,6 +3,8 @@ package xsql
 import (
 	"context"
 	"database/sql/driver"
+	"sync"
+	"sync/atomic"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -13,6 +15,7 @@ import (
 )
 
 type Conn struct {
+	id        string
 	processor Engine
 
 	cc        iface.Conn
@@ -21,6 +24,15 @@ type Conn struct {
 
 	connector *Connector
 	lastUsage xsync.LastUsage
+
+	// warm is true while conn created by warm-up waits for Connect
+	warm atomic.Bool
+
+	// affinityConns are additional sessions of conn by nodes of affinity keys (see WithNodeAffinity).
+	// Session of node may be created on another node if preferred node is unavailable
+	affinityMtx    sync.Mutex
+	affinityConns  map[uint32]iface.Conn
+	affinityClosed bool
 }
 
 func (c *Conn) Ping(ctx context.Context) (finalErr error) {
@@ -31,6 +43,8 @@ func (c *Conn) Ping(ctx context.Context) (finalErr error) {
 		onDone(finalErr)
 	}()
 
+	ctx = c.connector.outgoingContext(ctx)
+
 	return c.cc.Ping(ctx)
 }
 
@@ -55,6 +69,8 @@ func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (_ driver.Tx,
 		onDone(c.currentTx, finalErr)
 	}()
 
+	ctx = c.connector.outgoingContext(ctx)
+
 	if c.currentTx != nil {
 		return nil, xerrors.WithStackTrace(xerrors.AlreadyHasTx(c.currentTx.ID()))
 	}
@@ -81,7 +97,7 @@ func (c *Conn) Close() (finalErr error) {
 		onDone(finalErr)
 	}()
 
-	err := c.cc.Close()
+	err := xerrors.Join(c.closeAffinityConns(), c.cc.Close())
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -123,7 +139,7 @@ func (c *Conn) PrepareContext(ctx context.Context, sql string) (_ driver.Stmt, f
 
 	return &Stmt{
 		conn:      c,
-		processor: c.cc,
+		processor: currentConn{conn: c},
 		ctx:       ctx,
 		sql:       sql,
 	}, nil
@@ -140,16 +156,22 @@ func (c *Conn) QueryContext(ctx context.Context, sql string, args []driver.Named
 		onDone(finalErr)
 	}()
 
+	cc := c.affinityConn(c.connector.outgoingContext(ctx), sql)
+
+	ctx = withSessionID(c.connector.outgoingContext(ctx), cc.ID())
+
 	done := c.lastUsage.Start()
 	defer done()
 
-	sql, params, err := c.toYdb(sql, args...)
+	sql, params, err := c.toYdb(ctx, sql, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	ctx = c.connector.withStmtCache(ctx, sql)
+
 	if isExplain(ctx) {
-		ast, plan, err := c.cc.Explain(ctx, sql, params)
+		ast, plan, err := cc.Explain(ctx, sql, params)
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
 		}
@@ -157,11 +179,22 @@ func (c *Conn) QueryContext(ctx context.Context, sql string, args []driver.Named
 		return rowByAstPlan(ast, plan), nil
 	}
 
+	ctx, cancel := c.connector.withStatementTimeout(ctx)
+
+	start := c.connector.clock.Now()
+	defer func() {
+		c.onSlowQuery(ctx, sql, params, c.connector.clock.Since(start))
+	}()
+
 	if c.currentTx != nil {
-		return c.currentTx.tx.Query(ctx, sql, params)
+		rows, err := c.currentTx.tx.Query(ctx, sql, params)
+
+		return rowsWithCancel(rows, cancel), statementError(err)
 	}
 
-	return c.cc.Query(ctx, sql, params)
+	rows, err := cc.Query(ctx, sql, params)
+
+	return rowsWithCancel(rows, cancel), statementError(err)
 }
 
 func (c *Conn) ExecContext(ctx context.Context, sql string, args []driver.NamedValue) (
@@ -175,17 +208,37 @@ func (c *Conn) ExecContext(ctx context.Context, sql string, args []driver.NamedV
 		onDone(finalErr)
 	}()
 
+	cc := c.affinityConn(c.connector.outgoingContext(ctx), sql)
+
+	ctx = withSessionID(c.connector.outgoingContext(ctx), cc.ID())
+
 	done := c.lastUsage.Start()
 	defer done()
 
-	sql, params, err := c.toYdb(sql, args...)
+	sql, params, err := c.toYdb(ctx, sql, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	ctx = c.connector.withStmtCache(ctx, sql)
+
+	ctx, cancel := c.connector.withStatementTimeout(ctx)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	start := c.connector.clock.Now()
+	defer func() {
+		c.onSlowQuery(ctx, sql, params, c.connector.clock.Since(start))
+	}()
+
 	if c.currentTx != nil {
-		return c.currentTx.tx.Exec(ctx, sql, params)
+		result, err := c.currentTx.tx.Exec(ctx, sql, params)
+
+		return result, statementError(err)
 	}
 
-	return c.cc.Exec(ctx, sql, params)
+	result, err := cc.Exec(ctx, sql, params)
+
+	return result, statementError(err)
 }
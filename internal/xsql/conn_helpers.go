@@ -21,20 +21,45 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
-func (c *Conn) toYdb(sql string, args ...driver.NamedValue) (yql string, _ *params.Params, _ error) {
+func (c *Conn) toYdb(ctx context.Context, sql string, args ...driver.NamedValue) (
+	yql string, _ *params.Params, _ error,
+) {
 	queryArgs := make([]any, len(args))
 	for i := range args {
 		queryArgs[i] = args[i]
 	}
 
-	yql, params, err := c.connector.Bindings().ToYdb(sql, queryArgs...)
+	bindings := c.connector.Bindings()
+	if tablePathPrefix, has := tablePathPrefix(ctx); has {
+		if err := tablePathPrefix.Validate(); err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+		bindings = bindings.WithTablePathPrefix(tablePathPrefix)
+	}
+	if timeArgs, has := timeArgs(ctx); has {
+		bindings = bindings.WithTimeArgs(timeArgs)
+	}
+
+	yql, params, err := bindings.ToYdb(sql, queryArgs...)
 	if err != nil {
 		return "", nil, xerrors.WithStackTrace(err)
 	}
 
+	for _, middleware := range c.connector.statementMiddlewares {
+		yql, err = middleware(ctx, yql)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+	}
+
 	return yql, &params, nil
 }
 
+// ID returns identifier of conn in connector
+func (c *Conn) ID() string {
+	return c.id
+}
+
 func (c *Conn) LastUsage() time.Time {
 	return c.lastUsage.Get()
 }
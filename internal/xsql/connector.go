@@ -3,22 +3,31 @@ package xsql
 import (
 	"context"
 	"database/sql/driver"
+	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy"
 	propose "github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/propose"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
@@ -43,16 +52,40 @@ type (
 		Options               []propose.Option
 		disableServerBalancer bool
 		onCLose               []func(*Connector)
-
-		clock          clockwork.Clock
-		idleThreshold  time.Duration
-		conns          xsync.Map[uuid.UUID, *Conn]
-		done           chan struct{}
-		trace          *trace.DatabaseSQL
-		traceRetry     *trace.Retry
-		retryBudget    budget.Budget
-		pathNormalizer bind.TablePathPrefix
-		bindings       bind.Bindings
+		onClosed              []func(*Connector)
+
+		clock              clockwork.Clock
+		idleThreshold      time.Duration
+		keepAlive          int
+		minPoolSize        int
+		warmConns          chan *Conn
+		stmtCache          *stmtCache
+		connectRetry       connectRetryOption
+		conns              xsync.Map[string, *Conn]
+		connIDs            atomic.Uint64
+		sequentialConnIDs  bool
+		done               chan struct{}
+		closeMtx           xsync.Mutex
+		trace              *trace.DatabaseSQL
+		traceRetry         *trace.Retry
+		retryBudget        budget.Budget
+		pathNormalizer     bind.TablePathPrefix
+		bindings           bind.Bindings
+		nodeAffinity       func(query string) uint64
+		slowQueryThreshold time.Duration
+		slowQueries        *slowQueryExplainer
+		stmtTimeout        time.Duration
+
+		outgoingMetadata     []func(ctx context.Context) metadata.MD
+		statementMiddlewares []func(ctx context.Context, sql string) (string, error)
+	}
+	// Stats is a snapshot of connector state
+	Stats struct {
+		Conns []ConnStats
+	}
+	ConnStats struct {
+		ID        string
+		LastUsage time.Time
 	}
 	ydbDriver interface {
 		Name() string
@@ -74,6 +107,11 @@ func (e Engine) String() string {
 	}
 }
 
+// Engine returns processor of database/sql driver
+func (c *Connector) Engine() Engine {
+	return c.processor
+}
+
 func (c *Connector) RetryBudget() budget.Budget {
 	return c.retryBudget
 }
@@ -114,6 +152,41 @@ func (c *Connector) Scheme() scheme.Client {
 	return c.parent.Scheme()
 }
 
+// outgoingContext returns a copy of ctx with metadata from WithOutgoingMetadata options
+func (c *Connector) outgoingContext(ctx context.Context) context.Context {
+	for _, fn := range c.outgoingMetadata {
+		md := fn(ctx)
+		if md.Len() == 0 {
+			continue
+		}
+		kv := make([]string, 0, md.Len()*2) //nolint:gomnd
+		for k, values := range md {
+			for _, v := range values {
+				kv = append(kv, k, v)
+			}
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, kv...)
+	}
+
+	return ctx
+}
+
+// sessionBalancerContext allows server to choose node of new session unless server balancer is disabled.
+// Without session balancer hint (and on servers which don't support server-side balancing) session
+// is created on node chosen by client-side balancer
+func (c *Connector) sessionBalancerContext(ctx context.Context) context.Context {
+	if c.disableServerBalancer {
+		return ctx
+	}
+
+	return meta.WithAllowFeatures(ctx, meta.HintSessionBalancer)
+}
+
+const (
+	waitReadyBackoffCeiling     = 6
+	waitReadyBackoffJitterLimit = 0.5
+)
+
 const (
 	QUERY_SERVICE = iota + 1 //nolint:revive,stylecheck
 	LEGACY                   //nolint:revive,stylecheck
@@ -124,55 +197,165 @@ func (c *Connector) Open(name string) (driver.Conn, error) {
 }
 
 func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
+	if c.isClosed() {
+		return nil, xerrors.WithStackTrace(ErrConnectorClosed)
+	}
+
+	if conn := c.takeWarmConn(); conn != nil {
+		return conn, nil
+	}
+
+	return c.connect(ctx)
+}
+
+// connect creates new conn and registers it in connector
+func (c *Connector) connect(ctx context.Context) (_ driver.Conn, finalErr error) {
+	ctx = c.outgoingContext(ctx)
+
+	id := c.newConnID()
+
+	var cc iface.Conn
+	onDone := trace.DatabaseSQLOnConnectorConnect(c.trace, &ctx,
+		stack.FunctionID("", stack.Package("database/sql")),
+	)
+	defer func() {
+		if finalErr != nil {
+			onDone(finalErr, nil, id)
+		} else {
+			onDone(nil, connSessionInfo{cc}, id)
+		}
+	}()
+
+	cc, err := c.open(ctx, ctx, c.sessionBalancerContext, func() {
+		c.conns.Delete(id)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	conn := &Conn{
+		id:        id,
+		processor: c.processor,
+		cc:        cc,
+		ctx:       ctx,
+		connector: c,
+		lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+	}
+
+	return c.attach(id, conn)
+}
+
+// connSessionInfo exposes session of conn to traces
+type connSessionInfo struct {
+	iface.Conn
+}
+
+func (s connSessionInfo) Status() string {
+	if s.IsValid() {
+		return table.SessionReady
+	}
+
+	return table.SessionClosed
+}
+
+// newConnID returns random UUID or next sequential number if connector is configured with WithSequentialConnIDs
+func (c *Connector) newConnID() string {
+	if c.sequentialConnIDs {
+		return strconv.FormatUint(c.connIDs.Add(1), 10)
+	}
+
+	return uuid.NewString()
+}
+
+// WaitReady pings database until successful round-trip or done of ctx and returns nil right after
+// first successful ping. Delays between attempts grow exponentially from interval. Every retry attempt
+// acquires retry budget of connector. Non-retryable errors (such as authentication errors) are returned
+// immediately
+func (c *Connector) WaitReady(ctx context.Context, interval time.Duration) error {
+	b := backoff.New(
+		backoff.WithSlotDuration(interval),
+		backoff.WithCeiling(waitReadyBackoffCeiling),
+		backoff.WithJitterLimit(waitReadyBackoffJitterLimit),
+	)
+	for attempt := 1; ; attempt++ {
+		err := c.ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if !retry.Check(err).MustRetry(true) {
+			return xerrors.WithStackTrace(err)
+		}
+
+		t := c.clock.NewTimer(b.Delay(attempt - 1))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+
+			return xerrors.WithStackTrace(xerrors.Join(ctx.Err(), err))
+		case <-t.Chan():
+		}
+
+		if c.retryBudget != nil {
+			if acquireErr := c.retryBudget.Acquire(ctx); acquireErr != nil {
+				return xerrors.WithStackTrace(xerrors.Join(acquireErr, err))
+			}
+		}
+	}
+}
+
+// ping makes round-trip to database over new conn
+func (c *Connector) ping(ctx context.Context) error {
+	cc, err := c.Connect(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = cc.Close()
+	}()
+
+	if err = cc.(*Conn).Ping(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// open creates session with context modified by sessionContext and makes engine-specific conn over it.
+// onClose (if not nil) is called on close of conn
+func (c *Connector) open(
+	ctx, connCtx context.Context, sessionContext func(ctx context.Context) context.Context, onClose func(),
+) (iface.Conn, error) {
 	switch c.processor {
 	case QUERY_SERVICE:
-		s, err := query.CreateSession(ctx, c.Query())
+		s, err := createSession(ctx, c, func(ctx context.Context) (*query.Session, error) {
+			return query.CreateSession(sessionContext(ctx), c.Query())
+		})
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
 		}
 
-		id := uuid.New()
-
-		conn := &Conn{
-			processor: QUERY_SERVICE,
-			cc: propose.New(ctx, c, s, append(
-				c.Options,
-				propose.WithOnClose(func() {
-					c.conns.Delete(id)
-				}))...,
-			),
-			ctx:       ctx,
-			connector: c,
-			lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+		opts := c.Options
+		if onClose != nil {
+			opts = append(opts[:len(opts):len(opts)], propose.WithOnClose(onClose))
 		}
 
-		c.conns.Set(id, conn)
-
-		return conn, nil
+		return propose.New(connCtx, c, s, opts...), nil
 
 	case LEGACY:
-		s, err := c.Table().CreateSession(ctx) //nolint:staticcheck
+		s, err := createSession(ctx, c, func(ctx context.Context) (table.ClosableSession, error) {
+			return c.Table().CreateSession(sessionContext(ctx)) //nolint:staticcheck
+		})
 		if err != nil {
 			return nil, xerrors.WithStackTrace(err)
 		}
 
-		id := uuid.New()
-
-		conn := &Conn{
-			processor: LEGACY,
-			cc: legacy.New(ctx, c, s, append(c.LegacyOpts,
-				legacy.WithOnClose(func() {
-					c.conns.Delete(id)
-				}))...,
-			),
-			ctx:       ctx,
-			connector: c,
-			lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+		opts := c.LegacyOpts
+		if onClose != nil {
+			opts = append(opts[:len(opts):len(opts)], legacy.WithOnClose(onClose))
 		}
 
-		c.conns.Set(id, conn)
-
-		return conn, nil
+		return legacy.New(connCtx, c, s, opts...), nil
 	default:
 		return nil, xerrors.WithStackTrace(errWrongQueryProcessor)
 	}
@@ -186,22 +369,187 @@ func (c *Connector) Parent() ydbDriver {
 	return c.parent
 }
 
-func (c *Connector) Close() error {
+// createSession calls create and retries retryable errors according to connect retry options of connector
+func createSession[T any](ctx context.Context, c *Connector, create func(ctx context.Context) (T, error)) (
+	s T, err error,
+) {
+	for attempt := 1; ; attempt++ {
+		s, err = create(ctx)
+		if err == nil {
+			return s, nil
+		}
+
+		if attempt >= c.connectRetry.maxAttempts || !retry.Check(err).MustRetry(true) {
+			return s, xerrors.WithStackTrace(err)
+		}
+
+		t := c.clock.NewTimer(c.connectRetry.backoff.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+
+			return s, xerrors.WithStackTrace(xerrors.Join(ctx.Err(), err))
+		case <-t.Chan():
+		}
+
+		if c.retryBudget != nil {
+			if acquireErr := c.retryBudget.Acquire(ctx); acquireErr != nil {
+				return s, xerrors.WithStackTrace(xerrors.Join(acquireErr, err))
+			}
+		}
+	}
+}
+
+// warmUp eagerly creates minPoolSize conns for Connect. Warm-up failures are reported to
+// OnConnectorConnect trace only. Warm-up stops on close of connector
+func (c *Connector) warmUp(ctx context.Context) {
+	for i := 0; i < c.minPoolSize; i++ {
+		cc, err := c.connect(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		conn := cc.(*Conn) //nolint:forcetypeassert
+		conn.warm.Store(true)
+		c.warmConns <- conn
+	}
+}
+
+// takeWarmConn returns conn created by warm-up or nil if there are no warm conns.
+// Warm conns with invalid session are closed
+func (c *Connector) takeWarmConn() *Conn {
+	for {
+		select {
+		case conn := <-c.warmConns:
+			if !conn.warm.CompareAndSwap(true, false) {
+				continue
+			}
+			if !conn.cc.IsValid() {
+				_ = conn.Close()
+
+				continue
+			}
+
+			return conn
+		default:
+			return nil
+		}
+	}
+}
+
+// attach registers conn in connector. Conn created concurrently with Close is closed
+// immediately for avoid orphan sessions
+func (c *Connector) attach(id string, conn *Conn) (driver.Conn, error) {
+	c.conns.Set(id, conn)
+
+	if c.isClosed() {
+		_ = conn.Close()
+
+		return nil, xerrors.WithStackTrace(ErrConnectorClosed)
+	}
+
+	return conn, nil
+}
+
+func (c *Connector) isClosed() bool {
 	select {
 	case <-c.done:
-		return xerrors.WithStackTrace(errAlreadyClosed)
+		return true
 	default:
+		return false
+	}
+}
+
+// Close closes connector. Second and next calls returns ErrConnectorClosed
+//
+// Close runs in three phases: calls WithOnClose callbacks, closes all conns of connector
+// and then calls WithOnClosed callbacks. Callbacks of each phase are called in registration order
+func (c *Connector) Close() (finalErr error) {
+	c.closeMtx.WithLock(func() {
+		if c.isClosed() {
+			finalErr = xerrors.WithStackTrace(ErrConnectorClosed)
+
+			return
+		}
+
 		close(c.done)
 
 		for _, onClose := range c.onCLose {
 			onClose(c)
 		}
 
-		return nil
+		c.conns.Range(func(_ string, cc *Conn) bool {
+			_ = cc.Close()
+
+			return true
+		})
+
+		for _, onClosed := range c.onClosed {
+			onClosed(c)
+		}
+	})
+
+	return finalErr
+}
+
+// closeIdleConns closes conns which are idle longer than idleThreshold (the most idle first)
+// but keeps at least keepAlive live conns. Warm conns which are not taken by Connect yet are never closed
+func (c *Connector) closeIdleConns() {
+	var (
+		alive int
+		idle  []*Conn
+	)
+	c.conns.Range(func(_ string, cc *Conn) bool {
+		alive++
+		if !cc.warm.Load() && c.clock.Since(cc.LastUsage()) > c.idleThreshold {
+			idle = append(idle, cc)
+		}
+
+		return true
+	})
+
+	sort.Slice(idle, func(i, j int) bool {
+		return idle[i].LastUsage().Before(idle[j].LastUsage())
+	})
+
+	for _, cc := range idle {
+		if alive <= c.keepAlive {
+			return
+		}
+		_ = cc.Close()
+		alive--
 	}
 }
 
+// Stats returns snapshot of connector state
+func (c *Connector) Stats() Stats {
+	var stats Stats
+	c.conns.Range(func(_ string, cc *Conn) bool {
+		stats.Conns = append(stats.Conns, ConnStats{
+			ID:        cc.ID(),
+			LastUsage: cc.LastUsage(),
+		})
+
+		return true
+	})
+
+	return stats
+}
+
 func Open(parent ydbDriver, balancer grpc.ClientConnInterface, opts ...Option) (_ *Connector, err error) {
+	// option WithIdleThreshold takes precedence over environment variable
+	var idleThreshold time.Duration
+	if env := os.Getenv("YDB_DATABASE_SQL_IDLE_THRESHOLD"); env != "" {
+		idleThreshold, err = time.ParseDuration(env)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w %q: %w", errWrongIdleThreshold, env, err))
+		}
+	}
+
 	c := &Connector{
 		parent:   parent,
 		balancer: balancer,
@@ -217,6 +565,7 @@ func Open(parent ydbDriver, balancer grpc.ClientConnInterface, opts ...Option) (
 		trace:          &trace.DatabaseSQL{},
 		traceRetry:     &trace.Retry{},
 		pathNormalizer: bind.TablePathPrefix(parent.Name()),
+		idleThreshold:  idleThreshold,
 	}
 
 	for _, opt := range opts {
@@ -227,26 +576,42 @@ func Open(parent ydbDriver, balancer grpc.ClientConnInterface, opts ...Option) (
 		}
 	}
 
+	// query service has no keep in cache flag, so statement cache has no effect over query service
+	if c.stmtCache != nil && c.processor == QUERY_SERVICE {
+		return nil, xerrors.WithStackTrace(errStmtCacheOverQuery)
+	}
+
+	if c.minPoolSize > 0 {
+		ctx, cancel := xcontext.WithDone(context.Background(), c.done)
+		c.warmConns = make(chan *Conn, c.minPoolSize)
+		go func() {
+			defer cancel()
+			c.warmUp(ctx)
+		}()
+	}
+
+	if c.slowQueryThreshold > 0 && c.trace.OnSlowQuery != nil {
+		ctx, cancel := xcontext.WithDone(context.Background(), c.done)
+		c.slowQueries = newSlowQueryExplainer(c)
+		go func() {
+			defer cancel()
+			c.slowQueries.run(ctx)
+		}()
+	}
+
 	if c.idleThreshold > 0 {
 		ctx, cancel := xcontext.WithDone(context.Background(), c.done)
+		idleThresholdTimer := c.clock.NewTimer(c.idleThreshold)
 		go func() {
 			defer cancel()
+			defer idleThresholdTimer.Stop()
 			for {
-				idleThresholdTimer := c.clock.NewTimer(c.idleThreshold)
 				select {
 				case <-ctx.Done():
-					idleThresholdTimer.Stop()
-
 					return
 				case <-idleThresholdTimer.Chan():
-					idleThresholdTimer.Stop() // no really need, stop for common style only
-					c.conns.Range(func(_ uuid.UUID, cc *Conn) bool {
-						if c.clock.Since(cc.LastUsage()) > c.idleThreshold {
-							_ = cc.Close()
-						}
-
-						return true
-					})
+					c.closeIdleConns()
+					idleThresholdTimer.Reset(c.idleThreshold)
 				}
 			}
 		}()
@@ -0,0 +1,937 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// metadataInterceptor captures outgoing metadata of each call to the underlying conn
+type metadataInterceptor struct {
+	iface.Conn
+
+	calls []metadata.MD
+}
+
+func (i *metadataInterceptor) intercept(ctx context.Context) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	i.calls = append(i.calls, md)
+}
+
+func (i *metadataInterceptor) IsValid() bool {
+	return true
+}
+
+func (i *metadataInterceptor) ID() string {
+	return "test-session-id"
+}
+
+func (i *metadataInterceptor) Exec(ctx context.Context, _ string, _ *params.Params) (driver.Result, error) {
+	i.intercept(ctx)
+
+	return driver.ResultNoRows, nil
+}
+
+func (i *metadataInterceptor) Query(ctx context.Context, _ string, _ *params.Params) (
+	driver.RowsNextResultSet, error,
+) {
+	i.intercept(ctx)
+
+	return nil, nil //nolint:nilnil
+}
+
+func (i *metadataInterceptor) Ping(ctx context.Context) error {
+	i.intercept(ctx)
+
+	return nil
+}
+
+func TestWithOutgoingMetadata(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := &Connector{
+		clock:      clockwork.NewFakeClock(),
+		trace:      &trace.DatabaseSQL{},
+		traceRetry: &trace.Retry{},
+	}
+	require.NoError(t, WithOutgoingMetadata(func(ctx context.Context) metadata.MD {
+		return metadata.Pairs("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	}).Apply(c))
+	require.NoError(t, WithOutgoingMetadata(func(ctx context.Context) metadata.MD {
+		return nil
+	}).Apply(c))
+	require.NoError(t, WithOutgoingMetadata(func(ctx context.Context) metadata.MD {
+		return metadata.Pairs("x-custom", "1", "x-custom", "2")
+	}).Apply(c))
+
+	interceptor := &metadataInterceptor{}
+	conn := &Conn{
+		cc:        interceptor,
+		ctx:       ctx,
+		connector: c,
+		lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-user", "user")
+
+	require.NoError(t, conn.Ping(ctx))
+	_, err := conn.ExecContext(ctx, "SELECT 1", nil)
+	require.NoError(t, err)
+	_, err = conn.QueryContext(ctx, "SELECT 1", nil)
+	require.NoError(t, err)
+	stmt, err := conn.PrepareContext(ctx, "SELECT 1")
+	require.NoError(t, err)
+	_, err = stmt.(*Stmt).ExecContext(ctx, nil)
+	require.NoError(t, err)
+
+	require.Len(t, interceptor.calls, 4)
+	for _, md := range interceptor.calls {
+		require.Equal(t, []string{"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}, md.Get("traceparent"))
+		require.Equal(t, []string{"1", "2"}, md.Get("x-custom"))
+		require.Equal(t, []string{"user"}, md.Get("x-user"))
+	}
+}
+
+type (
+	testDriver struct {
+		ydbDriver
+	}
+	clockOption struct {
+		clockwork.Clock
+	}
+	// countingClock counts created timers
+	countingClock struct {
+		clockwork.FakeClock
+
+		timers atomic.Int32
+	}
+	closeInterceptor struct {
+		iface.Conn
+
+		closed  chan struct{}
+		onClose func()
+		once    sync.Once
+	}
+)
+
+func (testDriver) Name() string {
+	return "/local"
+}
+
+func (opt clockOption) Apply(c *Connector) error {
+	c.clock = opt.Clock
+
+	return nil
+}
+
+func (c *countingClock) NewTimer(d time.Duration) clockwork.Timer {
+	c.timers.Add(1)
+
+	return c.FakeClock.NewTimer(d)
+}
+
+func (i *closeInterceptor) Close() error {
+	i.once.Do(func() {
+		close(i.closed)
+		if i.onClose != nil {
+			i.onClose()
+		}
+	})
+
+	return nil
+}
+
+func TestIdleThreshold(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		env           string
+		opts          []Option
+		idleThreshold time.Duration
+		err           error
+	}{
+		{
+			name:          "Unset",
+			idleThreshold: 0,
+		},
+		{
+			name:          "Env",
+			env:           "5m",
+			idleThreshold: 5 * time.Minute,
+		},
+		{
+			name: "WrongEnv",
+			env:  "5",
+			err:  errWrongIdleThreshold,
+		},
+		{
+			name: "WrongEnvWithOption",
+			env:  "5",
+			opts: []Option{WithIdleThreshold(time.Minute)},
+			err:  errWrongIdleThreshold,
+		},
+		{
+			name:          "Option",
+			opts:          []Option{WithIdleThreshold(time.Minute)},
+			idleThreshold: time.Minute,
+		},
+		{
+			name:          "OptionOverEnv",
+			env:           "5m",
+			opts:          []Option{WithIdleThreshold(time.Minute)},
+			idleThreshold: time.Minute,
+		},
+		{
+			name:          "DisabledByOption",
+			env:           "5m",
+			opts:          []Option{WithIdleThreshold(0)},
+			idleThreshold: 0,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("YDB_DATABASE_SQL_IDLE_THRESHOLD", tt.env)
+			c, err := Open(testDriver{}, nil, append(tt.opts, clockOption{clockwork.NewFakeClock()})...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			defer func() {
+				_ = c.Close()
+			}()
+			require.Equal(t, tt.idleThreshold, c.idleThreshold)
+		})
+	}
+}
+
+func TestIdleReaperDisabled(t *testing.T) {
+	for _, idleThreshold := range []time.Duration{0, -time.Minute} {
+		t.Run(idleThreshold.String(), func(t *testing.T) {
+			t.Setenv("YDB_DATABASE_SQL_IDLE_THRESHOLD", "")
+			clock := &countingClock{FakeClock: clockwork.NewFakeClock()}
+			c, err := Open(testDriver{}, nil, WithIdleThreshold(idleThreshold), clockOption{clock})
+			require.NoError(t, err)
+			require.NoError(t, c.Close())
+			require.Zero(t, clock.timers.Load())
+		})
+	}
+}
+
+func TestIdleReaper(t *testing.T) {
+	ctx := xtest.Context(t)
+	clock := &countingClock{FakeClock: clockwork.NewFakeClock()}
+	c, err := Open(testDriver{}, nil, WithIdleThreshold(time.Minute), clockOption{clock})
+	require.NoError(t, err)
+	defer func() {
+		_ = c.Close()
+	}()
+	require.EqualValues(t, 1, clock.timers.Load())
+
+	cc := &closeInterceptor{closed: make(chan struct{})}
+	c.conns.Set(uuid.NewString(), &Conn{
+		cc:        cc,
+		ctx:       ctx,
+		connector: c,
+		lastUsage: xsync.NewLastUsage(xsync.WithClock(clock)),
+	})
+
+	clock.BlockUntil(1)
+	clock.Advance(2 * time.Minute)
+
+	select {
+	case <-cc.closed:
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		keepAlive int
+		conns     int
+		idle      int
+		alive     int
+	}{
+		{
+			name:      "NoKeepAlive",
+			keepAlive: 0,
+			conns:     5,
+			idle:      3,
+			alive:     2,
+		},
+		{
+			name:      "KeepAliveLessThanActive",
+			keepAlive: 1,
+			conns:     5,
+			idle:      3,
+			alive:     2,
+		},
+		{
+			name:      "KeepAliveGreaterThanActive",
+			keepAlive: 3,
+			conns:     5,
+			idle:      3,
+			alive:     3,
+		},
+		{
+			name:      "KeepAliveGreaterThanAll",
+			keepAlive: 10,
+			conns:     5,
+			idle:      5,
+			alive:     5,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			clock := clockwork.NewFakeClock()
+			c, err := Open(testDriver{}, nil,
+				WithKeepAlive(tt.keepAlive),
+				clockOption{clock},
+			)
+			require.NoError(t, err)
+			defer func() {
+				_ = c.Close()
+			}()
+
+			// background reaper not started, idle conns are closed explicitly
+			c.idleThreshold = time.Minute
+
+			interceptors := make([]*closeInterceptor, 0, tt.conns)
+			for i := 0; i < tt.conns; i++ {
+				if i == tt.idle {
+					clock.Advance(2 * time.Minute)
+				}
+				id := uuid.NewString()
+				cc := &closeInterceptor{
+					closed: make(chan struct{}),
+					onClose: func() {
+						c.conns.Delete(id)
+					},
+				}
+				interceptors = append(interceptors, cc)
+				c.conns.Set(id, &Conn{
+					cc:        cc,
+					ctx:       ctx,
+					connector: c,
+					lastUsage: xsync.NewLastUsage(xsync.WithClock(clock)),
+				})
+				clock.Advance(time.Second)
+			}
+			if tt.idle == tt.conns {
+				clock.Advance(2 * time.Minute)
+			}
+
+			c.closeIdleConns()
+
+			require.Len(t, c.Stats().Conns, tt.alive)
+			for i, cc := range interceptors {
+				select {
+				case <-cc.closed:
+					require.Less(t, i, tt.conns-tt.alive, "the most idle conns must be closed first")
+				default:
+				}
+			}
+		})
+	}
+}
+
+func TestConnectorClose(t *testing.T) {
+	t.Run("DoubleClose", func(t *testing.T) {
+		c, err := Open(testDriver{}, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+		require.ErrorIs(t, c.Close(), ErrConnectorClosed)
+		require.ErrorIs(t, c.Close(), ErrConnectorClosed)
+	})
+	t.Run("ConcurrentClose", func(t *testing.T) {
+		c, err := Open(testDriver{}, nil)
+		require.NoError(t, err)
+
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, 10)
+		)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = c.Close()
+			}(i)
+		}
+		wg.Wait()
+
+		var closed int
+		for _, err := range errs {
+			if err == nil {
+				closed++
+			} else {
+				require.ErrorIs(t, err, ErrConnectorClosed)
+			}
+		}
+		require.Equal(t, 1, closed)
+	})
+	t.Run("ConnectAfterClose", func(t *testing.T) {
+		c, err := Open(testDriver{}, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+
+		for _, processor := range []Engine{LEGACY, QUERY_SERVICE} {
+			c.processor = processor
+			cc, err := c.Connect(xtest.Context(t))
+			require.ErrorIs(t, err, ErrConnectorClosed)
+			require.Nil(t, cc)
+		}
+	})
+	t.Run("AttachAfterClose", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		c, err := Open(testDriver{}, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+
+		cc := &closeInterceptor{closed: make(chan struct{})}
+		id := uuid.NewString()
+		conn, err := c.attach(id, &Conn{
+			cc:        cc,
+			ctx:       ctx,
+			connector: c,
+			lastUsage: xsync.NewLastUsage(),
+		})
+		require.ErrorIs(t, err, ErrConnectorClosed)
+		require.Nil(t, conn)
+
+		select {
+		case <-cc.closed:
+		default:
+			t.Fatal("conn attached after close is not closed")
+		}
+	})
+	t.Run("CallbacksOrder", func(t *testing.T) {
+		var events []string
+		c, err := Open(testDriver{}, nil,
+			WithOnClose(func(*Connector) {
+				events = append(events, "onClose1")
+			}),
+			WithOnClosed(func(*Connector) {
+				events = append(events, "onClosed1")
+			}),
+			WithOnClose(func(*Connector) {
+				events = append(events, "onClose2")
+			}),
+			WithOnClosed(func(*Connector) {
+				events = append(events, "onClosed2")
+			}),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			c.conns.Set(uuid.NewString(), &Conn{
+				cc: &closeInterceptor{
+					closed: make(chan struct{}),
+					onClose: func() {
+						events = append(events, "connClose")
+					},
+				},
+				ctx:       xtest.Context(t),
+				connector: c,
+				lastUsage: xsync.NewLastUsage(),
+			})
+		}
+
+		require.NoError(t, c.Close())
+		require.Equal(t, []string{
+			"onClose1", "onClose2", "connClose", "connClose", "onClosed1", "onClosed2",
+		}, events)
+
+		require.ErrorIs(t, c.Close(), ErrConnectorClosed)
+		require.Len(t, events, 6)
+	})
+}
+
+type constBackoff time.Duration
+
+func (b constBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
+
+type noQuotaBudget struct{}
+
+func (noQuotaBudget) Acquire(context.Context) error {
+	return budget.ErrNoQuota
+}
+
+func TestConnectRetry(t *testing.T) {
+	var (
+		errUnavailable  = xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+		errNonRetryable = errors.New("non-retryable")
+	)
+	// failing returns create func which fails with errs before successful session creation
+	failing := func(calls *int, errs ...error) func(ctx context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) {
+			*calls++
+			if *calls <= len(errs) {
+				return "", errs[*calls-1]
+			}
+
+			return "session", nil
+		}
+	}
+	connector := func(t *testing.T, opts ...Option) *Connector {
+		c := &Connector{
+			clock: clockwork.NewRealClock(),
+		}
+		for _, opt := range opts {
+			require.NoError(t, opt.Apply(c))
+		}
+
+		return c
+	}
+	t.Run("FailsTwiceThenSucceeds", func(t *testing.T) {
+		var calls int
+		s, err := createSession(xtest.Context(t),
+			connector(t, WithConnectRetry(3, constBackoff(time.Millisecond))),
+			failing(&calls, errUnavailable, errUnavailable),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "session", s)
+		require.Equal(t, 3, calls)
+	})
+	t.Run("NonRetryable", func(t *testing.T) {
+		var calls int
+		_, err := createSession(xtest.Context(t),
+			connector(t, WithConnectRetry(3, constBackoff(time.Millisecond))),
+			failing(&calls, errNonRetryable, errNonRetryable, errNonRetryable),
+		)
+		require.ErrorIs(t, err, errNonRetryable)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("MaxAttempts", func(t *testing.T) {
+		var calls int
+		_, err := createSession(xtest.Context(t),
+			connector(t, WithConnectRetry(2, constBackoff(time.Millisecond))),
+			failing(&calls, errUnavailable, errUnavailable, errUnavailable),
+		)
+		require.ErrorIs(t, err, errUnavailable)
+		require.Equal(t, 2, calls)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		var calls int
+		_, err := createSession(xtest.Context(t), connector(t), failing(&calls, errUnavailable))
+		require.ErrorIs(t, err, errUnavailable)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(xtest.Context(t), 10*time.Millisecond)
+		defer cancel()
+
+		var calls int
+		_, err := createSession(ctx,
+			connector(t, WithConnectRetry(3, constBackoff(time.Hour))),
+			failing(&calls, errUnavailable, errUnavailable),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorIs(t, err, errUnavailable)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("RetryBudget", func(t *testing.T) {
+		var calls int
+		_, err := createSession(xtest.Context(t),
+			connector(t,
+				WithConnectRetry(3, constBackoff(time.Millisecond)),
+				WithRetryBudget(noQuotaBudget{}),
+			),
+			failing(&calls, errUnavailable, errUnavailable),
+		)
+		require.ErrorIs(t, err, budget.ErrNoQuota)
+		require.ErrorIs(t, err, errUnavailable)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("NilBackoff", func(t *testing.T) {
+		require.ErrorIs(t, WithConnectRetry(3, nil).Apply(&Connector{}), errNilConnectBackoff)
+	})
+}
+
+// sessionMetadataDriver captures outgoing metadata of session creation and fails it
+type sessionMetadataDriver struct {
+	testDriver
+	table.Client
+
+	md  metadata.MD
+	err error
+}
+
+func (d *sessionMetadataDriver) Table() table.Client {
+	return d
+}
+
+func (d *sessionMetadataDriver) CreateSession(ctx context.Context, _ ...table.Option) (table.ClosableSession, error) {
+	d.md, _ = metadata.FromOutgoingContext(ctx)
+
+	return nil, d.err
+}
+
+func TestServerBalancer(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		opts         []Option
+		capabilities []string
+	}{
+		{
+			name:         "Default",
+			capabilities: []string{meta.HintSessionBalancer},
+		},
+		{
+			name:         "Enabled",
+			opts:         []Option{WithServerBalancer(true)},
+			capabilities: []string{meta.HintSessionBalancer},
+		},
+		{
+			name: "Disabled",
+			opts: []Option{WithServerBalancer(false)},
+		},
+		{
+			name: "DisableServerBalancer",
+			opts: []Option{WithDisableServerBalancer()},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &sessionMetadataDriver{err: errors.New("test")}
+			c, err := Open(d, nil, append(tt.opts, WithQueryService(false))...)
+			require.NoError(t, err)
+			defer func() {
+				_ = c.Close()
+			}()
+
+			_, err = c.Connect(xtest.Context(t))
+			require.ErrorIs(t, err, d.err)
+			require.Equal(t, tt.capabilities, d.md.Get(meta.HeaderClientCapabilities))
+		})
+	}
+}
+
+// readyDriver fails session creation with err until number of calls exceeds failures
+type readyDriver struct {
+	testDriver
+	table.Client
+
+	failures int
+	calls    int
+	err      error
+}
+
+type readySession struct {
+	table.ClosableSession
+}
+
+func (d *readyDriver) Table() table.Client {
+	return d
+}
+
+func (d *readyDriver) CreateSession(context.Context, ...table.Option) (table.ClosableSession, error) {
+	d.calls++
+	if d.calls <= d.failures {
+		return nil, d.err
+	}
+
+	return readySession{}, nil
+}
+
+func (readySession) ID() string {
+	return "ready"
+}
+
+func (readySession) Status() table.SessionStatus {
+	return table.SessionReady
+}
+
+func (readySession) KeepAlive(context.Context) error {
+	return nil
+}
+
+func (readySession) Close(context.Context) error {
+	return nil
+}
+
+func TestWaitReady(t *testing.T) {
+	errUnavailable := xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	open := func(t *testing.T, d *readyDriver, opts ...Option) *Connector {
+		c, err := Open(d, nil, append(opts, WithQueryService(false))...)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+
+		return c
+	}
+	t.Run("ReadyAfterFailures", func(t *testing.T) {
+		d := &readyDriver{failures: 3, err: errUnavailable}
+		c := open(t, d)
+		require.NoError(t, c.WaitReady(xtest.Context(t), time.Millisecond))
+		require.Equal(t, 4, d.calls)
+	})
+	t.Run("Ready", func(t *testing.T) {
+		d := &readyDriver{}
+		c := open(t, d)
+		require.NoError(t, c.WaitReady(xtest.Context(t), time.Hour))
+		require.Equal(t, 1, d.calls)
+	})
+	t.Run("NeverReady", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(xtest.Context(t), 50*time.Millisecond)
+		defer cancel()
+
+		d := &readyDriver{failures: math.MaxInt, err: errUnavailable}
+		c := open(t, d)
+		err := c.WaitReady(ctx, time.Millisecond)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorIs(t, err, errUnavailable)
+		require.Greater(t, d.calls, 1)
+	})
+	t.Run("NonRetryable", func(t *testing.T) {
+		errNonRetryable := errors.New("non-retryable")
+		d := &readyDriver{failures: 1, err: errNonRetryable}
+		c := open(t, d)
+		require.ErrorIs(t, c.WaitReady(xtest.Context(t), time.Millisecond), errNonRetryable)
+		require.Equal(t, 1, d.calls)
+	})
+	t.Run("RetryBudget", func(t *testing.T) {
+		d := &readyDriver{failures: 3, err: errUnavailable}
+		c := open(t, d, WithRetryBudget(noQuotaBudget{}))
+		err := c.WaitReady(xtest.Context(t), time.Millisecond)
+		require.ErrorIs(t, err, budget.ErrNoQuota)
+		require.ErrorIs(t, err, errUnavailable)
+		require.Equal(t, 1, d.calls)
+	})
+}
+
+// readySessionDriver creates ready sessions
+type readySessionDriver struct {
+	testDriver
+	table.Client
+}
+
+func (d readySessionDriver) Table() table.Client {
+	return d
+}
+
+func (readySessionDriver) CreateSession(context.Context, ...table.Option) (table.ClosableSession, error) {
+	return readySession{}, nil
+}
+
+func TestConnIDs(t *testing.T) {
+	const conns = 100
+	connect := func(t *testing.T, opts ...Option) (ids, traced []string, stats Stats) {
+		var mu sync.Mutex
+		c, err := Open(readySessionDriver{}, nil, append(opts,
+			WithQueryService(false),
+			WithTrace(&trace.DatabaseSQL{
+				OnConnectorConnect: func(trace.DatabaseSQLConnectorConnectStartInfo) func(
+					trace.DatabaseSQLConnectorConnectDoneInfo,
+				) {
+					return func(info trace.DatabaseSQLConnectorConnectDoneInfo) {
+						mu.Lock()
+						defer mu.Unlock()
+						traced = append(traced, info.ConnID)
+					}
+				},
+			}),
+		)...)
+		require.NoError(t, err)
+		defer func() {
+			_ = c.Close()
+		}()
+
+		ids = make([]string, conns)
+		var wg sync.WaitGroup
+		for i := range ids {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				cc, err := c.Connect(xtest.Context(t))
+				require.NoError(t, err)
+				ids[i] = cc.(*Conn).ID()
+			}(i)
+		}
+		wg.Wait()
+
+		return ids, traced, c.Stats()
+	}
+	t.Run("Sequential", func(t *testing.T) {
+		ids, traced, stats := connect(t, WithSequentialConnIDs())
+		expected := make([]string, conns)
+		for i := range expected {
+			expected[i] = strconv.Itoa(i + 1)
+		}
+		require.ElementsMatch(t, expected, ids)
+		require.ElementsMatch(t, expected, traced)
+		require.Len(t, stats.Conns, conns)
+		for _, s := range stats.Conns {
+			require.Contains(t, expected, s.ID)
+		}
+	})
+	t.Run("UUID", func(t *testing.T) {
+		ids, traced, _ := connect(t)
+		require.ElementsMatch(t, ids, traced)
+		unique := make(map[string]struct{}, conns)
+		for _, id := range ids {
+			_, err := uuid.Parse(id)
+			require.NoError(t, err)
+			unique[id] = struct{}{}
+		}
+		require.Len(t, unique, conns)
+	})
+}
+
+func TestStatementMiddleware(t *testing.T) {
+	ctx := legacy.WithQueryMode(xtest.Context(t), legacy.SchemeQueryMode)
+	open := func(t *testing.T, opts ...Option) (*affinityDriver, *Conn) {
+		d := &affinityDriver{
+			available: []uint32{1},
+			executed:  make(map[string][]uint32),
+		}
+		c, err := Open(d, affinityBalancer{nodeIDs: []uint32{1}}, append([]Option{WithQueryService(false)}, opts...)...)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+		cc, err := c.Connect(ctx)
+		require.NoError(t, err)
+
+		return d, cc.(*Conn)
+	}
+	t.Run("Rewrite", func(t *testing.T) {
+		var seen []string
+		d, cc := open(t,
+			WithTablePathPrefix("/local/db"),
+			WithStatementMiddleware(func(ctx context.Context, sql string) (string, error) {
+				seen = append(seen, sql)
+
+				return sql + "\n-- tag: test", nil
+			}),
+			WithStatementMiddleware(func(ctx context.Context, sql string) (string, error) {
+				return sql + "\n-- tag: second", nil
+			}),
+		)
+		_, err := cc.ExecContext(ctx, "DROP TABLE t", nil)
+		require.NoError(t, err)
+		stmt, err := cc.PrepareContext(ctx, "DROP TABLE s")
+		require.NoError(t, err)
+		_, err = stmt.(*Stmt).ExecContext(ctx, nil)
+		require.NoError(t, err)
+
+		// middleware sees query after binding
+		require.Len(t, seen, 2)
+		require.Contains(t, seen[0], `PRAGMA TablePathPrefix("/local/db");`)
+		require.Contains(t, seen[0], "DROP TABLE t")
+		require.Contains(t, seen[1], "DROP TABLE s")
+		require.Len(t, d.executed, 2)
+		for _, sql := range seen {
+			require.Contains(t, d.executed, sql+"\n-- tag: test\n-- tag: second")
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		errForbidden := errors.New("forbidden")
+		d, cc := open(t, WithStatementMiddleware(func(ctx context.Context, sql string) (string, error) {
+			return "", errForbidden
+		}))
+		_, err := cc.ExecContext(ctx, "DROP TABLE t", nil)
+		require.ErrorIs(t, err, errForbidden)
+		_, err = cc.QueryContext(ctx, "SELECT 1", nil)
+		require.ErrorIs(t, err, errForbidden)
+		require.Empty(t, d.executed)
+	})
+}
+
+func TestMinPoolSize(t *testing.T) {
+	open := func(t *testing.T, d ydbDriver, clock clockwork.Clock, opts ...Option) *Connector {
+		c, err := Open(d, nil, append(opts, WithQueryService(false), clockOption{clock})...)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+
+		return c
+	}
+	t.Run("WarmConnsSurviveReaper", func(t *testing.T) {
+		clock := clockwork.NewFakeClock()
+		c := open(t, readySessionDriver{}, clock, WithMinPoolSize(3), WithIdleThreshold(time.Minute))
+		require.Eventually(t, func() bool {
+			return len(c.warmConns) == 3
+		}, time.Second, time.Millisecond)
+		require.Len(t, c.Stats().Conns, 3)
+
+		clock.Advance(2 * time.Minute)
+		c.closeIdleConns()
+		require.Len(t, c.Stats().Conns, 3)
+
+		cc, err := c.Connect(xtest.Context(t))
+		require.NoError(t, err)
+		require.Len(t, c.Stats().Conns, 3, "warm conn must be taken instead of creation of new conn")
+		require.False(t, cc.(*Conn).warm.Load())
+
+		clock.Advance(2 * time.Minute)
+		c.closeIdleConns()
+		stats := c.Stats()
+		require.Len(t, stats.Conns, 2, "taken conn must be closed by reaper")
+		for _, s := range stats.Conns {
+			require.NotEqual(t, cc.(*Conn).ID(), s.ID)
+		}
+	})
+	t.Run("FailuresAreTraced", func(t *testing.T) {
+		errNonRetryable := errors.New("non-retryable")
+		var (
+			mu     sync.Mutex
+			traced []error
+		)
+		c := open(t, &readyDriver{failures: 1, err: errNonRetryable}, clockwork.NewFakeClock(),
+			WithMinPoolSize(2),
+			WithTrace(&trace.DatabaseSQL{
+				OnConnectorConnect: func(trace.DatabaseSQLConnectorConnectStartInfo) func(
+					trace.DatabaseSQLConnectorConnectDoneInfo,
+				) {
+					return func(info trace.DatabaseSQLConnectorConnectDoneInfo) {
+						mu.Lock()
+						defer mu.Unlock()
+						traced = append(traced, info.Error)
+					}
+				},
+			}),
+		)
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return len(traced) == 2
+		}, time.Second, time.Millisecond)
+		require.ErrorIs(t, traced[0], errNonRetryable)
+		require.NoError(t, traced[1])
+		require.Len(t, c.Stats().Conns, 1)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		c := open(t, readySessionDriver{}, clockwork.NewFakeClock())
+		require.Nil(t, c.warmConns)
+		require.Empty(t, c.Stats().Conns)
+	})
+}
@@ -1,8 +1,20 @@
 package xsql
 
-import "context"
+import (
+	"context"
 
-type ctxExplainQueryModeKey struct{}
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/propose"
+)
+
+type (
+	ctxExplainQueryModeKey struct{}
+	ctxTablePathPrefixKey  struct{}
+	ctxTimeArgsKey         struct{}
+	ctxSessionIDKey        struct{}
+	ctxOnSessionAssigned   struct{}
+)
 
 func WithExplain(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ctxExplainQueryModeKey{}, true)
@@ -13,3 +25,54 @@ func isExplain(ctx context.Context) bool {
 
 	return has && v
 }
+
+// WithTxControl defines transaction control for standalone (non-transactional) statements
+// executed over query service. Unset transaction control means the default one
+func WithTxControl(ctx context.Context, txc *tx.Control) context.Context {
+	return propose.WithTxControl(ctx, txc)
+}
+
+// WithStatementTablePathPrefix defines table path prefix for statements executed with ctx.
+// Relative table paths of statement resolves under prefix instead of connector default
+func WithStatementTablePathPrefix(ctx context.Context, tablePathPrefix string) context.Context {
+	return context.WithValue(ctx, ctxTablePathPrefixKey{}, bind.TablePathPrefix(tablePathPrefix))
+}
+
+func tablePathPrefix(ctx context.Context) (bind.TablePathPrefix, bool) {
+	tablePathPrefix, has := ctx.Value(ctxTablePathPrefixKey{}).(bind.TablePathPrefix)
+
+	return tablePathPrefix, has
+}
+
+// WithStatementTimeArgs defines YDB type of time.Time args of statements executed with ctx
+// instead of connector settings
+func WithStatementTimeArgs(ctx context.Context, timeArgs bind.TimeArgs) context.Context {
+	return context.WithValue(ctx, ctxTimeArgsKey{}, timeArgs)
+}
+
+func timeArgs(ctx context.Context) (bind.TimeArgs, bool) {
+	timeArgs, has := ctx.Value(ctxTimeArgsKey{}).(bind.TimeArgs)
+
+	return timeArgs, has
+}
+
+// WithOnSessionAssigned defines callback which calls with YDB session id of conn
+// before execution of each statement with ctx
+func WithOnSessionAssigned(ctx context.Context, onSessionAssigned func(sessionID string)) context.Context {
+	return context.WithValue(ctx, ctxOnSessionAssigned{}, onSessionAssigned)
+}
+
+// SessionID returns YDB session id of conn which executes statement with ctx
+func SessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(ctxSessionIDKey{}).(string)
+
+	return sessionID
+}
+
+func withSessionID(ctx context.Context, sessionID string) context.Context {
+	if onSessionAssigned, has := ctx.Value(ctxOnSessionAssigned{}).(func(string)); has && onSessionAssigned != nil {
+		onSessionAssigned(sessionID)
+	}
+
+	return context.WithValue(ctx, ctxSessionIDKey{}, sessionID)
+}
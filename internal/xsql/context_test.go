@@ -0,0 +1,220 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// sqlInterceptor captures query text of each call to the underlying conn
+type sqlInterceptor struct {
+	iface.Conn
+
+	queries []string
+}
+
+func (i *sqlInterceptor) IsValid() bool {
+	return true
+}
+
+func (i *sqlInterceptor) ID() string {
+	return "test-session-id"
+}
+
+func (i *sqlInterceptor) Close() error {
+	return nil
+}
+
+func (i *sqlInterceptor) Exec(_ context.Context, sql string, _ *params.Params) (driver.Result, error) {
+	i.queries = append(i.queries, sql)
+
+	return driver.ResultNoRows, nil
+}
+
+func (i *sqlInterceptor) Query(_ context.Context, sql string, _ *params.Params) (driver.RowsNextResultSet, error) {
+	i.queries = append(i.queries, sql)
+
+	return nil, nil //nolint:nilnil
+}
+
+func TestWithStatementTablePathPrefix(t *testing.T) {
+	const pragma = `PRAGMA TablePathPrefix("%s");`
+
+	for _, tt := range []struct {
+		name     string
+		opts     []Option
+		prefixes []string
+	}{
+		{
+			name:     "WithoutConnectorPrefix",
+			prefixes: []string{"", "/tenant", ""},
+		},
+		{
+			name:     "WithConnectorPrefix",
+			opts:     []Option{WithTablePathPrefix("/local")},
+			prefixes: []string{"/local", "/tenant", "/local"},
+		},
+		{
+			name: "WithConnectorBindings",
+			opts: []Option{
+				WithQueryBind(bind.AutoDeclare{}),
+				WithTablePathPrefix("/local"),
+			},
+			prefixes: []string{"/local", "/tenant", "/local"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			c := &Connector{
+				clock:      clockwork.NewFakeClock(),
+				trace:      &trace.DatabaseSQL{},
+				traceRetry: &trace.Retry{},
+			}
+			for _, opt := range tt.opts {
+				require.NoError(t, opt.Apply(c))
+			}
+
+			interceptor := &sqlInterceptor{}
+			conn := &Conn{
+				cc:        interceptor,
+				ctx:       ctx,
+				connector: c,
+				lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+			}
+
+			_, err := conn.ExecContext(ctx, "SELECT * FROM t", nil)
+			require.NoError(t, err)
+			_, err = conn.QueryContext(WithStatementTablePathPrefix(ctx, "/tenant"), "SELECT * FROM t", nil)
+			require.NoError(t, err)
+			_, err = conn.QueryContext(ctx, "SELECT * FROM t", nil)
+			require.NoError(t, err)
+
+			require.Len(t, interceptor.queries, len(tt.prefixes))
+			for i, prefix := range tt.prefixes {
+				if prefix == "" {
+					require.NotContains(t, interceptor.queries[i], "PRAGMA TablePathPrefix")
+				} else {
+					require.Equal(t, 1, strings.Count(interceptor.queries[i], "PRAGMA TablePathPrefix"))
+					require.Contains(t, interceptor.queries[i], fmt.Sprintf(pragma, prefix))
+				}
+			}
+		})
+	}
+	t.Run("WrongPrefix", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		c := &Connector{
+			clock:      clockwork.NewFakeClock(),
+			trace:      &trace.DatabaseSQL{},
+			traceRetry: &trace.Retry{},
+		}
+		interceptor := &sqlInterceptor{}
+		conn := &Conn{
+			cc:        interceptor,
+			ctx:       ctx,
+			connector: c,
+			lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+		}
+
+		_, err := conn.ExecContext(WithStatementTablePathPrefix(ctx, "tenant"), "SELECT * FROM t", nil)
+		require.ErrorIs(t, err, bind.ErrWrongTablePathPrefix)
+		require.Empty(t, interceptor.queries)
+	})
+}
+
+// sessionInterceptor captures session id from context of each call to the underlying conn
+type sessionInterceptor struct {
+	sqlInterceptor
+
+	sessionIDs []string
+}
+
+func (i *sessionInterceptor) Exec(ctx context.Context, sql string, params *params.Params) (driver.Result, error) {
+	i.sessionIDs = append(i.sessionIDs, SessionID(ctx))
+
+	return i.sqlInterceptor.Exec(ctx, sql, params)
+}
+
+func (i *sessionInterceptor) Query(ctx context.Context, sql string, params *params.Params) (
+	driver.RowsNextResultSet, error,
+) {
+	i.sessionIDs = append(i.sessionIDs, SessionID(ctx))
+
+	return i.sqlInterceptor.Query(ctx, sql, params)
+}
+
+func TestWithOnSessionAssigned(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := &Connector{
+		clock:      clockwork.NewFakeClock(),
+		trace:      &trace.DatabaseSQL{},
+		traceRetry: &trace.Retry{},
+	}
+	interceptor := &sessionInterceptor{}
+	db := sql.OpenDB(connConnector{conn: &Conn{
+		cc:        interceptor,
+		ctx:       ctx,
+		connector: c,
+		lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+	}})
+	defer db.Close()
+
+	var assigned []string
+	_, err := db.ExecContext(WithOnSessionAssigned(ctx, func(sessionID string) {
+		assigned = append(assigned, sessionID)
+	}), "SELECT 1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-session-id"}, assigned)
+
+	_, err = db.ExecContext(ctx, "SELECT 1")
+	require.NoError(t, err)
+	require.Len(t, assigned, 1)
+
+	require.Equal(t, []string{"test-session-id", "test-session-id"}, interceptor.sessionIDs)
+	require.Empty(t, SessionID(ctx))
+}
+
+func TestWithStatementTimeArgs(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := &Connector{
+		clock:      clockwork.NewFakeClock(),
+		trace:      &trace.DatabaseSQL{},
+		traceRetry: &trace.Retry{},
+	}
+	require.NoError(t, WithQueryBind(bind.AutoDeclare{}).Apply(c))
+	require.NoError(t, WithQueryBind(bind.TimeArgsDatetime).Apply(c))
+
+	interceptor := &sqlInterceptor{}
+	conn := &Conn{
+		cc:        interceptor,
+		ctx:       ctx,
+		connector: c,
+		lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+	}
+
+	args := []driver.NamedValue{{Name: "t", Value: time.Now()}}
+	_, err := conn.ExecContext(ctx, "SELECT $t", args)
+	require.NoError(t, err)
+	_, err = conn.ExecContext(WithStatementTimeArgs(ctx, bind.TimeArgsDate), "SELECT $t", args)
+	require.NoError(t, err)
+	_, err = conn.ExecContext(WithStatementTimeArgs(ctx, bind.TimeArgsTimestamp), "SELECT $t", args)
+	require.NoError(t, err)
+
+	require.Len(t, interceptor.queries, 3)
+	require.Contains(t, interceptor.queries[0], "DECLARE $t AS Datetime;")
+	require.Contains(t, interceptor.queries[1], "DECLARE $t AS Date;")
+	require.Contains(t, interceptor.queries[2], "DECLARE $t AS Timestamp;")
+}
@@ -4,13 +4,70 @@ import (
 	"database/sql/driver"
 	"errors"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
 var (
 	ErrUnsupported         = driver.ErrSkip
 	errDeprecated          = driver.ErrSkip
-	errAlreadyClosed       = errors.New("already closed")
+	ErrConnectorClosed     = xerrors.Wrap(errors.New("ydb: connector closed"))
 	errWrongQueryProcessor = errors.New("wrong query processor")
 	errNotReadyConn        = xerrors.Retryable(errors.New("iface not ready"), xerrors.InvalidObject())
+	errNilConnectBackoff   = errors.New("nil backoff of connect retry")
+	errStmtCacheOverQuery  = errors.New("statement cache is supported by legacy (table service) engine only")
+	errWrongIdleThreshold  = errors.New("wrong value of YDB_DATABASE_SQL_IDLE_THRESHOLD environment variable")
 )
+
+// StatementError reports about fail of statement which was rejected by server.
+// StatementError is reachable with errors.As from errors returned by database/sql
+type StatementError struct {
+	err    error
+	code   Ydb.StatusIds_StatusCode
+	issues []*Ydb_Issue.IssueMessage
+}
+
+// Code returns status code of operation
+func (e *StatementError) Code() Ydb.StatusIds_StatusCode {
+	return e.code
+}
+
+// Issues returns issues of operation reported by server
+func (e *StatementError) Issues() []*Ydb_Issue.IssueMessage {
+	return e.issues
+}
+
+func (e *StatementError) Error() string {
+	return e.err.Error()
+}
+
+func (e *StatementError) Unwrap() error {
+	return e.err
+}
+
+// statementError wraps operation error to StatementError. Other errors returns as is
+func statementError(err error) error {
+	oe := xerrors.OperationError(err)
+	if oe == nil {
+		return err
+	}
+
+	var se *StatementError
+	if xerrors.As(err, &se) {
+		return err
+	}
+
+	se = &StatementError{
+		err:  err,
+		code: Ydb.StatusIds_StatusCode(oe.Code()),
+	}
+	if withIssues, has := oe.(interface {
+		Issues() []*Ydb_Issue.IssueMessage
+	}); has {
+		se.issues = withIssues.Issues()
+	}
+
+	return se
+}
@@ -0,0 +1,156 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// failingConn fails each statement with err
+type failingConn struct {
+	iface.Conn
+
+	err error
+}
+
+func (c *failingConn) IsValid() bool {
+	return true
+}
+
+func (c *failingConn) ID() string {
+	return "test-session-id"
+}
+
+func (c *failingConn) Close() error {
+	return nil
+}
+
+func (c *failingConn) Exec(context.Context, string, *params.Params) (driver.Result, error) {
+	return nil, c.err
+}
+
+func (c *failingConn) Query(context.Context, string, *params.Params) (driver.RowsNextResultSet, error) {
+	return nil, c.err
+}
+
+// connConnector connects database/sql to the predefined conn
+type connConnector struct {
+	conn *Conn
+}
+
+func (c connConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func (c connConnector) Driver() driver.Driver {
+	return nil
+}
+
+func TestStatementError(t *testing.T) {
+	uniqueViolation := xerrors.WithStackTrace(xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_PRECONDITION_FAILED),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+			Message:   "Conflict with existing key.",
+			IssueCode: 2012,
+			Severity:  1,
+		}}),
+	))
+
+	ctx := xtest.Context(t)
+	c := &Connector{
+		clock:      clockwork.NewFakeClock(),
+		trace:      &trace.DatabaseSQL{},
+		traceRetry: &trace.Retry{},
+	}
+	db := sql.OpenDB(connConnector{conn: &Conn{
+		cc:        &failingConn{err: uniqueViolation},
+		ctx:       ctx,
+		connector: c,
+		lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+	}})
+	defer db.Close()
+
+	for _, tt := range []struct {
+		name string
+		exec func() error
+	}{
+		{
+			name: "Exec",
+			exec: func() error {
+				_, err := db.ExecContext(ctx, "INSERT INTO t (id) VALUES (1)")
+
+				return err
+			},
+		},
+		{
+			name: "Query",
+			exec: func() error {
+				rows, err := db.QueryContext(ctx, "SELECT * FROM t")
+				if err == nil {
+					_ = rows.Close()
+				}
+
+				return err
+			},
+		},
+		{
+			name: "Stmt",
+			exec: func() error {
+				stmt, err := db.PrepareContext(ctx, "INSERT INTO t (id) VALUES (1)")
+				if err != nil {
+					return err
+				}
+				defer stmt.Close()
+
+				_, err = stmt.ExecContext(ctx)
+
+				return err
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.exec()
+			require.Error(t, err)
+
+			var statementErr *StatementError
+			require.True(t, errors.As(err, &statementErr))
+			require.Equal(t, Ydb.StatusIds_PRECONDITION_FAILED, statementErr.Code())
+			require.Len(t, statementErr.Issues(), 1)
+			require.EqualValues(t, 2012, statementErr.Issues()[0].GetIssueCode())
+			require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_PRECONDITION_FAILED))
+			require.Equal(t, uniqueViolation.Error(), err.Error())
+
+			var statusErr xerrors.StatusError
+			require.True(t, errors.As(err, &statusErr))
+			require.Equal(t, grpcCodes.FailedPrecondition, statusErr.Status().Code())
+			require.Len(t, statusErr.Status().Details(), 1)
+			issue, ok := statusErr.Status().Details()[0].(*Ydb_Issue.IssueMessage)
+			require.True(t, ok)
+			require.EqualValues(t, 2012, issue.GetIssueCode())
+		})
+	}
+	t.Run("NotOperationError", func(t *testing.T) {
+		err := errors.New("test")
+		require.Equal(t, err, statementError(err))
+		require.NoError(t, statementError(nil))
+	})
+	t.Run("AlreadyWrapped", func(t *testing.T) {
+		err := statementError(uniqueViolation)
+		require.Equal(t, err, statementError(err))
+	})
+}
@@ -12,8 +12,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy/badconn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -37,6 +37,7 @@ type (
 
 		defaultTxControl *table.TransactionControl
 		dataOpts         []options.ExecuteDataQueryOption
+		noKeepInCache    bool
 
 		scanOpts []options.ExecuteScanQueryOption
 
@@ -134,15 +135,15 @@ func (c *Conn) executeDataQuery(ctx context.Context, sql string, params *params.
 		sql, params, c.dataQueryOptions(ctx)...,
 	)
 	if err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	defer res.Close()
 
 	if err := res.NextResultSetErr(ctx); err != nil && !xerrors.Is(err, nil, io.EOF) {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	if err := res.Err(); err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return resultNoRows{}, nil
@@ -150,7 +151,7 @@ func (c *Conn) executeDataQuery(ctx context.Context, sql string, params *params.
 
 func (c *Conn) executeSchemeQuery(ctx context.Context, sql string) (driver.Result, error) {
 	if err := c.session.ExecuteSchemeQuery(ctx, sql); err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return resultNoRows{}, nil
@@ -161,15 +162,15 @@ func (c *Conn) executeScriptingQuery(ctx context.Context, sql string, params *pa
 ) {
 	res, err := c.parent.Scripting().StreamExecute(ctx, sql, params)
 	if err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	defer res.Close()
 
 	if err := res.NextResultSetErr(ctx); err != nil && !xerrors.Is(err, nil, io.EOF) {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	if err := res.Err(); err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return resultNoRows{}, nil
@@ -183,10 +184,10 @@ func (c *Conn) execDataQuery(ctx context.Context, sql string, params *params.Par
 		sql, params, c.dataQueryOptions(ctx)...,
 	)
 	if err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	if err = res.Err(); err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return &rows{
@@ -202,10 +203,10 @@ func (c *Conn) execScanQuery(ctx context.Context, sql string, params *params.Par
 		sql, params, c.scanOpts...,
 	)
 	if err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	if err = res.Err(); err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return &rows{
@@ -219,10 +220,10 @@ func (c *Conn) execScriptingQuery(ctx context.Context, sql string, params *param
 ) {
 	res, err := c.parent.Scripting().StreamExecute(ctx, sql, params)
 	if err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 	if err = res.Err(); err != nil {
-		return nil, badconn.Map(xerrors.WithStackTrace(err))
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return &rows{
@@ -265,6 +266,10 @@ func (c *Conn) ID() string {
 	return c.session.ID()
 }
 
+func (c *Conn) NodeID() uint32 {
+	return c.session.NodeID()
+}
+
 func (c *Conn) beginTx(ctx context.Context, txOptions driver.TxOptions) (tx iface.Tx, finalErr error) {
 	m := queryModeFromContext(ctx, c.defaultQueryMode)
 
@@ -0,0 +1,240 @@
+package legacy
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	internalTable "github.com/ydb-platform/ydb-go-sdk/v3/internal/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+type testSession struct {
+	table.ClosableSession
+
+	status table.SessionStatus
+	err    error
+	sent   int
+}
+
+func (s *testSession) Status() table.SessionStatus {
+	return s.status
+}
+
+func (s *testSession) Execute(context.Context, *table.TransactionControl, string, *params.Params,
+	...options.ExecuteDataQueryOption,
+) (table.Transaction, result.Result, error) {
+	s.sent++
+
+	return nil, nil, s.err
+}
+
+func TestConnBadConn(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		session *testSession
+		sent    int
+		badConn bool
+	}{
+		{
+			name: "SessionExpiredBeforeSend",
+			session: &testSession{
+				status: table.SessionClosed,
+			},
+			sent:    0,
+			badConn: true,
+		},
+		{
+			name: "SessionExpiredAfterSend",
+			session: &testSession{
+				status: table.SessionReady,
+				err:    xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SESSION_EXPIRED)),
+			},
+			sent:    1,
+			badConn: true,
+		},
+		{
+			name: "BadSessionAfterSend",
+			session: &testSession{
+				status: table.SessionReady,
+				err:    xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+			},
+			sent:    1,
+			badConn: true,
+		},
+		{
+			name: "TransportErrorAfterSend",
+			session: &testSession{
+				status: table.SessionReady,
+				err:    xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+			},
+			sent:    1,
+			badConn: false,
+		},
+		{
+			name: "OverloadedAfterSend",
+			session: &testSession{
+				status: table.SessionReady,
+				err:    xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			},
+			sent:    1,
+			badConn: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			cc := New(ctx, nil, tt.session)
+
+			_, err := cc.Exec(ctx, "UPSERT INTO t (a) VALUES (1)", nil)
+			require.Error(t, err)
+			require.Equal(t, tt.badConn, xerrors.Is(err, driver.ErrBadConn))
+			if tt.session.err != nil {
+				require.ErrorIs(t, err, tt.session.err)
+			}
+
+			_, err = cc.Query(ctx, "SELECT 1", nil)
+			require.Error(t, err)
+			require.Equal(t, tt.badConn, xerrors.Is(err, driver.ErrBadConn))
+
+			require.Equal(t, 2*tt.sent, tt.session.sent)
+		})
+	}
+}
+
+func TestDataQueryOptionsKeepInCache(t *testing.T) {
+	keepInCache := func(ctx context.Context, c *Conn, parameterized bool) bool {
+		a := allocator.New()
+		defer a.Free()
+
+		desc := options.ExecuteDataQueryDesc{
+			ExecuteDataQueryRequest: a.TableExecuteDataQueryRequest(),
+		}
+		if parameterized {
+			// session enables keep-in-cache policy of parameterized queries by default
+			desc.QueryCachePolicy = a.TableQueryCachePolicy()
+			desc.QueryCachePolicy.KeepInCache = true
+		}
+		for _, opt := range c.dataQueryOptions(ctx) {
+			opt.ApplyExecuteDataQueryOption(&desc, a)
+		}
+
+		return desc.GetQueryCachePolicy().GetKeepInCache()
+	}
+
+	ctx := xtest.Context(t)
+	t.Run("Default", func(t *testing.T) {
+		require.False(t, keepInCache(ctx, &Conn{}, false))
+		require.True(t, keepInCache(ctx, &Conn{}, true))
+		require.True(t, keepInCache(iface.WithPreparedStatement(ctx), &Conn{}, false))
+	})
+	t.Run("WithoutKeepInCache", func(t *testing.T) {
+		c := &Conn{}
+		WithoutKeepInCache()(c)
+		require.False(t, keepInCache(ctx, c, false))
+		require.False(t, keepInCache(ctx, c, true))
+		// prepared statements are kept in cache anyway
+		require.True(t, keepInCache(iface.WithPreparedStatement(ctx), c, true))
+	})
+	t.Run("WithoutKeepInCacheOverriddenByDataOpts", func(t *testing.T) {
+		c := &Conn{}
+		WithoutKeepInCache()(c)
+		WithDataOpts(options.WithKeepInCache(true))(c)
+		require.True(t, keepInCache(ctx, c, true))
+	})
+}
+
+func TestConnAutoCommit(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		txControl *table.TransactionControl
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name:      "BeginWithoutCommit",
+			txControl: table.SerializableReadWriteTxControl(),
+		},
+		{
+			name:      "OnlineReadOnly",
+			txControl: table.OnlineReadOnlyTxControl(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			var (
+				calls    []testutil.MethodCode
+				requests []*Ydb_Table.ExecuteDataQueryRequest
+			)
+			call := func(method testutil.MethodCode, result proto.Message) func(interface{}) (proto.Message, error) {
+				return func(interface{}) (proto.Message, error) {
+					calls = append(calls, method)
+
+					return result, nil
+				}
+			}
+			client := internalTable.New(ctx, testutil.NewBalancer(
+				testutil.WithInvokeHandlers(
+					testutil.InvokeHandlers{
+						testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+							return &Ydb_Table.CreateSessionResult{
+								SessionId: testutil.SessionID(),
+							}, nil
+						},
+						testutil.TableExecuteDataQuery: func(request interface{}) (proto.Message, error) {
+							calls = append(calls, testutil.TableExecuteDataQuery)
+							// request is recycled by allocator after call
+							r, ok := proto.Clone(request.(proto.Message)).(*Ydb_Table.ExecuteDataQueryRequest)
+							require.True(t, ok)
+							requests = append(requests, r)
+
+							return &Ydb_Table.ExecuteQueryResult{}, nil
+						},
+						testutil.TableBeginTransaction: call(testutil.TableBeginTransaction,
+							&Ydb_Table.BeginTransactionResult{
+								TxMeta: &Ydb_Table.TransactionMeta{Id: "tx"},
+							},
+						),
+						testutil.TableCommitTransaction: call(testutil.TableCommitTransaction,
+							&Ydb_Table.CommitTransactionResult{},
+						),
+					},
+				),
+			), config.New())
+			defer func() {
+				_ = client.Close(ctx)
+			}()
+			s, err := client.CreateSession(ctx)
+			require.NoError(t, err)
+
+			cc := New(ctx, nil, s)
+			if tt.txControl != nil {
+				ctx = WithTxControl(ctx, tt.txControl)
+			}
+
+			_, err = cc.Exec(ctx, "INSERT INTO t (a) VALUES (1)", nil)
+			require.NoError(t, err)
+
+			require.Equal(t, []testutil.MethodCode{testutil.TableExecuteDataQuery}, calls)
+			require.Len(t, requests, 1)
+			require.NotNil(t, requests[0].GetTxControl().GetBeginTx())
+			require.True(t, requests[0].GetTxControl().GetCommitTx())
+		})
+	}
+}
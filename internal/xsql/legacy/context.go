@@ -3,6 +3,8 @@ package legacy
 import (
 	"context"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -30,10 +32,25 @@ func txControl(ctx context.Context, defaultTxControl *table.TransactionControl)
 		}
 	}()
 	if txc, ok := ctx.Value(ctxTransactionControlKey{}).(*table.TransactionControl); ok {
+		return autoCommit(txc)
+	}
+
+	return autoCommit(defaultTxControl)
+}
+
+// autoCommit makes transaction control of statement outside of transaction (autocommit) commit
+// transaction in the same request, so begin of transaction doesn't leave transaction opened and
+// statement doesn't need begin → execute → commit round-trips
+func autoCommit(txc *table.TransactionControl) *table.TransactionControl {
+	if txc.Desc().GetBeginTx() == nil || txc.Desc().GetCommitTx() {
 		return txc
 	}
 
-	return defaultTxControl
+	commitTxc := table.TxControl()
+	proto.Merge(commitTxc.Desc(), txc.Desc())
+	commitTxc.Desc().CommitTx = true
+
+	return commitTxc
 }
 
 func (c *Conn) dataQueryOptions(ctx context.Context) []options.ExecuteDataQueryOption {
@@ -41,5 +58,10 @@ func (c *Conn) dataQueryOptions(ctx context.Context) []options.ExecuteDataQueryO
 		return append(c.dataOpts, options.WithKeepInCache(true))
 	}
 
+	if c.noKeepInCache {
+		// default data query options are applied after, so they can enable keep-in-cache back
+		return append([]options.ExecuteDataQueryOption{options.WithoutKeepInCache()}, c.dataOpts...)
+	}
+
 	return c.dataOpts
 }
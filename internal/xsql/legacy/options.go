@@ -24,6 +24,14 @@ func WithDataOpts(dataOpts ...options.ExecuteDataQueryOption) Option {
 	}
 }
 
+// WithoutKeepInCache disables keep-in-cache policy of parameterized data queries.
+// Prepared statements are executed with keep-in-cache policy anyway
+func WithoutKeepInCache() Option {
+	return func(c *Conn) {
+		c.noKeepInCache = true
+	}
+}
+
 func WithScanOpts(scanOpts ...options.ExecuteScanQueryOption) Option {
 	return func(c *Conn) {
 		c.scanOpts = scanOpts
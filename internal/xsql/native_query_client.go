@@ -0,0 +1,122 @@
+package xsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var _ query.Client = (*nativeQueryClient)(nil)
+
+// nativeQueryClient wraps native query client. Calls are retried with retry budget and retry trace
+// of connector, DoTx calls are traced with OnDoTx hook of connector trace
+type nativeQueryClient struct {
+	client      query.Client
+	trace       *trace.DatabaseSQL
+	traceRetry  *trace.Retry
+	retryBudget budget.Budget
+}
+
+// NativeQueryClient returns native query client which shares retry budget, retry trace and
+// database/sql trace with connector
+func (c *Connector) NativeQueryClient() query.Client {
+	return newNativeQueryClient(c, c.parent.Query())
+}
+
+func newNativeQueryClient(c *Connector, client query.Client) *nativeQueryClient {
+	return &nativeQueryClient{
+		client:      client,
+		trace:       c.trace,
+		traceRetry:  c.traceRetry,
+		retryBudget: c.retryBudget,
+	}
+}
+
+// retryOptions returns retry options of connector. Options are passed before options of call,
+// so call can override them
+func (c *nativeQueryClient) retryOptions() options.RetryOptionsOption {
+	opts := options.RetryOptionsOption{retry.WithTrace(c.traceRetry)}
+	if c.retryBudget != nil {
+		opts = append(opts, retry.WithBudget(c.retryBudget))
+	}
+
+	return opts
+}
+
+func (c *nativeQueryClient) Do(ctx context.Context, op query.Operation, opts ...query.DoOption) error {
+	err := c.client.Do(ctx, op, append([]query.DoOption{c.retryOptions()}, opts...)...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (c *nativeQueryClient) DoTx(ctx context.Context, op query.TxOperation, opts ...query.DoTxOption) (
+	finalErr error,
+) {
+	var (
+		attempts int
+		opErr    error
+		onDone   = trace.DatabaseSQLOnDoTx(c.trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql.(*nativeQueryClient).DoTx"),
+			"", false,
+		)
+	)
+	defer func() {
+		onDone(opErr)(attempts, finalErr)
+	}()
+
+	err := c.client.DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		attempts++
+		opErr = op(ctx, tx)
+
+		return opErr
+	}, append([]query.DoTxOption{c.retryOptions()}, opts...)...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (c *nativeQueryClient) Exec(ctx context.Context, sql string, opts ...query.ExecuteOption) error {
+	return c.client.Exec(ctx, sql, append([]query.ExecuteOption{c.retryOptions()}, opts...)...)
+}
+
+func (c *nativeQueryClient) Query(ctx context.Context, sql string, opts ...query.ExecuteOption) (
+	query.Result, error,
+) {
+	return c.client.Query(ctx, sql, append([]query.ExecuteOption{c.retryOptions()}, opts...)...)
+}
+
+func (c *nativeQueryClient) QueryResultSet(ctx context.Context, sql string, opts ...query.ExecuteOption) (
+	query.ClosableResultSet, error,
+) {
+	return c.client.QueryResultSet(ctx, sql, append([]query.ExecuteOption{c.retryOptions()}, opts...)...)
+}
+
+func (c *nativeQueryClient) QueryRow(ctx context.Context, sql string, opts ...query.ExecuteOption) (
+	query.Row, error,
+) {
+	return c.client.QueryRow(ctx, sql, append([]query.ExecuteOption{c.retryOptions()}, opts...)...)
+}
+
+func (c *nativeQueryClient) ExecuteScript(
+	ctx context.Context, sql string, ttl time.Duration, opts ...query.ExecuteOption,
+) (*options.ExecuteScriptOperation, error) {
+	return c.client.ExecuteScript(ctx, sql, ttl, append([]query.ExecuteOption{c.retryOptions()}, opts...)...)
+}
+
+func (c *nativeQueryClient) FetchScriptResults(
+	ctx context.Context, opID string, opts ...options.FetchScriptOption,
+) (*options.FetchScriptResult, error) {
+	return c.client.FetchScriptResults(ctx, opID, opts...)
+}
@@ -0,0 +1,134 @@
+package xsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// retryingQueryClient retries operations with retry options of call like native query client does
+type retryingQueryClient struct {
+	query.Client
+}
+
+func (retryingQueryClient) Do(ctx context.Context, op query.Operation, opts ...query.DoOption) error {
+	return retry.Retry(ctx, func(ctx context.Context) error {
+		return op(ctx, nil)
+	}, options.ParseDoOpts(nil, opts...).RetryOpts()...)
+}
+
+func (retryingQueryClient) DoTx(ctx context.Context, op query.TxOperation, opts ...query.DoTxOption) error {
+	return retry.Retry(ctx, func(ctx context.Context) error {
+		return op(ctx, nil)
+	}, options.ParseDoTxOpts(nil, opts...).RetryOpts()...)
+}
+
+// countingBudget grants quota limit times
+type countingBudget struct {
+	acquired int
+	limit    int
+}
+
+func (b *countingBudget) Acquire(context.Context) error {
+	if b.acquired >= b.limit {
+		return budget.ErrNoQuota
+	}
+	b.acquired++
+
+	return nil
+}
+
+func TestNativeQueryClient(t *testing.T) {
+	errRetryable := xerrors.Retryable(errors.New("retryable"), xerrors.WithBackoff(backoff.TypeNoBackoff))
+	connector := func(b budget.Budget, retries *int, doTxs *int) *Connector {
+		return &Connector{
+			retryBudget: b,
+			traceRetry: &trace.Retry{
+				OnRetry: func(trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+					*retries++
+
+					return nil
+				},
+			},
+			trace: &trace.DatabaseSQL{
+				OnDoTx: func(trace.DatabaseSQLDoTxStartInfo) func(
+					trace.DatabaseSQLDoTxIntermediateInfo,
+				) func(trace.DatabaseSQLDoTxDoneInfo) {
+					return func(trace.DatabaseSQLDoTxIntermediateInfo) func(trace.DatabaseSQLDoTxDoneInfo) {
+						return func(info trace.DatabaseSQLDoTxDoneInfo) {
+							*doTxs = info.Attempts
+						}
+					}
+				},
+			},
+		}
+	}
+	t.Run("Do", func(t *testing.T) {
+		var (
+			b       = &countingBudget{limit: 2}
+			retries int
+			doTxs   int
+			calls   int
+		)
+		client := newNativeQueryClient(connector(b, &retries, &doTxs), retryingQueryClient{})
+		err := client.Do(xtest.Context(t), func(ctx context.Context, s query.Session) error {
+			calls++
+
+			return errRetryable
+		})
+		require.ErrorIs(t, err, budget.ErrNoQuota)
+		require.ErrorIs(t, err, errRetryable)
+		require.Equal(t, 3, calls)
+		require.Equal(t, 2, b.acquired)
+		require.Equal(t, 1, retries)
+		require.Zero(t, doTxs)
+	})
+	t.Run("DoTx", func(t *testing.T) {
+		var (
+			b       = &countingBudget{limit: 2}
+			retries int
+			doTxs   int
+		)
+		client := newNativeQueryClient(connector(b, &retries, &doTxs), retryingQueryClient{})
+		err := client.DoTx(xtest.Context(t), func(ctx context.Context, tx query.TxActor) error {
+			if doTxs++; doTxs < 2 {
+				return errRetryable
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, b.acquired)
+		require.Equal(t, 1, retries)
+		// done hook of OnDoTx overrides counter with attempts of DoTx
+		require.Equal(t, 2, doTxs)
+	})
+	t.Run("OverrideBudget", func(t *testing.T) {
+		var (
+			b       = &countingBudget{limit: 2}
+			retries int
+			doTxs   int
+			calls   int
+		)
+		client := newNativeQueryClient(connector(b, &retries, &doTxs), retryingQueryClient{})
+		err := client.Do(xtest.Context(t), func(ctx context.Context, s query.Session) error {
+			calls++
+
+			return errRetryable
+		}, query.WithRetryBudget(noQuotaBudget{}))
+		require.ErrorIs(t, err, budget.ErrNoQuota)
+		require.Equal(t, 1, calls)
+		require.Zero(t, b.acquired)
+	})
+}
@@ -1,9 +1,15 @@
 package xsql
 
 import (
+	"context"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/propose"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
@@ -33,15 +39,29 @@ type (
 		t    *trace.Retry
 		opts []trace.RetryComposeOption
 	}
-	disableServerBalancerOption struct{}
-	onCloseOption               func(*Connector)
-	retryBudgetOption           struct {
+	serverBalancerOption bool
+	onCloseOption        func(*Connector)
+	onClosedOption       func(*Connector)
+	retryBudgetOption    struct {
 		budget budget.Budget
 	}
 	bindOption struct {
 		bind.Bind
 	}
-	queryProcessorOption Engine
+	queryProcessorOption   Engine
+	idleThresholdOption    time.Duration
+	keepAliveOption        int
+	minPoolSizeOption      int
+	stmtCacheSizeOption    int
+	outgoingMetadataOption func(ctx context.Context) metadata.MD
+	statementMiddleware    func(ctx context.Context, sql string) (string, error)
+	uuidCoercionOption     struct{}
+	sequentialConnIDs      struct{}
+	grpcDialOptionsOption  []grpc.DialOption
+	connectRetryOption     struct {
+		maxAttempts int
+		backoff     backoff.Backoff
+	}
 )
 
 func (t tablePathPrefixOption) Apply(c *Connector) error {
@@ -57,6 +77,64 @@ func (processor queryProcessorOption) Apply(c *Connector) error {
 	return nil
 }
 
+func (idleThreshold idleThresholdOption) Apply(c *Connector) error {
+	c.idleThreshold = time.Duration(idleThreshold)
+
+	return nil
+}
+
+func (keepAlive keepAliveOption) Apply(c *Connector) error {
+	c.keepAlive = int(keepAlive)
+
+	return nil
+}
+
+func (size minPoolSizeOption) Apply(c *Connector) error {
+	c.minPoolSize = int(size)
+
+	return nil
+}
+
+func (size stmtCacheSizeOption) Apply(c *Connector) error {
+	c.stmtCache = newStmtCache(int(size))
+
+	return nil
+}
+
+func (opt connectRetryOption) Apply(c *Connector) error {
+	if opt.maxAttempts > 1 && opt.backoff == nil {
+		return xerrors.WithStackTrace(errNilConnectBackoff)
+	}
+	c.connectRetry = opt
+
+	return nil
+}
+
+func (sequentialConnIDs) Apply(c *Connector) error {
+	c.sequentialConnIDs = true
+
+	return nil
+}
+
+func (uuidCoercionOption) Apply(c *Connector) error {
+	c.bindings = bind.Sort(append(c.bindings, bind.UUIDArgs{}))
+	c.Options = append(c.Options, propose.WithUUIDAsString())
+
+	return nil
+}
+
+func (fn outgoingMetadataOption) Apply(c *Connector) error {
+	c.outgoingMetadata = append(c.outgoingMetadata, fn)
+
+	return nil
+}
+
+func (fn statementMiddleware) Apply(c *Connector) error {
+	c.statementMiddlewares = append(c.statementMiddlewares, fn)
+
+	return nil
+}
+
 func (opt bindOption) Apply(c *Connector) error {
 	c.bindings = bind.Sort(append(c.bindings, opt.Bind))
 
@@ -81,8 +159,20 @@ func (onClose onCloseOption) Apply(c *Connector) error {
 	return nil
 }
 
-func (disableServerBalancerOption) Apply(c *Connector) error {
-	c.disableServerBalancer = true
+func (onClosed onClosedOption) Apply(c *Connector) error {
+	c.onClosed = append(c.onClosed, onClosed)
+
+	return nil
+}
+
+// Apply does nothing: grpc dial options are applied by ydb.Connector which dials dedicated
+// balancer of connector (see GrpcDialOptions)
+func (grpcDialOptionsOption) Apply(*Connector) error {
+	return nil
+}
+
+func (enabled serverBalancerOption) Apply(c *Connector) error {
+	c.disableServerBalancer = !bool(enabled)
 
 	return nil
 }
@@ -110,14 +200,59 @@ func WithTrace(
 	}
 }
 
+// WithOutgoingMetadata appends metadata returned by fn to each request to YDB
+func WithOutgoingMetadata(fn func(ctx context.Context) metadata.MD) Option {
+	return outgoingMetadataOption(fn)
+}
+
+// WithStatementMiddleware appends middleware which receives query text of each statement after binding
+// of args and returns query text for execution. Error of middleware aborts statement.
+// Middlewares are applied in order of options
+func WithStatementMiddleware(fn func(ctx context.Context, sql string) (string, error)) Option {
+	return statementMiddleware(fn)
+}
+
+// WithServerBalancer enables (by default) or disables server-side balancing of sessions of connector.
+// With server-side balancing server chooses node of new session, otherwise session is created on node
+// chosen by client-side balancer. Servers without support of server-side balancing ignore the option
+func WithServerBalancer(enabled bool) Option {
+	return serverBalancerOption(enabled)
+}
+
+// WithDisableServerBalancer is a shortcut of WithServerBalancer(false)
 func WithDisableServerBalancer() Option {
-	return disableServerBalancerOption{}
+	return serverBalancerOption(false)
+}
+
+// WithGrpcDialOptions appends grpc dial options of dedicated balancer of connector
+func WithGrpcDialOptions(opts ...grpc.DialOption) Option {
+	return grpcDialOptionsOption(opts)
+}
+
+// GrpcDialOptions returns grpc dial options from WithGrpcDialOptions options (including merged options)
+func GrpcDialOptions(opts ...Option) (dialOpts []grpc.DialOption) {
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case grpcDialOptionsOption:
+			dialOpts = append(dialOpts, opt...)
+		case mergedOptions:
+			dialOpts = append(dialOpts, GrpcDialOptions(opt...)...)
+		}
+	}
+
+	return dialOpts
 }
 
+// WithOnClose registers callback which calls on Connector.Close before closing of conns
 func WithOnClose(onClose func(*Connector)) Option {
 	return onCloseOption(onClose)
 }
 
+// WithOnClosed registers callback which calls on Connector.Close after all conns are closed
+func WithOnClosed(onClosed func(*Connector)) Option {
+	return onClosedOption(onClosed)
+}
+
 func WithTraceRetry(
 	t *trace.Retry,
 	opts ...trace.RetryComposeOption,
@@ -162,11 +297,49 @@ func WithFakeTx(modes ...legacy.QueryMode) Option {
 	}
 }
 
+// WithIdleThreshold sets maximum idle duration of connection. Connections which are idle longer
+// than idleThreshold will be closed.
+//
+// WithIdleThreshold takes precedence over YDB_DATABASE_SQL_IDLE_THRESHOLD environment variable.
+// Invalid value of environment variable fails Open.
+// Zero or negative idleThreshold disables closing of idle connections.
 func WithIdleThreshold(idleThreshold time.Duration) Option {
-	return legacyOptionsOption{
-		legacyOps: []legacy.Option{
-			legacy.WithIdleThreshold(idleThreshold),
+	return Merge(
+		idleThresholdOption(idleThreshold),
+		legacyOptionsOption{
+			legacyOps: []legacy.Option{
+				legacy.WithIdleThreshold(idleThreshold),
+			},
 		},
+	)
+}
+
+// WithKeepAlive defines minimum number of live connections which will not be closed by idle threshold
+func WithKeepAlive(minConns int) Option {
+	return keepAliveOption(minConns)
+}
+
+// WithMinPoolSize makes connector eagerly create n conns in background on open. Warm conns are
+// returned by Connect before creation of new conns and are not closed by idle threshold until taken.
+// Warm-up respects connect retry options and stops on close of connector
+func WithMinPoolSize(n int) Option {
+	return minPoolSizeOption(n)
+}
+
+// WithStatementCacheSize defines size of LRU cache of query texts. Repeated queries from cache are executed
+// with keep in cache flag. Zero size disables cache.
+// Cache is supported by legacy engine only, Open with query service engine and cache fails
+func WithStatementCacheSize(size int) Option {
+	return stmtCacheSizeOption(size)
+}
+
+// WithConnectRetry makes Connect retry retryable errors of session creation up to maxAttempts attempts
+// (including the first one) with delays from backoff. Retries are limited by context of Connect and
+// retry budget of connector. maxAttempts less than 2 disables retries
+func WithConnectRetry(maxAttempts int, backoff backoff.Backoff) Option {
+	return connectRetryOption{
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
 	}
 }
 
@@ -205,3 +378,15 @@ func WithQueryService(b bool) Option {
 
 	return queryProcessorOption(LEGACY)
 }
+
+// WithSequentialConnIDs makes connector identify conns with sequential numbers ("1", "2", ...)
+// instead of random UUIDs. Numbers are unique for lifetime of connector
+func WithSequentialConnIDs() Option {
+	return sequentialConnIDs{}
+}
+
+// WithUUIDCoercion enables binding of [16]byte args as UUID and scan of UUID columns into uuid.UUID
+// (query service engine only)
+func WithUUIDCoercion() Option {
+	return uuidCoercionOption{}
+}
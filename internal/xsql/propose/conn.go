@@ -12,6 +12,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
 )
 
@@ -33,22 +34,20 @@ type Conn struct {
 	onClose []func()
 	closed  atomic.Bool
 	fakeTx  bool
+
+	uuidAsString bool
 }
 
 func (c *Conn) Exec(ctx context.Context, sql string, params *params.Params) (
 	result driver.Result, finalErr error,
 ) {
-	if !c.IsValid() {
-		return nil, xerrors.WithStackTrace(errNotReadyConn)
-	}
-
 	if !c.isReady() {
-		return nil, xerrors.WithStackTrace(errNotReadyConn)
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
 
-	err := c.session.Exec(ctx, sql, options.WithParameters(params))
+	err := c.session.Exec(ctx, sql, executeOptions(ctx, options.WithParameters(params))...)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return resultNoRows{}, nil
@@ -58,18 +57,12 @@ func (c *Conn) Query(ctx context.Context, sql string, params *params.Params) (
 	result driver.RowsNextResultSet, finalErr error,
 ) {
 	if !c.isReady() {
-		return nil, xerrors.WithStackTrace(errNotReadyConn)
-	}
-
-	if !c.isReady() {
-		return nil, xerrors.WithStackTrace(errNotReadyConn)
+		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
 
-	res, err := c.session.Query(ctx, sql,
-		options.WithParameters(params),
-	)
+	res, err := c.session.Query(ctx, sql, executeOptions(ctx, options.WithParameters(params))...)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, badconn.MapSent(xerrors.WithStackTrace(err))
 	}
 
 	return &rows{
@@ -131,22 +124,29 @@ func (c *Conn) ID() string {
 	return c.session.ID()
 }
 
+func (c *Conn) NodeID() uint32 {
+	return c.session.NodeID()
+}
+
 func (c *Conn) IsValid() bool {
 	return c.isReady()
 }
 
 func (c *Conn) Ping(ctx context.Context) (finalErr error) {
 	if !c.isReady() {
-		return xerrors.WithStackTrace(errNotReadyConn)
+		return badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
 
 	if !c.session.Core.IsAlive() {
-		return xerrors.WithStackTrace(errNotReadyConn)
+		return badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
 
 	err := c.session.Exec(ctx, "select 1")
+	if err != nil {
+		return badconn.Map(xerrors.WithStackTrace(err))
+	}
 
-	return err
+	return nil
 }
 
 func (c *Conn) BeginTx(ctx context.Context, txOptions driver.TxOptions) (iface.Tx, error) {
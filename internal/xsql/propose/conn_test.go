@@ -0,0 +1,233 @@
+package propose
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// testServer is a fake query service which captures execute query requests
+type testServer struct {
+	mu       sync.Mutex
+	requests []*Ydb_Query.ExecuteQueryRequest
+	// calls are methods called by statements (calls of session lifecycle are skipped)
+	calls []string
+}
+
+func (s *testServer) call(method string) {
+	switch method {
+	case Ydb_Query_V1.QueryService_CreateSession_FullMethodName,
+		Ydb_Query_V1.QueryService_AttachSession_FullMethodName,
+		Ydb_Query_V1.QueryService_DeleteSession_FullMethodName:
+	default:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.calls = append(s.calls, method)
+	}
+}
+
+func (s *testServer) Invoke(_ context.Context, method string, _, reply any, _ ...grpc.CallOption) error {
+	s.call(method)
+
+	switch method {
+	case Ydb_Query_V1.QueryService_CreateSession_FullMethodName:
+		proto.Merge(reply.(proto.Message), &Ydb_Query.CreateSessionResponse{ //nolint:forcetypeassert
+			Status:    Ydb.StatusIds_SUCCESS,
+			SessionId: "test",
+		})
+	default:
+		proto.Merge(reply.(proto.Message), &Ydb_Query.DeleteSessionResponse{ //nolint:forcetypeassert
+			Status: Ydb.StatusIds_SUCCESS,
+		})
+	}
+
+	return nil
+}
+
+func (s *testServer) NewStream(ctx context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (
+	grpc.ClientStream, error,
+) {
+	s.call(method)
+
+	return &testStream{ctx: ctx, server: s, method: method}, nil
+}
+
+type testStream struct {
+	grpc.ClientStream
+
+	ctx    context.Context //nolint:containedctx
+	server *testServer
+	method string
+	recv   int
+}
+
+func (s *testStream) Header() (metadata.MD, error) { return nil, nil } //nolint:nilnil
+func (s *testStream) Trailer() metadata.MD         { return nil }
+func (s *testStream) CloseSend() error             { return nil }
+func (s *testStream) Context() context.Context     { return s.ctx }
+
+func (s *testStream) SendMsg(m any) error {
+	if request, ok := m.(*Ydb_Query.ExecuteQueryRequest); ok {
+		s.server.mu.Lock()
+		defer s.server.mu.Unlock()
+
+		s.server.requests = append(s.server.requests, proto.Clone(request).(*Ydb_Query.ExecuteQueryRequest)) //nolint:forcetypeassert,lll
+	}
+
+	return nil
+}
+
+func (s *testStream) RecvMsg(m any) error {
+	s.recv++
+	switch s.method {
+	case Ydb_Query_V1.QueryService_AttachSession_FullMethodName:
+		if s.recv > 1 {
+			<-s.ctx.Done()
+
+			return s.ctx.Err()
+		}
+		proto.Merge(m.(proto.Message), &Ydb_Query.SessionState{ //nolint:forcetypeassert
+			Status: Ydb.StatusIds_SUCCESS,
+		})
+
+		return nil
+	default:
+		if s.recv > 1 {
+			return io.EOF
+		}
+		proto.Merge(m.(proto.Message), &Ydb_Query.ExecuteQueryResponsePart{ //nolint:forcetypeassert
+			Status: Ydb.StatusIds_SUCCESS,
+		})
+
+		return nil
+	}
+}
+
+func TestConnTxControl(t *testing.T) {
+	toYDB := func(txc *tx.Control) *Ydb_Query.TransactionControl {
+		return txc.ToYDB(allocator.New())
+	}
+	for _, tt := range []struct {
+		name      string
+		txControl *tx.Control
+		expected  *Ydb_Query.TransactionControl
+	}{
+		{
+			name:     "Default",
+			expected: toYDB(tx.DefaultTxControl()),
+		},
+		{
+			name:      "SnapshotReadOnly",
+			txControl: tx.SnapshotReadOnlyTxControl(),
+			expected:  toYDB(tx.SnapshotReadOnlyTxControl()),
+		},
+		{
+			name:      "SerializableReadWrite",
+			txControl: tx.SerializableReadWriteTxControl(tx.CommitTx()),
+			expected:  toYDB(tx.SerializableReadWriteTxControl(tx.CommitTx())),
+		},
+		{
+			name:      "BeginWithoutCommit",
+			txControl: tx.SerializableReadWriteTxControl(),
+			expected:  toYDB(tx.SerializableReadWriteTxControl(tx.CommitTx())),
+		},
+		{
+			name:      "WithTxID",
+			txControl: tx.NewControl(tx.WithTxID("test")),
+			expected:  toYDB(tx.NewControl(tx.WithTxID("test"))),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			server := &testServer{}
+			client := query.New(ctx, server, config.New())
+			defer func() {
+				_ = client.Close(ctx)
+			}()
+			s, err := query.CreateSession(ctx, client)
+			require.NoError(t, err)
+
+			conn := New(ctx, nil, s)
+			defer func() {
+				_ = conn.Close()
+			}()
+
+			if tt.txControl != nil {
+				ctx = WithTxControl(ctx, tt.txControl)
+			}
+
+			_, err = conn.Exec(ctx, "UPSERT INTO t (a) VALUES (1)", nil)
+			require.NoError(t, err)
+			rows, err := conn.Query(ctx, "SELECT 1", nil)
+			require.NoError(t, err)
+			require.NoError(t, rows.Close())
+
+			require.Len(t, server.requests, 2)
+			for _, request := range server.requests {
+				require.True(t, proto.Equal(tt.expected, request.GetTxControl()),
+					"expected %v, got %v", tt.expected, request.GetTxControl(),
+				)
+			}
+		})
+	}
+}
+
+func TestConnAutoCommit(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		txControl *tx.Control
+	}{
+		{
+			name: "Default",
+		},
+		{
+			name:      "BeginWithoutCommit",
+			txControl: tx.SerializableReadWriteTxControl(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			server := &testServer{}
+			client := query.New(ctx, server, config.New())
+			defer func() {
+				_ = client.Close(ctx)
+			}()
+			s, err := query.CreateSession(ctx, client)
+			require.NoError(t, err)
+
+			conn := New(ctx, nil, s)
+			defer func() {
+				_ = conn.Close()
+			}()
+
+			if tt.txControl != nil {
+				ctx = WithTxControl(ctx, tt.txControl)
+			}
+
+			_, err = conn.Exec(ctx, "INSERT INTO t (a) VALUES (1)", nil)
+			require.NoError(t, err)
+
+			require.Equal(t, []string{Ydb_Query_V1.QueryService_ExecuteQuery_FullMethodName}, server.calls)
+			require.Len(t, server.requests, 1)
+			if txc := server.requests[0].GetTxControl(); txc != nil {
+				require.True(t, txc.GetCommitTx())
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package propose
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+)
+
+type ctxTxControlKey struct{}
+
+// WithTxControl defines transaction control for standalone (non-transactional) statements
+func WithTxControl(ctx context.Context, txc *tx.Control) context.Context {
+	return context.WithValue(ctx, ctxTxControlKey{}, txc)
+}
+
+func executeOptions(ctx context.Context, opts ...options.Execute) []options.Execute {
+	if txc, has := ctx.Value(ctxTxControlKey{}).(*tx.Control); has && txc != nil {
+		return append(opts, options.WithTxControl(autoCommit(txc)))
+	}
+
+	return opts
+}
+
+// autoCommit makes transaction control of statement outside of transaction (autocommit) commit
+// transaction in the same request, so begin of transaction doesn't leave transaction opened and
+// statement doesn't need begin → execute → commit round-trips
+func autoCommit(txc *tx.Control) *tx.Control {
+	if !txc.BeginTx() || txc.Commit {
+		return txc
+	}
+
+	commitTxc := *txc
+	commitTxc.Commit = true
+
+	return &commitTxc
+}
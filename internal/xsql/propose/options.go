@@ -13,3 +13,10 @@ func WithFakeTx() Option {
 		c.fakeTx = true
 	}
 }
+
+// WithUUIDAsString makes rows to return UUID values as canonical string for scan into uuid.UUID
+func WithUUIDAsString() Option {
+	return func(c *Conn) {
+		c.uuidAsString = true
+	}
+}
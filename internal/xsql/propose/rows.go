@@ -8,6 +8,8 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/google/uuid"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -148,6 +150,10 @@ func (r *rows) Next(dst []driver.Value) error {
 		return xerrors.WithStackTrace(err)
 	}
 
+	if r.conn.uuidAsString {
+		uuidToString(dstBuf)
+	}
+
 	dstI := 0
 	for i := range dstBuf {
 		if !r.discarded[i] {
@@ -159,6 +165,22 @@ func (r *rows) Next(dst []driver.Value) error {
 	return nil
 }
 
+// uuidToString replaces UUID values with canonical string which database/sql scans into uuid.UUID
+func uuidToString(values []driver.Value) {
+	for i := range values {
+		switch v := values[i].(type) {
+		case uuid.UUID:
+			values[i] = v.String()
+		case *uuid.UUID:
+			if v != nil {
+				values[i] = v.String()
+			} else {
+				values[i] = nil
+			}
+		}
+	}
+}
+
 func (r *rows) Close() error {
 	ctx := context.Background()
 
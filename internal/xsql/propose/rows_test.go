@@ -0,0 +1,29 @@
+package propose
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDToString(t *testing.T) {
+	id := uuid.MustParse("6E73B41C-4EDE-4D08-9CFB-B7462D9E498B")
+
+	values := []driver.Value{id, &id, (*uuid.UUID)(nil), nil, "text", int64(1)}
+	uuidToString(values)
+	require.Equal(t, []driver.Value{id.String(), id.String(), nil, nil, "text", int64(1)}, values)
+
+	var (
+		scanned    uuid.UUID
+		scannedPtr = &uuid.UUID{}
+		nullUUID   uuid.NullUUID
+	)
+	require.NoError(t, scanned.Scan(values[0]))
+	require.Equal(t, id, scanned)
+	require.NoError(t, nullUUID.Scan(values[2]))
+	require.False(t, nullUUID.Valid)
+	require.NoError(t, scannedPtr.Scan(values[1]))
+	require.Equal(t, id, *scannedPtr)
+}
@@ -0,0 +1,147 @@
+package xsql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	// slowQueryExplainTimeout limits background explain of slow query
+	slowQueryExplainTimeout = 10 * time.Second
+	// slowQueryQueueSize limits number of slow queries which wait for explain.
+	// Slow queries over the limit aren't explained
+	slowQueryQueueSize = 16
+)
+
+type (
+	slowQueryThresholdOption time.Duration
+	slowQuery                struct {
+		sql     string
+		params  *params.Params
+		elapsed time.Duration
+	}
+	// slowQueryExplainer explains slow queries one by one in background over single session,
+	// which is created on first explain and reused by next explains. Slow queries with the same
+	// text as query which waits for explain (or is explaining now) are skipped
+	slowQueryExplainer struct {
+		c     *Connector
+		queue chan slowQuery
+
+		mu      sync.Mutex
+		pending map[string]struct{}
+
+		cc iface.Conn // used by goroutine of run only
+	}
+)
+
+func (threshold slowQueryThresholdOption) Apply(c *Connector) error {
+	c.slowQueryThreshold = time.Duration(threshold)
+
+	return nil
+}
+
+// WithSlowQueryThreshold makes connector explain statements which took longer than threshold
+// and report plans to OnSlowQuery trace hook. Zero threshold (default) disables explain of slow queries
+func WithSlowQueryThreshold(threshold time.Duration) Option {
+	return slowQueryThresholdOption(threshold)
+}
+
+// onSlowQuery schedules explain of statement if statement took longer than slow query threshold.
+// Explain runs in background on separated session because session of conn is used by next statements,
+// so explain doesn't affect result of statement
+func (c *Conn) onSlowQuery(_ context.Context, sql string, params *params.Params, elapsed time.Duration) {
+	connector := c.connector
+	if connector.slowQueries == nil || elapsed < connector.slowQueryThreshold {
+		return
+	}
+
+	connector.slowQueries.add(slowQuery{
+		sql:     sql,
+		params:  params,
+		elapsed: elapsed,
+	})
+}
+
+func newSlowQueryExplainer(c *Connector) *slowQueryExplainer {
+	return &slowQueryExplainer{
+		c:       c,
+		queue:   make(chan slowQuery, slowQueryQueueSize),
+		pending: make(map[string]struct{}, slowQueryQueueSize),
+	}
+}
+
+// add schedules explain of query. Query is skipped if query with the same text is pending or queue is full
+func (e *slowQueryExplainer) add(q slowQuery) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, has := e.pending[q.sql]; has {
+		return
+	}
+
+	select {
+	case e.queue <- q:
+		e.pending[q.sql] = struct{}{}
+	default:
+	}
+}
+
+// run explains scheduled queries until done of ctx and closes session of explainer on exit
+func (e *slowQueryExplainer) run(ctx context.Context) {
+	defer func() {
+		if e.cc != nil {
+			_ = e.cc.Close()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case q := <-e.queue:
+			ast, plan, err := e.explain(ctx, q.sql, q.params)
+			trace.DatabaseSQLOnSlowQuery(e.c.trace, q.sql, q.elapsed, ast, plan, err)
+
+			e.mu.Lock()
+			delete(e.pending, q.sql)
+			e.mu.Unlock()
+		}
+	}
+}
+
+// explain explains query over session of explainer. Invalid session is replaced with new one
+func (e *slowQueryExplainer) explain(ctx context.Context, sql string, params *params.Params) (
+	ast, plan string, _ error,
+) {
+	ctx, cancel := xcontext.WithTimeout(e.c.outgoingContext(ctx), slowQueryExplainTimeout)
+	defer cancel()
+
+	if e.cc != nil && !e.cc.IsValid() {
+		_ = e.cc.Close()
+		e.cc = nil
+	}
+
+	if e.cc == nil {
+		cc, err := e.c.open(ctx, xcontext.ValueOnly(ctx), func(ctx context.Context) context.Context {
+			return ctx
+		}, nil)
+		if err != nil {
+			return "", "", xerrors.WithStackTrace(err)
+		}
+		e.cc = cc
+	}
+
+	ast, plan, err := e.cc.Explain(ctx, sql, params)
+	if err != nil {
+		return "", "", xerrors.WithStackTrace(err)
+	}
+
+	return ast, plan, nil
+}
@@ -0,0 +1,170 @@
+package xsql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type (
+	// slowQueryDriver executes statements with delay on fake clock
+	slowQueryDriver struct {
+		affinityDriver
+
+		clock      clockwork.FakeClock
+		delay      time.Duration
+		explainErr error
+		// explain blocks until release if release isn't nil
+		started chan struct{}
+		release chan struct{}
+	}
+	slowQuerySession struct {
+		table.ClosableSession
+
+		d *slowQueryDriver
+	}
+)
+
+func (d *slowQueryDriver) Table() table.Client {
+	return d
+}
+
+func (d *slowQueryDriver) CreateSession(ctx context.Context, opts ...table.Option) (table.ClosableSession, error) {
+	s, err := d.affinityDriver.CreateSession(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &slowQuerySession{ClosableSession: s, d: d}, nil
+}
+
+func (s *slowQuerySession) ExecuteSchemeQuery(
+	ctx context.Context, sql string, opts ...options.ExecuteSchemeQueryOption,
+) error {
+	s.d.clock.Advance(s.d.delay)
+
+	return s.ClosableSession.ExecuteSchemeQuery(ctx, sql, opts...)
+}
+
+func (s *slowQuerySession) Explain(_ context.Context, sql string) (table.DataQueryExplanation, error) {
+	if s.d.release != nil {
+		s.d.started <- struct{}{}
+		<-s.d.release
+	}
+	if s.d.explainErr != nil {
+		return table.DataQueryExplanation{}, s.d.explainErr
+	}
+
+	return table.DataQueryExplanation{
+		Explanation: table.Explanation{Plan: "plan of " + sql},
+		AST:         "ast of " + sql,
+	}, nil
+}
+
+func TestSlowQuery(t *testing.T) {
+	ctx := legacy.WithQueryMode(xtest.Context(t), legacy.SchemeQueryMode)
+	open := func(t *testing.T, d *slowQueryDriver) (*Conn, chan trace.DatabaseSQLSlowQueryInfo) {
+		slowQueries := make(chan trace.DatabaseSQLSlowQueryInfo, 1)
+		c, err := Open(d, affinityBalancer{nodeIDs: []uint32{1}},
+			WithQueryService(false),
+			WithSlowQueryThreshold(100*time.Millisecond),
+			WithTrace(&trace.DatabaseSQL{
+				OnSlowQuery: func(info trace.DatabaseSQLSlowQueryInfo) {
+					slowQueries <- info
+				},
+			}),
+			clockOption{d.clock},
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+		cc, err := c.Connect(ctx)
+		require.NoError(t, err)
+
+		return cc.(*Conn), slowQueries
+	}
+	newDriver := func(delay time.Duration) *slowQueryDriver {
+		return &slowQueryDriver{
+			affinityDriver: affinityDriver{
+				available: []uint32{1},
+				executed:  make(map[string][]uint32),
+			},
+			clock: clockwork.NewFakeClock(),
+			delay: delay,
+		}
+	}
+	t.Run("Slow", func(t *testing.T) {
+		d := newDriver(time.Second)
+		cc, slowQueries := open(t, d)
+		_, err := cc.ExecContext(ctx, "DROP TABLE t", nil)
+		require.NoError(t, err)
+		info := <-slowQueries
+		require.Equal(t, "DROP TABLE t", info.Query)
+		require.Equal(t, time.Second, info.Elapsed)
+		require.Equal(t, "plan of DROP TABLE t", info.Plan)
+		require.Equal(t, "ast of DROP TABLE t", info.Ast)
+		require.NoError(t, info.Error)
+	})
+	t.Run("Fast", func(t *testing.T) {
+		d := newDriver(10 * time.Millisecond)
+		cc, slowQueries := open(t, d)
+		_, err := cc.ExecContext(ctx, "DROP TABLE t", nil)
+		require.NoError(t, err)
+		select {
+		case info := <-slowQueries:
+			t.Fatalf("unexpected slow query: %+v", info)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+	t.Run("ExplainError", func(t *testing.T) {
+		errExplain := errors.New("explain failed")
+		d := newDriver(time.Second)
+		d.explainErr = errExplain
+		cc, slowQueries := open(t, d)
+		_, err := cc.ExecContext(ctx, "DROP TABLE t", nil)
+		require.NoError(t, err)
+		info := <-slowQueries
+		require.ErrorIs(t, info.Error, errExplain)
+		require.Empty(t, info.Plan)
+		require.Equal(t, []uint32{1}, d.executed["DROP TABLE t"])
+	})
+	t.Run("DeduplicatedOverSingleSession", func(t *testing.T) {
+		d := newDriver(time.Second)
+		d.started = make(chan struct{}, 10)
+		d.release = make(chan struct{})
+		cc, slowQueries := open(t, d)
+		_, err := cc.ExecContext(ctx, "DROP TABLE t1", nil)
+		require.NoError(t, err)
+		<-d.started
+		// the same query waits for explain already
+		for i := 0; i < 3; i++ {
+			_, err = cc.ExecContext(ctx, "DROP TABLE t1", nil)
+			require.NoError(t, err)
+		}
+		_, err = cc.ExecContext(ctx, "DROP TABLE t2", nil)
+		require.NoError(t, err)
+		close(d.release)
+		require.Equal(t, "DROP TABLE t1", (<-slowQueries).Query)
+		require.Equal(t, "DROP TABLE t2", (<-slowQueries).Query)
+		select {
+		case info := <-slowQueries:
+			t.Fatalf("unexpected slow query: %+v", info)
+		case <-time.After(50 * time.Millisecond):
+		}
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		// session of conn and session of explainer
+		require.Equal(t, 2, d.sessions)
+	})
+}
@@ -0,0 +1,100 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+)
+
+type ctxStatementTimeoutKey struct{}
+
+// WithStatementTimeout limits time of statements executed with ctx by timeout.
+// Effective timeout of statement is the minimum of this timeout, connector default
+// (see WithDefaultStatementTimeout) and ctx deadline
+func WithStatementTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, ctxStatementTimeoutKey{}, timeout)
+}
+
+type defaultStatementTimeoutOption time.Duration
+
+func (timeout defaultStatementTimeoutOption) Apply(c *Connector) error {
+	c.stmtTimeout = time.Duration(timeout)
+
+	return nil
+}
+
+// WithDefaultStatementTimeout limits time of each statement executed over connector.
+// Zero timeout (default) means statements are limited with ctx deadline only
+func WithDefaultStatementTimeout(timeout time.Duration) Option {
+	return defaultStatementTimeoutOption(timeout)
+}
+
+// statementTimeout returns the minimum of positive statement timeouts from ctx and connector
+func (c *Connector) statementTimeout(ctx context.Context) (timeout time.Duration, has bool) {
+	timeout, has = c.stmtTimeout, c.stmtTimeout > 0
+	if d, ok := ctx.Value(ctxStatementTimeoutKey{}).(time.Duration); ok && d > 0 && (!has || d < timeout) {
+		timeout, has = d, true
+	}
+
+	return timeout, has
+}
+
+// withStatementTimeout limits ctx of statement with statement timeout.
+// Deadline of ctx is kept if it is earlier than statement timeout.
+// Returned cancel is nil if statement timeout isn't defined
+func (c *Connector) withStatementTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, has := c.statementTimeout(ctx)
+	if !has {
+		return ctx, nil
+	}
+
+	return xcontext.WithTimeout(ctx, timeout)
+}
+
+// cancelRows releases ctx of statement with statement timeout on close of rows
+type cancelRows struct {
+	driver.RowsNextResultSet
+
+	cancel context.CancelFunc
+}
+
+// rowsWithCancel makes rows which calls cancel on close. Rows returns as is if cancel is nil
+func rowsWithCancel(rows driver.RowsNextResultSet, cancel context.CancelFunc) driver.Rows {
+	switch {
+	case cancel == nil:
+		return rows
+	case rows == nil:
+		cancel()
+
+		return nil
+	default:
+		return &cancelRows{
+			RowsNextResultSet: rows,
+			cancel:            cancel,
+		}
+	}
+}
+
+func (r *cancelRows) ColumnTypeDatabaseTypeName(index int) string {
+	if rows, has := r.RowsNextResultSet.(driver.RowsColumnTypeDatabaseTypeName); has {
+		return rows.ColumnTypeDatabaseTypeName(index)
+	}
+
+	return ""
+}
+
+func (r *cancelRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	if rows, has := r.RowsNextResultSet.(driver.RowsColumnTypeNullable); has {
+		return rows.ColumnTypeNullable(index)
+	}
+
+	return false, false
+}
+
+func (r *cancelRows) Close() error {
+	defer r.cancel()
+
+	return r.RowsNextResultSet.Close()
+}
@@ -0,0 +1,161 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// ctxConn keeps ctx of the last statement
+type ctxConn struct {
+	iface.Conn
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (c *ctxConn) IsValid() bool {
+	return true
+}
+
+func (c *ctxConn) ID() string {
+	return "test-session-id"
+}
+
+func (c *ctxConn) Close() error {
+	return nil
+}
+
+func (c *ctxConn) Exec(ctx context.Context, _ string, _ *params.Params) (driver.Result, error) {
+	c.ctx = ctx
+
+	return driver.RowsAffected(0), nil
+}
+
+func (c *ctxConn) Query(ctx context.Context, _ string, _ *params.Params) (driver.RowsNextResultSet, error) {
+	c.ctx = ctx
+
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+func (emptyRows) HasNextResultSet() bool    { return false }
+func (emptyRows) NextResultSet() error      { return io.EOF }
+
+func TestStatementTimeout(t *testing.T) {
+	newDB := func(t *testing.T, opts ...Option) (*sql.DB, *ctxConn) {
+		ctx := xtest.Context(t)
+		c := &Connector{
+			clock:      clockwork.NewFakeClock(),
+			trace:      &trace.DatabaseSQL{},
+			traceRetry: &trace.Retry{},
+		}
+		for _, opt := range opts {
+			require.NoError(t, opt.Apply(c))
+		}
+		cc := &ctxConn{}
+		db := sql.OpenDB(connConnector{conn: &Conn{
+			cc:        cc,
+			ctx:       ctx,
+			connector: c,
+			lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+		}})
+		t.Cleanup(func() {
+			_ = db.Close()
+		})
+
+		return db, cc
+	}
+	// timeout returns duration until deadline of statement ctx
+	timeout := func(t *testing.T, ctx context.Context) time.Duration {
+		deadline, has := ctx.Deadline()
+		require.True(t, has)
+
+		return time.Until(deadline)
+	}
+	t.Run("OverrideWins", func(t *testing.T) {
+		db, cc := newDB(t, WithDefaultStatementTimeout(time.Hour))
+		ctx, cancel := context.WithTimeout(xtest.Context(t), time.Hour)
+		defer cancel()
+		_, err := db.ExecContext(WithStatementTimeout(ctx, time.Minute), "UPSERT INTO t (id) VALUES (1)")
+		require.NoError(t, err)
+		require.LessOrEqual(t, timeout(t, cc.ctx), time.Minute)
+		require.Greater(t, timeout(t, cc.ctx), time.Minute-10*time.Second)
+		require.Error(t, cc.ctx.Err(), "ctx of statement must be released after exec")
+	})
+	t.Run("DefaultWins", func(t *testing.T) {
+		db, cc := newDB(t, WithDefaultStatementTimeout(time.Minute))
+		_, err := db.ExecContext(WithStatementTimeout(xtest.Context(t), time.Hour), "UPSERT INTO t (id) VALUES (1)")
+		require.NoError(t, err)
+		require.LessOrEqual(t, timeout(t, cc.ctx), time.Minute)
+		require.Greater(t, timeout(t, cc.ctx), time.Minute-10*time.Second)
+	})
+	t.Run("DeadlineWins", func(t *testing.T) {
+		db, cc := newDB(t, WithDefaultStatementTimeout(time.Hour))
+		ctx, cancel := context.WithTimeout(xtest.Context(t), time.Minute)
+		defer cancel()
+		deadline, _ := ctx.Deadline()
+		_, err := db.ExecContext(WithStatementTimeout(ctx, time.Hour), "UPSERT INTO t (id) VALUES (1)")
+		require.NoError(t, err)
+		statementDeadline, has := cc.ctx.Deadline()
+		require.True(t, has)
+		require.Equal(t, deadline, statementDeadline)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		db, cc := newDB(t)
+		_, err := db.ExecContext(xtest.Context(t), "UPSERT INTO t (id) VALUES (1)")
+		require.NoError(t, err)
+		_, has := cc.ctx.Deadline()
+		require.False(t, has)
+	})
+	t.Run("Query", func(t *testing.T) {
+		db, cc := newDB(t)
+		rows, err := db.QueryContext(WithStatementTimeout(xtest.Context(t), time.Minute), "SELECT 1")
+		require.NoError(t, err)
+		require.LessOrEqual(t, timeout(t, cc.ctx), time.Minute)
+		require.NoError(t, cc.ctx.Err(), "ctx of statement must be alive until rows are closed")
+		require.NoError(t, rows.Close())
+		require.Error(t, cc.ctx.Err())
+	})
+	t.Run("Stmt", func(t *testing.T) {
+		t.Run("Exec", func(t *testing.T) {
+			db, cc := newDB(t, WithDefaultStatementTimeout(time.Hour))
+			ctx := WithStatementTimeout(xtest.Context(t), time.Minute)
+			stmt, err := db.PrepareContext(ctx, "UPSERT INTO t (id) VALUES (1)")
+			require.NoError(t, err)
+			defer stmt.Close()
+			_, err = stmt.ExecContext(ctx)
+			require.NoError(t, err)
+			require.LessOrEqual(t, timeout(t, cc.ctx), time.Minute)
+			require.Greater(t, timeout(t, cc.ctx), time.Minute-10*time.Second)
+			require.Error(t, cc.ctx.Err(), "ctx of statement must be released after exec")
+		})
+		t.Run("Query", func(t *testing.T) {
+			db, cc := newDB(t, WithDefaultStatementTimeout(time.Minute))
+			stmt, err := db.PrepareContext(xtest.Context(t), "SELECT 1")
+			require.NoError(t, err)
+			defer stmt.Close()
+			rows, err := stmt.QueryContext(xtest.Context(t))
+			require.NoError(t, err)
+			require.LessOrEqual(t, timeout(t, cc.ctx), time.Minute)
+			require.NoError(t, cc.ctx.Err(), "ctx of statement must be alive until rows are closed")
+			require.NoError(t, rows.Close())
+			require.Error(t, cc.ctx.Err())
+		})
+	})
+}
@@ -35,16 +35,35 @@ func (stmt *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_
 		onDone(finalErr)
 	}()
 
-	if !stmt.conn.cc.IsValid() {
+	cc, processor := stmt.conn.cc, stmt.processor
+	if _, ok := processor.(currentConn); ok {
+		cc = stmt.conn.affinityConn(stmt.conn.connector.outgoingContext(ctx), stmt.sql)
+		processor = cc
+	}
+
+	ctx = withSessionID(stmt.conn.connector.outgoingContext(ctx), cc.ID())
+
+	if !cc.IsValid() {
 		return nil, xerrors.WithStackTrace(errNotReadyConn)
 	}
 
-	sql, params, err := stmt.conn.toYdb(stmt.sql, args...)
+	sql, params, err := stmt.conn.toYdb(ctx, stmt.sql, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	return stmt.processor.Query(ctx, sql, params)
+	ctx = stmt.conn.connector.withStmtCache(ctx, sql)
+
+	ctx, cancel := stmt.conn.connector.withStatementTimeout(ctx)
+
+	start := stmt.conn.connector.clock.Now()
+	defer func() {
+		stmt.conn.onSlowQuery(ctx, sql, params, stmt.conn.connector.clock.Since(start))
+	}()
+
+	rows, err := processor.Query(ctx, sql, params)
+
+	return rowsWithCancel(rows, cancel), statementError(err)
 }
 
 func (stmt *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_ driver.Result, finalErr error) {
@@ -56,16 +75,38 @@ func (stmt *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_
 		onDone(finalErr)
 	}()
 
-	if !stmt.conn.cc.IsValid() {
+	cc, processor := stmt.conn.cc, stmt.processor
+	if _, ok := processor.(currentConn); ok {
+		cc = stmt.conn.affinityConn(stmt.conn.connector.outgoingContext(ctx), stmt.sql)
+		processor = cc
+	}
+
+	ctx = withSessionID(stmt.conn.connector.outgoingContext(ctx), cc.ID())
+
+	if !cc.IsValid() {
 		return nil, xerrors.WithStackTrace(errNotReadyConn)
 	}
 
-	sql, params, err := stmt.conn.toYdb(stmt.sql, args...)
+	sql, params, err := stmt.conn.toYdb(ctx, stmt.sql, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	return stmt.processor.Exec(ctx, sql, params)
+	ctx = stmt.conn.connector.withStmtCache(ctx, sql)
+
+	ctx, cancel := stmt.conn.connector.withStatementTimeout(ctx)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	start := stmt.conn.connector.clock.Now()
+	defer func() {
+		stmt.conn.onSlowQuery(ctx, sql, params, stmt.conn.connector.clock.Since(start))
+	}()
+
+	result, err := processor.Exec(ctx, sql, params)
+
+	return result, statementError(err)
 }
 
 func (stmt *Stmt) NumInput() int {
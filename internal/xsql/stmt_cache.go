@@ -0,0 +1,71 @@
+package xsql
+
+import (
+	"container/list"
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// stmtCache is a LRU cache of query texts executed through connector.
+// Repeated queries are marked for keep compiled query in server-side cache.
+// Cache holds only query texts, so eviction never affects statements in flight.
+type stmtCache struct {
+	mu    xsync.Mutex
+	size  int
+	lru   *list.List // front is the most recently used query
+	index map[string]*list.Element
+}
+
+func newStmtCache(size int) *stmtCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &stmtCache{
+		size:  size,
+		lru:   list.New(),
+		index: make(map[string]*list.Element, size),
+	}
+}
+
+// touch registers execution of query and reports whether the query is
+// already in the cache. Nil cache never hits.
+func (c *stmtCache) touch(sql string) (hit bool) {
+	if c == nil {
+		return false
+	}
+
+	key := bind.Normalize(sql)
+
+	c.mu.WithLock(func() {
+		if el, has := c.index[key]; has {
+			c.lru.MoveToFront(el)
+			hit = true
+
+			return
+		}
+
+		c.index[key] = c.lru.PushFront(key)
+
+		if c.lru.Len() > c.size {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+			delete(c.index, oldest.Value.(string)) //nolint:forcetypeassert
+		}
+	})
+
+	return hit
+}
+
+// withStmtCache marks ctx as context of prepared statement if sql was executed
+// through connector before. Legacy engine executes such queries with keep in cache flag.
+func (c *Connector) withStmtCache(ctx context.Context, sql string) context.Context {
+	if c.stmtCache.touch(sql) {
+		return iface.WithPreparedStatement(ctx)
+	}
+
+	return ctx
+}
@@ -0,0 +1,167 @@
+package xsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// preparedInterceptor captures prepared statement flag of each call to the underlying conn
+type preparedInterceptor struct {
+	iface.Conn
+
+	prepared []bool
+}
+
+func (i *preparedInterceptor) IsValid() bool {
+	return true
+}
+
+func (i *preparedInterceptor) ID() string {
+	return "test-session-id"
+}
+
+func (i *preparedInterceptor) Exec(ctx context.Context, _ string, _ *params.Params) (driver.Result, error) {
+	i.prepared = append(i.prepared, iface.IsPreparedStatement(ctx))
+
+	return driver.ResultNoRows, nil
+}
+
+func (i *preparedInterceptor) Query(ctx context.Context, _ string, _ *params.Params) (
+	driver.RowsNextResultSet, error,
+) {
+	i.prepared = append(i.prepared, iface.IsPreparedStatement(ctx))
+
+	return nil, nil //nolint:nilnil
+}
+
+func TestStmtCache(t *testing.T) {
+	t.Run("LRU", func(t *testing.T) {
+		c := newStmtCache(2)
+		require.False(t, c.touch("SELECT 1"))
+		require.False(t, c.touch("SELECT 2"))
+		require.True(t, c.touch("SELECT 1"))
+		// SELECT 2 is the least recently used query
+		require.False(t, c.touch("SELECT 3"))
+		require.True(t, c.touch("SELECT 1"))
+		require.True(t, c.touch("SELECT 3"))
+		require.False(t, c.touch("SELECT 2"))
+		// SELECT 1 is evicted by SELECT 2
+		require.False(t, c.touch("SELECT 1"))
+	})
+	t.Run("Normalize", func(t *testing.T) {
+		c := newStmtCache(1)
+		require.False(t, c.touch("SELECT 1"))
+		require.True(t, c.touch("\n\tSELECT   1\n"))
+		require.True(t, c.touch("-- comment\nSELECT /* comment */ 1"))
+		require.False(t, c.touch("SELECT 'a  b'"))
+		require.False(t, c.touch("SELECT 'a b'"))
+		require.True(t, c.touch("SELECT  'a b' -- comment"))
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		c := newStmtCache(0)
+		require.Nil(t, c)
+		require.False(t, c.touch("SELECT 1"))
+		require.False(t, c.touch("SELECT 1"))
+	})
+}
+
+func TestStmtCacheKeepInCache(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		size     int
+		prepared []bool
+	}{
+		{
+			name:     "Enabled",
+			size:     1,
+			prepared: []bool{false, true, true, false, false},
+		},
+		{
+			name:     "Disabled",
+			size:     0,
+			prepared: []bool{false, false, false, false, false},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			c := &Connector{
+				clock:      clockwork.NewFakeClock(),
+				trace:      &trace.DatabaseSQL{},
+				traceRetry: &trace.Retry{},
+			}
+			require.NoError(t, WithStatementCacheSize(tt.size).Apply(c))
+
+			interceptor := &preparedInterceptor{}
+			conn := &Conn{
+				cc:        interceptor,
+				ctx:       ctx,
+				connector: c,
+				lastUsage: xsync.NewLastUsage(xsync.WithClock(c.Clock())),
+			}
+
+			_, err := conn.ExecContext(ctx, "SELECT 1", nil)
+			require.NoError(t, err)
+			_, err = conn.QueryContext(ctx, "SELECT  1", nil)
+			require.NoError(t, err)
+			stmt, err := conn.PrepareContext(ctx, "SELECT 1")
+			require.NoError(t, err)
+			_, err = stmt.(*Stmt).ExecContext(ctx, nil)
+			require.NoError(t, err)
+			// SELECT 2 evicts SELECT 1, but prepared statement is still usable
+			_, err = conn.ExecContext(ctx, "SELECT 2", nil)
+			require.NoError(t, err)
+			_, err = stmt.(*Stmt).QueryContext(ctx, nil)
+			require.NoError(t, err)
+
+			require.Equal(t, tt.prepared, interceptor.prepared)
+		})
+	}
+}
+
+func TestStmtCacheEngine(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		queryService bool
+		size         int
+		err          error
+	}{
+		{
+			name: "Legacy",
+			size: 10,
+		},
+		{
+			name:         "QueryService",
+			queryService: true,
+			size:         10,
+			err:          errStmtCacheOverQuery,
+		},
+		{
+			name:         "QueryServiceWithoutCache",
+			queryService: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Open(testDriver{}, nil, WithQueryService(tt.queryService), WithStatementCacheSize(tt.size))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			defer func() {
+				_ = c.Close()
+			}()
+			require.Equal(t, tt.size > 0, c.stmtCache != nil)
+		})
+	}
+}
@@ -6,8 +6,8 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/iface"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy/badconn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -44,7 +44,7 @@ func (tx *Tx) Commit() (finalErr error) {
 	}()
 
 	if err := tx.tx.Commit(tx.ctx); err != nil {
-		return xerrors.WithStackTrace(err)
+		return statementError(xerrors.WithStackTrace(err))
 	}
 
 	return nil
@@ -85,11 +85,15 @@ func (tx *Tx) QueryContext(ctx context.Context, sql string, args []driver.NamedV
 		onDone(finalErr)
 	}()
 
-	sql, params, err := tx.conn.toYdb(sql, args...)
+	ctx = withSessionID(tx.conn.connector.outgoingContext(ctx), tx.conn.cc.ID())
+
+	sql, params, err := tx.conn.toYdb(ctx, sql, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	ctx = tx.conn.connector.withStmtCache(ctx, sql)
+
 	if isExplain(ctx) {
 		ast, plan, err := tx.conn.cc.Explain(ctx, sql, params)
 		if err != nil {
@@ -99,12 +103,23 @@ func (tx *Tx) QueryContext(ctx context.Context, sql string, args []driver.NamedV
 		return rowByAstPlan(ast, plan), nil
 	}
 
+	ctx, cancel := tx.conn.connector.withStatementTimeout(ctx)
+
+	start := tx.conn.connector.clock.Now()
+	defer func() {
+		tx.conn.onSlowQuery(ctx, sql, params, tx.conn.connector.clock.Since(start))
+	}()
+
 	rows, err := tx.tx.Query(ctx, sql, params)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		if cancel != nil {
+			cancel()
+		}
+
+		return nil, statementError(xerrors.WithStackTrace(err))
 	}
 
-	return rows, nil
+	return rowsWithCancel(rows, cancel), nil
 }
 
 func (tx *Tx) ExecContext(ctx context.Context, sql string, args []driver.NamedValue) (
@@ -118,14 +133,28 @@ func (tx *Tx) ExecContext(ctx context.Context, sql string, args []driver.NamedVa
 		onDone(finalErr)
 	}()
 
-	sql, params, err := tx.conn.toYdb(sql, args...)
+	ctx = withSessionID(tx.conn.connector.outgoingContext(ctx), tx.conn.cc.ID())
+
+	sql, params, err := tx.conn.toYdb(ctx, sql, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	ctx = tx.conn.connector.withStmtCache(ctx, sql)
+
+	ctx, cancel := tx.conn.connector.withStatementTimeout(ctx)
+	if cancel != nil {
+		defer cancel()
+	}
+
+	start := tx.conn.connector.clock.Now()
+	defer func() {
+		tx.conn.onSlowQuery(ctx, sql, params, tx.conn.connector.clock.Since(start))
+	}()
+
 	result, err := tx.tx.Exec(ctx, sql, params)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, statementError(xerrors.WithStackTrace(err))
 	}
 
 	return result, nil
@@ -0,0 +1,23 @@
+package log
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/kv"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// PanicLogger makes trace.PanicLogger which logs panics recovered in hooks of traces
+// with trace.PanicPolicyLogAndContinue policy
+func PanicLogger(l Logger, opts ...Option) trace.PanicLogger {
+	l = wrapLogger(l, opts...)
+
+	return func(hook string, e interface{}, stack []byte) {
+		ctx := with(context.Background(), ERROR, "ydb", "trace", "panic")
+		l.Log(ctx, "panic recovered in hook",
+			kv.String("hook", hook),
+			kv.Any("panic", e),
+			kv.String("stack", string(stack)),
+		)
+	}
+}
@@ -0,0 +1,28 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestPanicLogger(t *testing.T) {
+	var buf bytes.Buffer
+	composed := (&trace.Query{}).Compose(
+		&trace.Query{
+			OnNew: func(trace.QueryNewStartInfo) func(trace.QueryNewDoneInfo) {
+				panic("OnNew")
+			},
+		},
+		trace.WithQueryPanicPolicy(trace.PanicPolicyLogAndContinue),
+		trace.WithQueryPanicLogger(PanicLogger(Default(&buf))),
+	)
+	require.NotPanics(t, func() {
+		composed.OnNew(trace.QueryNewStartInfo{})
+	})
+	require.Contains(t, buf.String(), "ERROR 'ydb.trace.panic' => panic recovered in hook")
+	require.Contains(t, buf.String(), `"hook":"Query.OnNew"`)
+}
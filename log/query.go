@@ -572,6 +572,96 @@ func internalQuery(
 				}
 			}
 		},
+		OnTxBegin: func(info trace.QueryTxBeginStartInfo) func(info trace.QueryTxBeginDoneInfo) {
+			if d.Details()&trace.QueryTransactionEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "query", "transaction", "begin")
+			l.Log(ctx, "start",
+				kv.String("SessionID", info.Session.ID()),
+				kv.String("TxMode", info.TxMode),
+			)
+			start := time.Now()
+
+			return func(info trace.QueryTxBeginDoneInfo) {
+				if info.Error == nil {
+					l.Log(WithLevel(ctx, DEBUG), "done",
+						kv.Latency(start),
+						kv.String("TransactionID", info.TxID),
+					)
+				} else {
+					lvl := WARN
+					if !xerrors.IsYdb(info.Error) {
+						lvl = DEBUG
+					}
+					l.Log(WithLevel(ctx, lvl), "failed",
+						kv.Latency(start),
+						kv.Error(info.Error),
+						kv.Version(),
+					)
+				}
+			}
+		},
+		OnTxCommit: func(info trace.QueryTxCommitStartInfo) func(info trace.QueryTxCommitDoneInfo) {
+			if d.Details()&trace.QueryTransactionEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "query", "transaction", "commit")
+			l.Log(ctx, "start",
+				kv.String("SessionID", info.Session.ID()),
+				kv.String("TransactionID", info.Tx.ID()),
+				kv.String("TxMode", info.TxMode),
+			)
+			start := time.Now()
+
+			return func(info trace.QueryTxCommitDoneInfo) {
+				if info.Error == nil {
+					l.Log(WithLevel(ctx, DEBUG), "done",
+						kv.Latency(start),
+					)
+				} else {
+					lvl := WARN
+					if !xerrors.IsYdb(info.Error) {
+						lvl = DEBUG
+					}
+					l.Log(WithLevel(ctx, lvl), "failed",
+						kv.Latency(start),
+						kv.Error(info.Error),
+						kv.Version(),
+					)
+				}
+			}
+		},
+		OnTxRollback: func(info trace.QueryTxRollbackStartInfo) func(info trace.QueryTxRollbackDoneInfo) {
+			if d.Details()&trace.QueryTransactionEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "query", "transaction", "rollback")
+			l.Log(ctx, "start",
+				kv.String("SessionID", info.Session.ID()),
+				kv.String("TransactionID", info.Tx.ID()),
+				kv.String("TxMode", info.TxMode),
+			)
+			start := time.Now()
+
+			return func(info trace.QueryTxRollbackDoneInfo) {
+				if info.Error == nil {
+					l.Log(WithLevel(ctx, DEBUG), "done",
+						kv.Latency(start),
+					)
+				} else {
+					lvl := WARN
+					if !xerrors.IsYdb(info.Error) {
+						lvl = DEBUG
+					}
+					l.Log(WithLevel(ctx, lvl), "failed",
+						kv.Latency(start),
+						kv.Error(info.Error),
+						kv.Version(),
+					)
+				}
+			}
+		},
 		OnTxQuery: func(info trace.QueryTxQueryStartInfo) func(info trace.QueryTxQueryDoneInfo) {
 			if d.Details()&trace.QueryTransactionEvents == 0 {
 				return nil
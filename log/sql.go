@@ -32,6 +32,7 @@ func internalDatabaseSQL(l *wrapper, d trace.Detailer) (t trace.DatabaseSQL) {
 			if info.Error == nil {
 				l.Log(WithLevel(ctx, DEBUG), "connected",
 					kv.Latency(start),
+					kv.String("conn_id", info.ConnID),
 					kv.String("session_id", info.Session.ID()),
 					kv.String("session_status", info.Session.Status()),
 				)
@@ -339,5 +340,29 @@ func internalDatabaseSQL(l *wrapper, d trace.Detailer) (t trace.DatabaseSQL) {
 		}
 	}
 
+	t.OnSlowQuery = func(info trace.DatabaseSQLSlowQueryInfo) {
+		if d.Details()&trace.DatabaseSQLConnEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "database", "sql", "conn", "slow")
+		if info.Error == nil {
+			l.Log(ctx, "slow query",
+				appendFieldByCondition(l.logQuery,
+					kv.String("query", info.Query),
+					kv.Duration("elapsed", info.Elapsed),
+					kv.String("plan", info.Plan),
+				)...,
+			)
+		} else {
+			l.Log(ctx, "slow query (explain failed)",
+				appendFieldByCondition(l.logQuery,
+					kv.String("query", info.Query),
+					kv.Duration("elapsed", info.Elapsed),
+					kv.Error(info.Error),
+				)...,
+			)
+		}
+	}
+
 	return t
 }
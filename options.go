@@ -613,6 +613,18 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithTableTxQueryCache enables cache of compiled queries within table service transactions.
+// First execution of query text within transaction keeps compiled query in server cache of session,
+// repeated executions of the same text within transaction are executed by id of compiled query.
+// Cache is cleared on commit or rollback of transaction
+func WithTableTxQueryCache() Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithTxQueryCache())
+
+		return nil
+	}
+}
+
 // WithPanicCallback specified behavior on panic
 // Warning: WithPanicCallback must be defined on start of all options
 // (before `WithTrace{Driver,Table,Scheme,Scripting,Coordination,Ratelimiter}` and other options)
@@ -34,3 +34,18 @@ func ParamsFromMap(m map[string]any) params.Parameters {
 
 	return (*params.Params)(&p)
 }
+
+// ParamsFromArgs build parameters from database/sql args (driver.NamedValue, sql.NamedArg or
+// positional values) with the same binding rules as database/sql driver uses. Positional args
+// are named as $p0, $p1, ... by position of arg.
+// ParamsFromArgs helps reuse of database/sql args with native query client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParamsFromArgs(args ...any) params.Parameters {
+	p, err := bind.Params(args...)
+	if err != nil {
+		return wrongParameters{err: xerrors.WithStackTrace(err)}
+	}
+
+	return (*params.Params)(&p)
+}
@@ -1,8 +1,11 @@
 package ydb_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -125,6 +128,15 @@ func makeParamsUsingParamsFromMap(tb testing.TB) params.Parameters {
 	})
 }
 
+func makeParamsUsingParamsFromArgs(tb testing.TB) params.Parameters {
+	return ydb.ParamsFromArgs(
+		driver.NamedValue{Name: "$a", Value: uint64(123)},
+		sql.Named("$b", uuid.UUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+		driver.NamedValue{Name: "$c", Value: func(v uint64) *uint64 { return &v }(123)},
+		sql.Named("$d", []uint64{123, 123, 123, 123}),
+	)
+}
+
 func makeParamsUsingTableTypes(tb testing.TB) params.Parameters {
 	return table.NewQueryParameters(
 		table.ValueParam("$a", types.Uint64Value(123)),
@@ -170,6 +182,14 @@ func TestParams(t *testing.T) {
 		require.Equal(t, fmt.Sprint(exp), fmt.Sprint(pb))
 		a.Free()
 	})
+	t.Run("ParamsFromArgs", func(t *testing.T) {
+		params := makeParamsUsingParamsFromArgs(t)
+		a := allocator.New()
+		pb, err := params.ToYDB(a)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprint(exp), fmt.Sprint(pb))
+		a.Free()
+	})
 	t.Run("table/types", func(t *testing.T) {
 		params := makeParamsUsingTableTypes(t)
 		a := allocator.New()
@@ -180,6 +200,76 @@ func TestParams(t *testing.T) {
 	})
 }
 
+func TestParamsFromArgs(t *testing.T) {
+	ts := time.Date(2024, 5, 17, 23, 59, 58, 123456000, time.UTC)
+	for _, tt := range []struct {
+		name   string
+		args   []any
+		native params.Parameters
+	}{
+		{
+			name: "NamedValues",
+			args: []any{
+				driver.NamedValue{Name: "$text", Value: "text"},
+				driver.NamedValue{Name: "$flag", Value: true},
+				driver.NamedValue{Name: "$num", Value: int32(-1)},
+				driver.NamedValue{Name: "$ts", Value: ts},
+				driver.NamedValue{Name: "$bytes", Value: []byte("bytes")},
+			},
+			native: ydb.ParamsBuilder().
+				Param("$text").Text("text").
+				Param("$flag").Bool(true).
+				Param("$num").Int32(-1).
+				Param("$ts").Timestamp(ts).
+				Param("$bytes").Bytes([]byte("bytes")).
+				Build(),
+		},
+		{
+			name: "NamedArgs",
+			args: []any{
+				sql.Named("text", "text"),
+				sql.Named("$num", float64(1.5)),
+				sql.Named("$dur", time.Second),
+			},
+			native: ydb.ParamsBuilder().
+				Param("$text").Text("text").
+				Param("$num").Double(1.5).
+				Param("$dur").Interval(time.Second).
+				Build(),
+		},
+		{
+			name: "Positional",
+			args: []any{
+				"text",
+				driver.NamedValue{Ordinal: 2, Value: uint32(2)},
+			},
+			native: ydb.ParamsBuilder().
+				Param("$p0").Text("text").
+				Param("$p1").Uint32(2).
+				Build(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := allocator.New()
+			defer a.Free()
+
+			exp, err := tt.native.ToYDB(a)
+			require.NoError(t, err)
+
+			act, err := ydb.ParamsFromArgs(tt.args...).ToYDB(a)
+			require.NoError(t, err)
+			require.Equal(t, fmt.Sprint(exp), fmt.Sprint(act))
+		})
+	}
+	t.Run("Error", func(t *testing.T) {
+		a := allocator.New()
+		defer a.Free()
+
+		_, err := ydb.ParamsFromArgs(sql.Named("", 1)).ToYDB(a)
+		require.Error(t, err)
+	})
+}
+
 func BenchmarkParams(b *testing.B) {
 	b.Run("ParamsBuilder", func(b *testing.B) {
 		b.ReportAllocs()
@@ -0,0 +1,115 @@
+// Package arrow converts results of YDB queries into Apache Arrow record batches.
+//
+// Package is a separated module, so Apache Arrow dependency isn't required for users of core SDK.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package arrow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+// batchSize is a max number of rows in single record batch
+const batchSize = 1024
+
+// ExecuteArrow executes query and converts result set of query into Arrow record batches.
+// Schema of records is derived from column types of result set.
+// Optional YDB types are mapped into nullable Arrow fields.
+//
+// Like query.Executor.QueryResultSet, query must return exactly one result set.
+// Result set is read entirely before return, so returned reader doesn't hold session of query.
+// Record batches have at most 1024 rows. Returned reader must be released by caller
+//
+// Mapping of YDB types into Arrow types:
+//
+//	Bool                      -> Boolean
+//	Int8 ... Int64            -> Int8 ... Int64
+//	Uint8 ... Uint64          -> Uint8 ... Uint64
+//	Float, Double             -> Float32, Float64
+//	Utf8, Json, JsonDocument  -> String
+//	String, Yson              -> Binary
+//	Uuid                      -> FixedSizeBinary(16)
+//	Date                      -> Date32 (days since Unix epoch)
+//	Datetime                  -> Timestamp(s, UTC)
+//	Timestamp                 -> Timestamp(us, UTC)
+//	Interval                  -> Duration(us)
+//	Decimal(p,s)              -> Decimal128(p,s)
+//
+// Other types (such as containers and timezone types) are not supported.
+func ExecuteArrow(ctx context.Context, e query.Executor, sql string, opts ...query.ExecuteOption) (
+	_ array.RecordReader, finalErr error,
+) {
+	rs, err := e.QueryResultSet(ctx, sql, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rs.Close(ctx); err != nil && finalErr == nil {
+			finalErr = err
+		}
+	}()
+
+	return readRecords(ctx, rs, memory.DefaultAllocator)
+}
+
+func readRecords(ctx context.Context, rs query.ResultSet, mem memory.Allocator) (array.RecordReader, error) {
+	descriptors := rs.ColumnDescriptors()
+	columns := make([]column, len(descriptors))
+	fields := make([]arrow.Field, len(descriptors))
+	dst := make([]interface{}, len(descriptors))
+	for i := range descriptors {
+		c, err := newColumn(descriptors[i])
+		if err != nil {
+			return nil, err
+		}
+		columns[i], fields[i], dst[i] = c, c.field, c.dst()
+	}
+	schema := arrow.NewSchema(fields, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	var (
+		records []arrow.Record
+		rows    int
+	)
+	defer func() {
+		for _, r := range records {
+			r.Release()
+		}
+	}()
+
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+		if err = row.Scan(dst...); err != nil {
+			return nil, fmt.Errorf("scan row: %w", err)
+		}
+		for i := range columns {
+			columns[i].append(b.Field(i), dst[i])
+		}
+		if rows++; rows == batchSize {
+			records = append(records, b.NewRecord())
+			rows = 0
+		}
+	}
+	if rows > 0 {
+		records = append(records, b.NewRecord())
+	}
+
+	return array.NewRecordReader(schema, records)
+}
@@ -0,0 +1,199 @@
+package arrow
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	testExecutor struct {
+		query.Executor
+
+		rs  *testResultSet
+		sql string
+	}
+	testResultSet struct {
+		query.ClosableResultSet
+
+		columns []query.Column
+		rows    [][]types.Value
+		closed  bool
+	}
+	testRow struct {
+		query.Row
+
+		values []types.Value
+	}
+)
+
+func (e *testExecutor) QueryResultSet(_ context.Context, sql string, _ ...query.ExecuteOption) (
+	query.ClosableResultSet, error,
+) {
+	e.sql = sql
+
+	return e.rs, nil
+}
+
+func (rs *testResultSet) ColumnDescriptors() []query.Column {
+	return rs.columns
+}
+
+func (rs *testResultSet) NextRow(context.Context) (query.Row, error) {
+	if len(rs.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := rs.rows[0]
+	rs.rows = rs.rows[1:]
+
+	return testRow{values: row}, nil
+}
+
+func (rs *testResultSet) Close(context.Context) error {
+	rs.closed = true
+
+	return nil
+}
+
+func (r testRow) Scan(dst ...interface{}) error {
+	for i := range dst {
+		if err := types.CastTo(r.values[i], dst[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newTestResultSet(names []string, rows ...[]types.Value) *testResultSet {
+	rs := &testResultSet{rows: rows}
+	for i, name := range names {
+		rs.columns = append(rs.columns, query.Column{Name: name, Type: rows[0][i].Type()})
+	}
+
+	return rs
+}
+
+func TestExecuteArrow(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 123456000, time.UTC)
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	price, err := types.DecimalValueFromString("-12.34", 22, 9)
+	require.NoError(t, err)
+
+	t.Run("SchemaAndValues", func(t *testing.T) {
+		rs := newTestResultSet(
+			[]string{"id", "name", "uuid", "created_at", "day", "ttl", "price"},
+			[]types.Value{
+				types.Uint64Value(1),
+				types.OptionalValue(types.TextValue("a")),
+				types.UuidValue(id),
+				types.TimestampValueFromTime(ts),
+				types.DateValueFromTime(ts),
+				types.IntervalValueFromDuration(time.Second),
+				price,
+			},
+			[]types.Value{
+				types.Uint64Value(2),
+				types.NullValue(types.TypeText),
+				types.UuidValue(id),
+				types.TimestampValueFromTime(ts.Add(time.Microsecond)),
+				types.DateValueFromTime(ts),
+				types.IntervalValueFromDuration(time.Minute),
+				price,
+			},
+		)
+		e := &testExecutor{rs: rs}
+		r, err := ExecuteArrow(ctx, e, "SELECT * FROM t")
+		require.NoError(t, err)
+		defer r.Release()
+		require.Equal(t, "SELECT * FROM t", e.sql)
+		require.True(t, rs.closed)
+
+		require.Equal(t, arrow.NewSchema([]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Uint64},
+			{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "uuid", Type: &arrow.FixedSizeBinaryType{ByteWidth: 16}},
+			{Name: "created_at", Type: &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}},
+			{Name: "day", Type: arrow.FixedWidthTypes.Date32},
+			{Name: "ttl", Type: &arrow.DurationType{Unit: arrow.Microsecond}},
+			{Name: "price", Type: &arrow.Decimal128Type{Precision: 22, Scale: 9}},
+		}, nil).String(), r.Schema().String())
+
+		require.True(t, r.Next())
+		rec := r.Record()
+		require.EqualValues(t, 2, rec.NumRows())
+
+		require.Equal(t, []uint64{1, 2}, rec.Column(0).(*array.Uint64).Uint64Values())
+
+		name := rec.Column(1).(*array.String)
+		require.Equal(t, "a", name.Value(0))
+		require.True(t, name.IsNull(1))
+
+		require.Equal(t, id[:], rec.Column(2).(*array.FixedSizeBinary).Value(0))
+
+		require.Equal(t, []arrow.Timestamp{
+			arrow.Timestamp(ts.UnixMicro()),
+			arrow.Timestamp(ts.UnixMicro() + 1),
+		}, rec.Column(3).(*array.Timestamp).TimestampValues())
+
+		require.Equal(t, arrow.Date32FromTime(ts), rec.Column(4).(*array.Date32).Value(0))
+
+		require.Equal(t, []arrow.Duration{
+			arrow.Duration(time.Second.Microseconds()),
+			arrow.Duration(time.Minute.Microseconds()),
+		}, rec.Column(5).(*array.Duration).DurationValues())
+
+		require.Equal(t, decimal128.FromI64(-12340000000), rec.Column(6).(*array.Decimal128).Value(0))
+
+		require.False(t, r.Next())
+		require.NoError(t, r.Err())
+	})
+	t.Run("Batches", func(t *testing.T) {
+		rows := make([][]types.Value, batchSize+1)
+		for i := range rows {
+			rows[i] = []types.Value{types.Int32Value(int32(i))}
+		}
+		r, err := ExecuteArrow(ctx, &testExecutor{rs: newTestResultSet([]string{"n"}, rows...)}, "SELECT n")
+		require.NoError(t, err)
+		defer r.Release()
+
+		var sizes []int64
+		for r.Next() {
+			sizes = append(sizes, r.Record().NumRows())
+		}
+		require.Equal(t, []int64{batchSize, 1}, sizes)
+	})
+	t.Run("UnsupportedType", func(t *testing.T) {
+		rs := newTestResultSet([]string{"list"}, []types.Value{types.ListValue(types.Int32Value(1))})
+		_, err := ExecuteArrow(ctx, &testExecutor{rs: rs}, "SELECT [1] AS list")
+		require.ErrorIs(t, err, errUnsupportedType)
+		require.Contains(t, err.Error(), `column "list" of type List<Int32>`)
+		require.True(t, rs.closed)
+	})
+	t.Run("QueryError", func(t *testing.T) {
+		errQuery := errors.New("query failed")
+		_, err := ExecuteArrow(ctx, &errExecutor{err: errQuery}, "SELECT 1")
+		require.ErrorIs(t, err, errQuery)
+	})
+}
+
+type errExecutor struct {
+	query.Executor
+
+	err error
+}
+
+func (e *errExecutor) QueryResultSet(context.Context, string, ...query.ExecuteOption) (query.ClosableResultSet, error) {
+	return nil, e.err
+}
@@ -0,0 +1,168 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var errUnsupportedType = errors.New("unsupported type")
+
+// column converts values of result set column into values of Arrow field
+type column struct {
+	field arrow.Field
+	// dst makes scan destination of column values which is reused for all rows
+	dst func() interface{}
+	// append appends scanned value of dst into builder of field
+	append func(b array.Builder, dst interface{})
+}
+
+// newTypedColumn makes column which scans values into T (or *T for nullable column)
+func newTypedColumn[T any](name string, t arrow.DataType, nullable bool, add func(b array.Builder, v T)) column {
+	c := column{
+		field: arrow.Field{
+			Name:     name,
+			Type:     t,
+			Nullable: nullable,
+		},
+	}
+	if nullable {
+		c.dst = func() interface{} {
+			return new(*T)
+		}
+		c.append = func(b array.Builder, dst interface{}) {
+			if v := *(dst.(**T)); v != nil {
+				add(b, *v)
+			} else {
+				b.AppendNull()
+			}
+		}
+	} else {
+		c.dst = func() interface{} {
+			return new(T)
+		}
+		c.append = func(b array.Builder, dst interface{}) {
+			add(b, *(dst.(*T)))
+		}
+	}
+
+	return c
+}
+
+//nolint:funlen
+func newColumn(c query.Column) (column, error) {
+	t, nullable := c.Type, false
+	if isOptional, innerType := types.IsOptional(t); isOptional {
+		t, nullable = innerType, true
+	}
+
+	switch {
+	case types.Equal(t, types.TypeBool):
+		return newTypedColumn(c.Name, arrow.FixedWidthTypes.Boolean, nullable, func(b array.Builder, v bool) {
+			b.(*array.BooleanBuilder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeInt8):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Int8, nullable, func(b array.Builder, v int8) {
+			b.(*array.Int8Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeInt16):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Int16, nullable, func(b array.Builder, v int16) {
+			b.(*array.Int16Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeInt32):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Int32, nullable, func(b array.Builder, v int32) {
+			b.(*array.Int32Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeInt64):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Int64, nullable, func(b array.Builder, v int64) {
+			b.(*array.Int64Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeUint8):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Uint8, nullable, func(b array.Builder, v uint8) {
+			b.(*array.Uint8Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeUint16):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Uint16, nullable, func(b array.Builder, v uint16) {
+			b.(*array.Uint16Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeUint32):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Uint32, nullable, func(b array.Builder, v uint32) {
+			b.(*array.Uint32Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeUint64):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Uint64, nullable, func(b array.Builder, v uint64) {
+			b.(*array.Uint64Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeFloat):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Float32, nullable, func(b array.Builder, v float32) {
+			b.(*array.Float32Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeDouble):
+		return newTypedColumn(c.Name, arrow.PrimitiveTypes.Float64, nullable, func(b array.Builder, v float64) {
+			b.(*array.Float64Builder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeText), types.Equal(t, types.TypeJSON), types.Equal(t, types.TypeJSONDocument):
+		return newTypedColumn(c.Name, arrow.BinaryTypes.String, nullable, func(b array.Builder, v string) {
+			b.(*array.StringBuilder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeBytes), types.Equal(t, types.TypeYSON):
+		return newTypedColumn(c.Name, arrow.BinaryTypes.Binary, nullable, func(b array.Builder, v []byte) {
+			b.(*array.BinaryBuilder).Append(v)
+		}), nil
+	case types.Equal(t, types.TypeUUID):
+		return newTypedColumn(c.Name, &arrow.FixedSizeBinaryType{ByteWidth: len(uuid.UUID{})}, nullable,
+			func(b array.Builder, v uuid.UUID) {
+				b.(*array.FixedSizeBinaryBuilder).Append(v[:])
+			},
+		), nil
+	case types.Equal(t, types.TypeDate):
+		return newTypedColumn(c.Name, arrow.FixedWidthTypes.Date32, nullable, func(b array.Builder, v time.Time) {
+			b.(*array.Date32Builder).Append(arrow.Date32FromTime(v))
+		}), nil
+	case types.Equal(t, types.TypeDatetime):
+		return newTypedColumn(c.Name, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"}, nullable,
+			func(b array.Builder, v time.Time) {
+				b.(*array.TimestampBuilder).Append(arrow.Timestamp(v.Unix()))
+			},
+		), nil
+	case types.Equal(t, types.TypeTimestamp):
+		return newTypedColumn(c.Name, &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nullable,
+			func(b array.Builder, v time.Time) {
+				b.(*array.TimestampBuilder).Append(arrow.Timestamp(v.UnixMicro()))
+			},
+		), nil
+	case types.Equal(t, types.TypeInterval):
+		return newTypedColumn(c.Name, &arrow.DurationType{Unit: arrow.Microsecond}, nullable,
+			func(b array.Builder, v time.Duration) {
+				b.(*array.DurationBuilder).Append(arrow.Duration(v.Microseconds()))
+			},
+		), nil
+	}
+
+	if d, ok := t.(interface {
+		Precision() uint32
+		Scale() uint32
+	}); ok {
+		return newTypedColumn(c.Name,
+			&arrow.Decimal128Type{Precision: int32(d.Precision()), Scale: int32(d.Scale())}, nullable,
+			func(b array.Builder, v types.Decimal) {
+				b.(*array.Decimal128Builder).Append(decimal128FromBytes(v.Bytes))
+			},
+		), nil
+	}
+
+	return column{}, fmt.Errorf("column %q of type %s: %w", c.Name, c.Type.Yql(), errUnsupportedType)
+}
+
+// decimal128FromBytes converts big-endian two's complement YDB decimal into Arrow decimal
+func decimal128FromBytes(b [16]byte) decimal128.Num {
+	return decimal128.New(int64(binary.BigEndian.Uint64(b[:8])), binary.BigEndian.Uint64(b[8:]))
+}
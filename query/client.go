@@ -61,6 +61,11 @@ type (
 		//
 		// If op TxOperation returns nil - transaction will be committed
 		// If op TxOperation return non nil - transaction will be rollback
+		//
+		// DoTx called with context of outer DoTx operation of the same client joins outer transaction instead of
+		// beginning a new one. DoTx of another client begins own transaction as usual.
+		// Such nested call neither retries nor commits - only the outermost DoTx does. Nested call returns
+		// ErrNestedTxIsolation if requested TransactionSettings are stronger than settings of outer transaction
 		// Warning: if context without deadline or cancellation func than DoTx can run indefinitely
 		DoTx(ctx context.Context, op TxOperation, opts ...DoTxOption) error
 
@@ -148,6 +153,11 @@ func WithLabel(lbl string) options.RetryOptionsOption {
 	return options.WithLabel(lbl)
 }
 
+// WithRetryTrace appends trace of retry loop of Do and DoTx calls
+func WithRetryTrace(t *trace.Retry) options.RetryOptionsOption {
+	return options.WithRetryTrace(t)
+}
+
 // WithRetryBudget creates option with external budget
 func WithRetryBudget(b budget.Budget) options.RetryOptionsOption {
 	return options.WithRetryBudget(b)
@@ -0,0 +1,113 @@
+package query
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	csvSettings struct {
+		delimiter rune
+		nullValue string
+		header    bool
+	}
+	// CSVOption is an option for ExportCSV
+	CSVOption func(s *csvSettings)
+)
+
+// WithCSVDelimiter defines delimiter of fields (comma by default)
+func WithCSVDelimiter(delimiter rune) CSVOption {
+	return func(s *csvSettings) {
+		s.delimiter = delimiter
+	}
+}
+
+// WithCSVNullValue defines representation of NULL values (empty string by default)
+func WithCSVNullValue(nullValue string) CSVOption {
+	return func(s *csvSettings) {
+		s.nullValue = nullValue
+	}
+}
+
+// WithCSVHeader enables (by default) or disables header row with column names
+func WithCSVHeader(header bool) CSVOption {
+	return func(s *csvSettings) {
+		s.header = header
+	}
+}
+
+// ExportCSV writes rows of result set into w as CSV and returns number of written rows (without header).
+// Rows are read and written one by one, so result set isn't materialized in memory.
+//
+// Text, bytes and numbers are written as is, date and time values in RFC3339 format
+// and other values as YQL literals.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ExportCSV(ctx context.Context, rs ResultSet, w io.Writer, opts ...CSVOption) (rows int64, err error) {
+	settings := csvSettings{
+		delimiter: ',',
+		header:    true,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = settings.delimiter
+
+	columns := rs.Columns()
+	if settings.header {
+		if err = cw.Write(columns); err != nil {
+			return 0, xerrors.WithStackTrace(err)
+		}
+	}
+
+	record := make([]string, len(columns))
+
+	// written rows are flushed into w even if reading of result set fails
+	defer cw.Flush()
+
+	rows, err = exportRows(ctx, rs, len(columns), func(values []value.Value) error {
+		for i := range values {
+			record[i] = csvField(values[i], settings.nullValue)
+		}
+
+		return cw.Write(record)
+	})
+	if err != nil {
+		return rows, xerrors.WithStackTrace(err)
+	}
+
+	cw.Flush()
+	if err = cw.Error(); err != nil {
+		return rows, xerrors.WithStackTrace(err)
+	}
+
+	return rows, nil
+}
+
+func csvField(v value.Value, nullValue string) string {
+	v = value.Unwrap(v)
+	if value.IsNull(v) {
+		return nullValue
+	}
+
+	var s string
+	if err := value.CastTo(v, &s); err == nil {
+		return s
+	}
+
+	var t time.Time
+	if err := value.CastTo(v, &t); err == nil {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	return v.Yql()
+}
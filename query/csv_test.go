@@ -0,0 +1,121 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	csvResultSet struct {
+		ResultSet
+
+		columns []string
+		rows    [][]value.Value
+		err     error
+	}
+	csvRow struct {
+		Row
+
+		values []value.Value
+	}
+)
+
+func (rs *csvResultSet) Columns() []string {
+	return rs.columns
+}
+
+func (rs *csvResultSet) NextRow(context.Context) (Row, error) {
+	if len(rs.rows) == 0 {
+		if rs.err != nil {
+			return nil, rs.err
+		}
+
+		return nil, xerrors.WithStackTrace(io.EOF)
+	}
+	row := rs.rows[0]
+	rs.rows = rs.rows[1:]
+
+	return csvRow{values: row}, nil
+}
+
+func (r csvRow) Scan(dst ...interface{}) error {
+	for i := range dst {
+		if err := value.CastTo(r.values[i], dst[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestExportCSV(t *testing.T) {
+	ctx := context.Background()
+	newResultSet := func() *csvResultSet {
+		return &csvResultSet{
+			columns: []string{"id", "name", "created", "tags"},
+			rows: [][]value.Value{
+				{
+					value.Uint64Value(1),
+					value.OptionalValue(value.TextValue("a,b")),
+					value.TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+					value.ListValue(value.TextValue("x")),
+				},
+				{
+					value.Uint64Value(2),
+					value.NullValue(types.Text),
+					value.NullValue(types.Timestamp),
+					value.ListValue(),
+				},
+			},
+		}
+	}
+	for _, tt := range []struct {
+		name string
+		opts []CSVOption
+		csv  string
+	}{
+		{
+			name: "Defaults",
+			csv: "id,name,created,tags\n" +
+				"1,\"a,b\",2024-01-02T03:04:05Z,\"[\"\"x\"\"u]\"\n" +
+				"2,,,[]\n",
+		},
+		{
+			name: "WithOptions",
+			opts: []CSVOption{
+				WithCSVDelimiter(';'),
+				WithCSVNullValue(`\N`),
+				WithCSVHeader(false),
+			},
+			csv: "1;a,b;2024-01-02T03:04:05Z;\"[\"\"x\"\"u]\"\n" +
+				"2;\\N;\\N;[]\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			rows, err := ExportCSV(ctx, newResultSet(), &buf, tt.opts...)
+			require.NoError(t, err)
+			require.EqualValues(t, 2, rows)
+			require.Equal(t, tt.csv, buf.String())
+		})
+	}
+	t.Run("ReadError", func(t *testing.T) {
+		rs := newResultSet()
+		rs.err = errors.New("test")
+		var buf bytes.Buffer
+		rows, err := ExportCSV(ctx, rs, &buf)
+		require.ErrorIs(t, err, rs.err)
+		require.EqualValues(t, 2, rows)
+		require.Equal(t, 3, bytes.Count(buf.Bytes(), []byte("\n")))
+	})
+}
@@ -63,6 +63,17 @@ func WithResponsePartLimitSizeBytes(size int64) ExecuteOption {
 	return options.WithResponsePartLimitSizeBytes(size)
 }
 
+// WithResultPageSize sets preferred size in bytes of each result part (page) in query response stream.
+// Smaller pages decrease latency of first rows and memory usage, bigger pages decrease overhead of
+// reading of large results. Server limits parts by size in bytes only, so page size in rows is not
+// supported. Size must be positive, size greater than MaxResultPageSize is capped
+func WithResultPageSize(size int64) ExecuteOption {
+	return options.WithResultPageSize(size)
+}
+
+// MaxResultPageSize is an upper bound of result page size (see WithResultPageSize)
+const MaxResultPageSize = options.MaxResultPageSize
+
 func WithCallOptions(opts ...grpc.CallOption) ExecuteOption {
 	return options.WithCallOptions(opts...)
 }
@@ -73,3 +84,29 @@ func WithCallOptions(opts ...grpc.CallOption) ExecuteOption {
 func WithResourcePool(id string) ExecuteOption {
 	return options.WithResourcePool(id)
 }
+
+// WithStatementInconsistentReads allows inconsistent reads for the single statement executed with
+// online read-only transaction control (see OnlineReadOnlyTxControl) without WithInconsistentReads
+// option. Execution with any other transaction control fails with error
+func WithStatementInconsistentReads() ExecuteOption {
+	return options.WithInconsistentReads()
+}
+
+// WithColumnRename renames columns of each result set of query (map of source column name to new name).
+// New names are reported by Columns and used by named and struct scanning of rows, so columns can be aliased
+// without changes of query text. Reading of result set without some source column fails with error
+func WithColumnRename(rename map[string]string) ExecuteOption {
+	return options.WithColumnRename(rename)
+}
+
+// WithOptionalRow makes QueryRow to return ErrNoRow if result has no rows.
+// Without option QueryRow returns io.EOF based error on empty result
+func WithOptionalRow() ExecuteOption {
+	return options.WithOptionalRow()
+}
+
+// WithRowMustExist makes QueryRow to return NoRowError if result has no rows.
+// Use errors.As for check that required row not exists
+func WithRowMustExist() ExecuteOption {
+	return options.WithRowMustExist()
+}
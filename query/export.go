@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// exportRows reads rows of result set one by one and calls write with values of row columns.
+// Slice of values is reused between calls of write
+func exportRows(ctx context.Context, rs ResultSet, columns int, write func(values []value.Value) error) (
+	rows int64, _ error,
+) {
+	var (
+		values = make([]value.Value, columns)
+		dst    = make([]interface{}, columns)
+	)
+	for i := range values {
+		dst[i] = &values[i]
+	}
+
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			return rows, xerrors.WithStackTrace(err)
+		}
+
+		if err = row.Scan(dst...); err != nil {
+			return rows, xerrors.WithStackTrace(err)
+		}
+
+		if err = write(values); err != nil {
+			return rows, xerrors.WithStackTrace(err)
+		}
+
+		rows++
+	}
+}
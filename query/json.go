@@ -0,0 +1,192 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	// JSONFormatArray writes rows as elements of single JSON array
+	JSONFormatArray = JSONFormat(iota)
+	// JSONFormatNDJSON writes rows as newline delimited JSON objects (one object per line)
+	JSONFormatNDJSON
+)
+
+type (
+	// JSONFormat defines layout of rows in ExportJSON output
+	JSONFormat   int
+	jsonSettings struct {
+		format JSONFormat
+	}
+	// JSONOption is an option for ExportJSON
+	JSONOption func(s *jsonSettings)
+)
+
+// WithJSONFormat defines layout of rows (JSONFormatArray by default)
+func WithJSONFormat(format JSONFormat) JSONOption {
+	return func(s *jsonSettings) {
+		s.format = format
+	}
+}
+
+// ExportJSON writes rows of result set into w as JSON objects keyed by column names (in order of columns)
+// and returns number of written rows. Rows are read and written one by one, so result set isn't
+// materialized in memory.
+//
+// Values are converted as follows:
+//   - NULL is written as null
+//   - Bool is written as boolean, integer types and Interval (number of microseconds) as numbers
+//   - Float and Double are written as numbers, NaN and infinities as strings "NaN", "+Inf" and "-Inf"
+//   - Decimal is written as string with exact decimal value (such as "-12.345000000")
+//   - Date is written as string "2006-01-02", Datetime and Timestamp as RFC3339 strings in UTC
+//   - TzDate, TzDatetime and TzTimestamp are written as strings in YDB format (with time zone name)
+//   - Json and JsonDocument are embedded as JSON values
+//   - Text, Bytes, Yson, Uuid and DyNumber are written as strings
+//   - other values (containers, variants, etc.) are written as strings with YQL literals
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ExportJSON(ctx context.Context, rs ResultSet, w io.Writer, opts ...JSONOption) (rows int64, err error) {
+	var settings jsonSettings
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	columns := rs.Columns()
+	names := make([][]byte, len(columns))
+	for i, name := range columns {
+		if names[i], err = json.Marshal(name); err != nil {
+			return 0, xerrors.WithStackTrace(err)
+		}
+	}
+
+	var (
+		buf   bytes.Buffer
+		first = true
+	)
+	rows, err = exportRows(ctx, rs, len(columns), func(values []value.Value) error {
+		buf.Reset()
+		if settings.format == JSONFormatArray {
+			if first {
+				buf.WriteByte('[')
+			} else {
+				buf.WriteByte(',')
+			}
+		}
+		buf.WriteByte('{')
+		for i := range values {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(names[i])
+			buf.WriteByte(':')
+			field, err := json.Marshal(jsonField(values[i]))
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			buf.Write(field)
+		}
+		buf.WriteByte('}')
+		if settings.format == JSONFormatNDJSON {
+			buf.WriteByte('\n')
+		}
+		first = false
+		_, err := w.Write(buf.Bytes())
+
+		return err
+	})
+	if err != nil {
+		return rows, xerrors.WithStackTrace(err)
+	}
+
+	if settings.format == JSONFormatArray {
+		tail := "]\n"
+		if rows == 0 {
+			tail = "[]\n"
+		}
+		if _, err = io.WriteString(w, tail); err != nil {
+			return rows, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return rows, nil
+}
+
+//nolint:funlen
+func jsonField(v value.Value) interface{} {
+	v = value.Unwrap(v)
+	if value.IsNull(v) {
+		return nil
+	}
+
+	if d, ok := v.(value.DecimalValuer); ok {
+		b := d.Value()
+
+		return decimal.Format(decimal.FromBytes(b[:], d.Precision(), d.Scale()), d.Precision(), d.Scale())
+	}
+
+	switch v.Type() {
+	case types.Bool:
+		var b bool
+		if err := value.CastTo(v, &b); err == nil {
+			return b
+		}
+	case types.Int8, types.Int16, types.Int32, types.Int64:
+		var i int64
+		if err := value.CastTo(v, &i); err == nil {
+			return i
+		}
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		var u uint64
+		if err := value.CastTo(v, &u); err == nil {
+			return u
+		}
+	case types.Float, types.Double:
+		var f float64
+		if err := value.CastTo(v, &f); err == nil {
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return strconv.FormatFloat(f, 'g', -1, 64)
+			}
+
+			return f
+		}
+	case types.Interval:
+		var d time.Duration
+		if err := value.CastTo(v, &d); err == nil {
+			return d.Microseconds()
+		}
+	case types.Date:
+		var t time.Time
+		if err := value.CastTo(v, &t); err == nil {
+			return t.UTC().Format(time.DateOnly)
+		}
+	case types.Datetime, types.Timestamp:
+		var t time.Time
+		if err := value.CastTo(v, &t); err == nil {
+			return t.UTC().Format(time.RFC3339Nano)
+		}
+	case types.JSON, types.JSONDocument:
+		var s string
+		if err := value.CastTo(v, &s); err == nil && json.Valid([]byte(s)) {
+			return json.RawMessage(s)
+		}
+	}
+
+	var s string
+	if err := value.CastTo(v, &s); err == nil {
+		return s
+	}
+
+	return v.Yql()
+}
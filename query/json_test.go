@@ -0,0 +1,101 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestExportJSON(t *testing.T) {
+	ctx := context.Background()
+	newResultSet := func() *csvResultSet {
+		return &csvResultSet{
+			columns: []string{"id", "name", "created", "amount", "doc"},
+			rows: [][]value.Value{
+				{
+					value.Uint64Value(1),
+					value.OptionalValue(value.TextValue("a")),
+					value.TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)),
+					func() value.Value {
+						v, err := value.DecimalValueFromString("-12.345", 22, 9)
+						require.NoError(t, err)
+
+						return v
+					}(),
+					value.JSONValue(`{"k":[1,2]}`),
+				},
+				{
+					value.Uint64Value(2),
+					value.NullValue(types.Text),
+					value.NullValue(types.Timestamp),
+					value.NullValue(types.NewDecimal(22, 9)),
+					value.NullValue(types.JSON),
+				},
+			},
+		}
+	}
+	const (
+		row1 = `{"id":1,"name":"a","created":"2024-01-02T03:04:05.000006Z",` +
+			`"amount":"-12.345000000","doc":{"k":[1,2]}}`
+		row2 = `{"id":2,"name":null,"created":null,"amount":null,"doc":null}`
+	)
+	for _, tt := range []struct {
+		name string
+		opts []JSONOption
+		json string
+	}{
+		{
+			name: "Array",
+			json: "[" + row1 + "," + row2 + "]\n",
+		},
+		{
+			name: "NDJSON",
+			opts: []JSONOption{WithJSONFormat(JSONFormatNDJSON)},
+			json: row1 + "\n" + row2 + "\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			rows, err := ExportJSON(ctx, newResultSet(), &buf, tt.opts...)
+			require.NoError(t, err)
+			require.EqualValues(t, 2, rows)
+			require.Equal(t, tt.json, buf.String())
+		})
+	}
+	t.Run("EmptyArray", func(t *testing.T) {
+		var buf bytes.Buffer
+		rows, err := ExportJSON(ctx, &csvResultSet{columns: []string{"id"}}, &buf)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, rows)
+		require.Equal(t, "[]\n", buf.String())
+	})
+}
+
+func TestJSONField(t *testing.T) {
+	for _, tt := range []struct {
+		v   value.Value
+		exp interface{}
+	}{
+		{v: value.BoolValue(true), exp: true},
+		{v: value.Int8Value(-1), exp: int64(-1)},
+		{v: value.Uint8Value(1), exp: uint64(1)},
+		{v: value.Uint64Value(math.MaxUint64), exp: uint64(math.MaxUint64)},
+		{v: value.DoubleValue(1.5), exp: 1.5},
+		{v: value.DoubleValue(math.Inf(1)), exp: "+Inf"},
+		{v: value.IntervalValueFromDuration(time.Second), exp: int64(1000000)},
+		{v: value.DateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), exp: "2024-01-02"},
+		{v: value.BytesValue([]byte("test")), exp: "test"},
+		{v: value.ListValue(value.Int32Value(1)), exp: "[1]"},
+	} {
+		t.Run(tt.v.Yql(), func(t *testing.T) {
+			require.Equal(t, tt.exp, jsonField(tt.v))
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ReadResultSetTyped executes query and scans every row of result into value of type T.
+// T must be a struct (see ScanStruct for mapping rules of columns to struct fields).
+//
+// Like QueryResultSet, query must return exactly one result set. Error is returned if
+// any column of result set can't be mapped to a field of T
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadResultSetTyped[T any](ctx context.Context, tx TxActor, sql string, opts ...ExecuteOption) (
+	_ []T, finalErr error,
+) {
+	rs, err := tx.QueryResultSet(ctx, sql, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if err := rs.Close(ctx); err != nil && finalErr == nil {
+			finalErr = xerrors.WithStackTrace(err)
+		}
+	}()
+
+	var values []T
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return values, nil
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		var v T
+		if err = row.ScanStruct(&v); err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("scan row %d into %T: %w", len(values), v, err))
+		}
+		values = append(values, v)
+	}
+}
@@ -0,0 +1,99 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type (
+	// typedRow scans struct with real struct scanner over columns and values of row
+	typedRow struct {
+		Row
+
+		columns []*Ydb.Column
+		values  []*Ydb.Value
+	}
+	errQueryResultSetTx struct {
+		TxActor
+
+		err error
+	}
+)
+
+func newTypedRow(columns ...KeyTupleColumn) typedRow {
+	// allocator isn't freed because protos of allocator are reused after free
+	a := allocator.New()
+	row := typedRow{}
+	for _, c := range columns {
+		tv := value.ToYDB(c.value, a)
+		row.columns = append(row.columns, &Ydb.Column{Name: c.name, Type: tv.GetType()})
+		row.values = append(row.values, tv.GetValue())
+	}
+
+	return row
+}
+
+func (r typedRow) ScanStruct(dst interface{}, opts ...ScanStructOption) error {
+	return scanner.Struct(scanner.Data(r.columns, r.values)).ScanStruct(dst, opts...)
+}
+
+func (tx *errQueryResultSetTx) QueryResultSet(context.Context, string, ...ExecuteOption) (ClosableResultSet, error) {
+	return nil, tx.err
+}
+
+func TestReadResultSetTyped(t *testing.T) {
+	ctx := xtest.Context(t)
+	type user struct {
+		ID   uint64 `sql:"id"`
+		Name string `sql:"name"`
+	}
+	t.Run("Struct", func(t *testing.T) {
+		tx := &readRowsTx{rows: []Row{
+			newTypedRow(KeyColumn("id", value.Uint64Value(1)), KeyColumn("name", value.TextValue("a"))),
+			newTypedRow(KeyColumn("id", value.Uint64Value(2)), KeyColumn("name", value.TextValue("b"))),
+		}}
+		users, err := ReadResultSetTyped[user](ctx, tx, "SELECT id, name FROM users")
+		require.NoError(t, err)
+		require.Equal(t, "SELECT id, name FROM users", tx.sql)
+		require.Equal(t, []user{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}, users)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		users, err := ReadResultSetTyped[user](ctx, &readRowsTx{}, "SELECT id, name FROM users")
+		require.NoError(t, err)
+		require.Empty(t, users)
+	})
+	t.Run("TypeMismatch", func(t *testing.T) {
+		tx := &readRowsTx{rows: []Row{
+			newTypedRow(KeyColumn("id", value.Uint64Value(1)), KeyColumn("name", value.TextValue("a"))),
+			newTypedRow(KeyColumn("id", value.TextValue("2")), KeyColumn("name", value.TextValue("b"))),
+		}}
+		users, err := ReadResultSetTyped[user](ctx, tx, "SELECT id, name FROM users")
+		require.Error(t, err)
+		require.Nil(t, users)
+		require.Contains(t, err.Error(), "scan row 1 into query.user")
+	})
+	t.Run("UnknownColumn", func(t *testing.T) {
+		tx := &readRowsTx{rows: []Row{
+			newTypedRow(KeyColumn("id", value.Uint64Value(1)), KeyColumn("email", value.TextValue("a@b"))),
+		}}
+		_, err := ReadResultSetTyped[user](ctx, tx, "SELECT id, email FROM users")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "scan row 0 into query.user")
+	})
+	t.Run("QueryResultSetError", func(t *testing.T) {
+		errMultipleResultSets := errors.New("unexpected result set")
+		_, err := ReadResultSetTyped[user](ctx,
+			&errQueryResultSetTx{err: errMultipleResultSets}, "SELECT 1; SELECT 2",
+		)
+		require.ErrorIs(t, err, errMultipleResultSets)
+	})
+}
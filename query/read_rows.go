@@ -0,0 +1,301 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	keyColumnsSeparator = "\x00"
+	readRowsKeysParam   = "$keys"
+)
+
+var (
+	errEmptyKeyTuple         = errors.New("key tuple has no columns")
+	errMismatchedKeyColumns  = errors.New("key tuples have different key columns")
+	errUnexpectedRowKey      = errors.New("row key doesn't match any of requested keys")
+	errEmptyReadRowsTableArg = errors.New("table path is empty")
+	errMixedKeyTypes         = errors.New("key tuples have different types of values")
+)
+
+type (
+	// KeyTuple is a key of table row made by Key.
+	// KeyTuple is comparable and can be used as map key.
+	KeyTuple struct {
+		columns string
+		// values is a serialized typed tuple of unwrapped key values
+		values string
+	}
+	// KeyTupleColumn is a named column of key tuple made by KeyColumn
+	KeyTupleColumn struct {
+		name  string
+		value value.Value
+	}
+	readRowsSettings struct {
+		tablePathPrefix bind.TablePathPrefix
+		executeOptions  []ExecuteOption
+	}
+	// ReadRowsOption is an option for ReadRowsByKeys
+	ReadRowsOption func(s *readRowsSettings)
+)
+
+// KeyColumn makes named column of key tuple
+//
+// Value must have type of key column (optional values are unwrapped)
+func KeyColumn(name string, v value.Value) KeyTupleColumn {
+	return KeyTupleColumn{
+		name:  name,
+		value: v,
+	}
+}
+
+// Key makes key tuple from key columns
+func Key(columns ...KeyTupleColumn) KeyTuple {
+	names := make([]string, len(columns))
+	values := make([]value.Value, len(columns))
+	for i := range columns {
+		names[i] = columns[i].name
+		values[i] = value.Unwrap(columns[i].value)
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	// deterministic serialization makes equal keys equal as map keys
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(value.ToYDB(value.TupleValue(values...), a))
+	if err != nil {
+		panic(err)
+	}
+
+	return KeyTuple{
+		columns: strings.Join(names, keyColumnsSeparator),
+		values:  string(data),
+	}
+}
+
+// Columns returns names of key columns
+func (k KeyTuple) Columns() []string {
+	if k.columns == "" {
+		return nil
+	}
+
+	return strings.Split(k.columns, keyColumnsSeparator)
+}
+
+// String returns YQL representation of key values
+func (k KeyTuple) String() string {
+	return k.tuple().Yql()
+}
+
+// tuple returns tuple of key values
+func (k KeyTuple) tuple() value.Value {
+	var tv Ydb.TypedValue
+	if err := proto.Unmarshal([]byte(k.values), &tv); err != nil {
+		return value.TupleValue()
+	}
+
+	return value.FromYDB(tv.GetType(), tv.GetValue())
+}
+
+// items returns key values
+func (k KeyTuple) items() []value.Value {
+	if tuple, ok := k.tuple().(interface{ TupleItems() []value.Value }); ok {
+		return tuple.TupleItems()
+	}
+
+	return nil
+}
+
+// WithReadRowsTablePathPrefix defines prefix for relative table path of ReadRowsByKeys
+func WithReadRowsTablePathPrefix(tablePathPrefix string) ReadRowsOption {
+	return func(s *readRowsSettings) {
+		s.tablePathPrefix = bind.TablePathPrefix(tablePathPrefix)
+	}
+}
+
+// WithReadRowsExecuteOptions defines execute options of query which reads rows in ReadRowsByKeys
+func WithReadRowsExecuteOptions(opts ...ExecuteOption) ReadRowsOption {
+	return func(s *readRowsSettings) {
+		s.executeOptions = append(s.executeOptions, opts...)
+	}
+}
+
+// ReadRowsByKeys reads rows of table with given keys in single query and returns matched rows keyed by input key.
+// Rows for missing keys are absent from result map.
+//
+// All keys must have the same key columns. Rows contain requested columns and key columns
+// (all columns if columns is empty). Relative table path is normalized with table path prefix
+// (see WithReadRowsTablePathPrefix).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadRowsByKeys(
+	ctx context.Context, tx TxActor, table string, keys []KeyTuple, columns []string, opts ...ReadRowsOption,
+) (map[KeyTuple]Row, error) {
+	if table == "" {
+		return nil, xerrors.WithStackTrace(errEmptyReadRowsTableArg)
+	}
+
+	rows := make(map[KeyTuple]Row, len(keys))
+	if len(keys) == 0 {
+		return rows, nil
+	}
+
+	var settings readRowsSettings
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	sql, keysParams, err := readRowsQuery(settings.tablePathPrefix.NormalizePath(table), keys, columns)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	rs, err := tx.QueryResultSet(ctx, sql,
+		append([]ExecuteOption{WithParameters(keysParams)}, settings.executeOptions...)...,
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = rs.Close(ctx)
+	}()
+
+	requested := make(map[KeyTuple]struct{}, len(keys))
+	for _, k := range keys {
+		requested[k] = struct{}{}
+	}
+
+	keyColumns := keys[0].Columns()
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		k, err := rowKey(row, keyColumns)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		if _, has := requested[k]; !has {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s (key values must have types of key columns)",
+				errUnexpectedRowKey, k,
+			))
+		}
+
+		rows[k] = row
+	}
+}
+
+// readRowsQuery makes query which reads rows of table by keys. Keys are passed as $keys parameter,
+// so query text depends only on table, columns and key columns
+func readRowsQuery(table string, keys []KeyTuple, columns []string) (string, *params.Params, error) {
+	keyColumns := keys[0].Columns()
+	if len(keyColumns) == 0 {
+		return "", nil, xerrors.WithStackTrace(errEmptyKeyTuple)
+	}
+	for _, k := range keys[1:] {
+		if k.columns != keys[0].columns {
+			return "", nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q and %q", errMismatchedKeyColumns,
+				keyColumns, k.Columns(),
+			))
+		}
+	}
+	if err := checkIdentifier(table); err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+	for _, name := range append(append([]string{}, columns...), keyColumns...) {
+		if err := checkIdentifier(name); err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	values := make([]value.Value, len(keys))
+	for i, k := range keys {
+		if len(keyColumns) == 1 {
+			values[i] = k.items()[0]
+		} else {
+			values[i] = k.tuple()
+		}
+	}
+	for _, v := range values[1:] {
+		if !types.Equal(v.Type(), values[0].Type()) {
+			return "", nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s and %s", errMixedKeyTypes,
+				values[0].Type().Yql(), v.Type().Yql(),
+			))
+		}
+	}
+	keysParam := params.Named(readRowsKeysParam, value.ListValue(values...))
+
+	var sb strings.Builder
+	sb.WriteString("DECLARE " + keysParam.Name() + " AS " + keysParam.Value().Type().Yql() + ";\n\n")
+	sb.WriteString("SELECT ")
+	if len(columns) == 0 {
+		sb.WriteString("*")
+	} else {
+		selected := make(map[string]struct{}, len(columns)+len(keyColumns))
+		for _, name := range append(append([]string{}, columns...), keyColumns...) {
+			if _, has := selected[name]; has {
+				continue
+			}
+			if len(selected) > 0 {
+				sb.WriteString(", ")
+			}
+			selected[name] = struct{}{}
+			sb.WriteString("`" + name + "`")
+		}
+	}
+	sb.WriteString("\nFROM `" + table + "`\nWHERE ")
+	if len(keyColumns) == 1 {
+		sb.WriteString("`" + keyColumns[0] + "`")
+	} else {
+		sb.WriteString("(")
+		for i, name := range keyColumns {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("`" + name + "`")
+		}
+		sb.WriteString(")")
+	}
+	sb.WriteString(" IN " + keysParam.Name() + ";")
+
+	return sb.String(), &params.Params{keysParam}, nil
+}
+
+func rowKey(row Row, keyColumns []string) (KeyTuple, error) {
+	values := make([]value.Value, len(keyColumns))
+	dst := make([]NamedDestination, len(keyColumns))
+	for i, name := range keyColumns {
+		dst[i] = Named(name, &values[i])
+	}
+	if err := row.ScanNamed(dst...); err != nil {
+		return KeyTuple{}, xerrors.WithStackTrace(err)
+	}
+
+	columns := make([]KeyTupleColumn, len(keyColumns))
+	for i, name := range keyColumns {
+		columns[i] = KeyColumn(name, values[i])
+	}
+
+	return Key(columns...), nil
+}
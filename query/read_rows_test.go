@@ -0,0 +1,236 @@
+package query
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	readRowsTx struct {
+		TxActor
+
+		sql  string
+		rows []Row
+	}
+	readRowsResultSet struct {
+		ClosableResultSet
+
+		rows []Row
+	}
+	readRowsRow struct {
+		Row
+
+		values map[string]value.Value
+	}
+)
+
+func (tx *readRowsTx) QueryResultSet(_ context.Context, sql string, _ ...ExecuteOption) (ClosableResultSet, error) {
+	tx.sql = sql
+
+	return &readRowsResultSet{rows: tx.rows}, nil
+}
+
+func (rs *readRowsResultSet) NextRow(context.Context) (Row, error) {
+	if len(rs.rows) == 0 {
+		return nil, xerrors.WithStackTrace(io.EOF)
+	}
+	row := rs.rows[0]
+	rs.rows = rs.rows[1:]
+
+	return row, nil
+}
+
+func (rs *readRowsResultSet) Close(context.Context) error {
+	return nil
+}
+
+func (r readRowsRow) ScanNamed(dst ...NamedDestination) error {
+	for _, d := range dst {
+		if err := value.CastTo(r.values[d.Name()], d.Ref()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestReadRowsQuery(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		table   string
+		keys    []KeyTuple
+		columns []string
+		sql     string
+		keysYQL string
+		err     error
+	}{
+		{
+			name: "SingleColumnKey",
+			keys: []KeyTuple{
+				Key(KeyColumn("id", value.Uint64Value(1))),
+				Key(KeyColumn("id", value.Uint64Value(2))),
+			},
+			columns: []string{"value"},
+			sql: "DECLARE $keys AS List<Uint64>;\n\n" +
+				"SELECT `value`, `id`\nFROM `/local/series`\nWHERE `id` IN $keys;",
+			keysYQL: "[1ul,2ul]",
+		},
+		{
+			name: "CompositeKey",
+			keys: []KeyTuple{
+				Key(KeyColumn("id", value.Uint64Value(1)), KeyColumn("name", value.TextValue("a"))),
+				Key(KeyColumn("id", value.Uint64Value(2)), KeyColumn("name", value.TextValue("b"))),
+			},
+			columns: []string{"name", "value"},
+			sql: "DECLARE $keys AS List<Tuple<Uint64,Utf8>>;\n\n" +
+				"SELECT `name`, `value`, `id`\nFROM `/local/series`\nWHERE (`id`, `name`) IN $keys;",
+			keysYQL: `[(1ul,"a"u),(2ul,"b"u)]`,
+		},
+		{
+			name: "AllColumns",
+			keys: []KeyTuple{
+				Key(KeyColumn("id", value.OptionalValue(value.Uint64Value(1)))),
+			},
+			sql:     "DECLARE $keys AS List<Uint64>;\n\nSELECT *\nFROM `/local/series`\nWHERE `id` IN $keys;",
+			keysYQL: "[1ul]",
+		},
+		{
+			name: "MismatchedKeyColumns",
+			keys: []KeyTuple{
+				Key(KeyColumn("id", value.Uint64Value(1))),
+				Key(KeyColumn("name", value.TextValue("a"))),
+			},
+			err: errMismatchedKeyColumns,
+		},
+		{
+			name: "MixedKeyTypes",
+			keys: []KeyTuple{
+				Key(KeyColumn("id", value.Uint64Value(1))),
+				Key(KeyColumn("id", value.Int32Value(2))),
+			},
+			err: errMixedKeyTypes,
+		},
+		{
+			name: "EmptyKey",
+			keys: []KeyTuple{Key()},
+			err:  errEmptyKeyTuple,
+		},
+		{
+			name:  "WrongTable",
+			table: "/local/series` WHERE 1 = 1; DROP TABLE `users",
+			keys:  []KeyTuple{Key(KeyColumn("id", value.Uint64Value(1)))},
+			err:   errWrongIdentifier,
+		},
+		{
+			name:    "WrongColumn",
+			keys:    []KeyTuple{Key(KeyColumn("id", value.Uint64Value(1)))},
+			columns: []string{"value`, `secret"},
+			err:     errWrongIdentifier,
+		},
+		{
+			name: "WrongKeyColumn",
+			keys: []KeyTuple{Key(KeyColumn("id` IN (1); --", value.Uint64Value(1)))},
+			err:  errWrongIdentifier,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			table := tt.table
+			if table == "" {
+				table = "/local/series"
+			}
+			sql, keysParams, err := readRowsQuery(table, tt.keys, tt.columns)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.sql, sql)
+			require.Equal(t, 1, keysParams.Count())
+			keysParams.Each(func(name string, v value.Value) {
+				require.Equal(t, "$keys", name)
+				require.Equal(t, tt.keysYQL, v.Yql())
+			})
+		})
+	}
+}
+
+func TestReadRowsQueryTextDoesNotDependOnKeys(t *testing.T) {
+	sql1, _, err := readRowsQuery("/local/series", []KeyTuple{
+		Key(KeyColumn("id", value.Uint64Value(1))),
+	}, nil)
+	require.NoError(t, err)
+	sql2, _, err := readRowsQuery("/local/series", []KeyTuple{
+		Key(KeyColumn("id", value.Uint64Value(2))),
+		Key(KeyColumn("id", value.Uint64Value(3))),
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, sql1, sql2)
+}
+
+func TestKeyTuple(t *testing.T) {
+	k := Key(KeyColumn("id", value.OptionalValue(value.Uint64Value(1))), KeyColumn("name", value.TextValue("a")))
+	require.Equal(t, Key(KeyColumn("id", value.Uint64Value(1)), KeyColumn("name", value.TextValue("a"))), k)
+	require.NotEqual(t, Key(KeyColumn("id", value.Uint64Value(2)), KeyColumn("name", value.TextValue("a"))), k)
+	require.Equal(t, []string{"id", "name"}, k.Columns())
+	require.Equal(t, `(1ul,"a"u)`, k.String())
+}
+
+func TestReadRowsByKeys(t *testing.T) {
+	ctx := context.Background()
+	seeded := func(id uint64, name string, v string) Row {
+		return readRowsRow{values: map[string]value.Value{
+			"id":    value.OptionalValue(value.Uint64Value(id)),
+			"name":  value.OptionalValue(value.TextValue(name)),
+			"value": value.OptionalValue(value.TextValue(v)),
+		}}
+	}
+	key := func(id uint64, name string) KeyTuple {
+		return Key(KeyColumn("id", value.Uint64Value(id)), KeyColumn("name", value.TextValue(name)))
+	}
+	t.Run("PresentAndAbsentKeys", func(t *testing.T) {
+		tx := &readRowsTx{rows: []Row{
+			seeded(1, "a", "1a"),
+			seeded(3, "c", "3c"),
+		}}
+		rows, err := ReadRowsByKeys(ctx, tx, "series", []KeyTuple{
+			key(1, "a"), key(2, "b"), key(3, "c"), key(4, "d"),
+		}, []string{"value"}, WithReadRowsTablePathPrefix("/local/prefix"))
+		require.NoError(t, err)
+		require.Contains(t, tx.sql, "FROM `/local/prefix/series`")
+		require.Len(t, rows, 2)
+		require.NotContains(t, rows, key(2, "b"))
+		require.NotContains(t, rows, key(4, "d"))
+		for k, exp := range map[KeyTuple]string{
+			key(1, "a"): "1a",
+			key(3, "c"): "3c",
+		} {
+			require.Contains(t, rows, k)
+			var v string
+			require.NoError(t, rows[k].ScanNamed(Named("value", &v)))
+			require.Equal(t, exp, v)
+		}
+	})
+	t.Run("NoKeys", func(t *testing.T) {
+		tx := &readRowsTx{}
+		rows, err := ReadRowsByKeys(ctx, tx, "/local/series", nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, rows)
+		require.Empty(t, tx.sql)
+	})
+	t.Run("KeyTypeMismatch", func(t *testing.T) {
+		tx := &readRowsTx{rows: []Row{
+			seeded(1, "a", "1a"),
+		}}
+		_, err := ReadRowsByKeys(ctx, tx, "/local/series", []KeyTuple{
+			Key(KeyColumn("id", value.Int32Value(1)), KeyColumn("name", value.TextValue("a"))),
+		}, nil)
+		require.ErrorIs(t, err, errUnexpectedRowKey)
+	})
+}
@@ -1,6 +1,8 @@
 package query
 
 import (
+	"errors"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
@@ -11,15 +13,48 @@ type (
 	ResultSet         = result.Set
 	ClosableResultSet = result.ClosableResultSet
 	Row               = result.Row
+	Column            = result.Column
 	Type              = types.Type
 	NamedDestination  = scanner.NamedDestination
 	ScanStructOption  = scanner.ScanStructOption
 )
 
+type (
+	// RowsAffectedResult is an optional interface of Result with number of rows changed by query:
+	//
+	//	if r, ok := res.(query.RowsAffectedResult); ok {
+	//		rowsAffected, err := r.RowsAffected()
+	//	}
+	RowsAffectedResult = result.RowsAffectedResult
+	// CancelableResult is an optional interface of Result which may be canceled before reading to the end
+	CancelableResult = result.CancelableResult
+)
+
+// ErrNoRow returns by QueryRow with WithOptionalRow option if result has no rows
+var ErrNoRow = errors.New("no row in result")
+
+// ErrResultCanceled returns by reading of result after CancelableResult.Cancel call
+var ErrResultCanceled = errors.New("result is canceled")
+
+// NoRowError returns by QueryRow with WithRowMustExist option if result has no rows
+type NoRowError struct{}
+
+func (NoRowError) Error() string {
+	return "expected exactly one row, but result has no rows"
+}
+
 func Named(columnName string, destinationValueReference interface{}) (dst NamedDestination) {
 	return scanner.NamedRef(columnName, destinationValueReference)
 }
 
+// ScanStruct scans row into struct dst
+//
+// Columns are mapped to struct fields by tag (sql tag by default or ydb tag as fallback) or by field name
+// (case-insensitive). Optional columns are mapped to pointer fields.
+func ScanStruct(row Row, dst interface{}, opts ...ScanStructOption) error {
+	return row.ScanStruct(dst, opts...)
+}
+
 func WithScanStructTagName(name string) ScanStructOption {
 	return scanner.WithTagName(name)
 }
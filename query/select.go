@@ -0,0 +1,294 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	errEmptySelectTableArg = errors.New("table path is empty")
+	errWrongIdentifier     = errors.New("wrong identifier")
+	errEmptyPredicate      = errors.New("empty predicate")
+	errMixedInValueTypes   = errors.New("values of IN predicate have different types")
+)
+
+type (
+	// Predicate is a condition of WHERE clause of BuildSelect made by Eq, In, Range, And and Or.
+	// Values of predicates are passed as query parameters, never as literals of query text
+	Predicate interface {
+		appendYQL(q *selectQuery, sb *strings.Builder) error
+	}
+	eqPredicate struct {
+		column string
+		value  value.Value
+	}
+	inPredicate struct {
+		column string
+		values []value.Value
+	}
+	rangePredicate struct {
+		column   string
+		from, to value.Value
+	}
+	logicalPredicate struct {
+		operator   string
+		predicates []Predicate
+	}
+	selectSettings struct {
+		tablePathPrefix bind.TablePathPrefix
+		orderBy         []string
+		limit           uint64
+	}
+	// SelectOption is an option for BuildSelect
+	SelectOption func(s *selectSettings)
+	selectQuery  struct {
+		params params.Params
+	}
+)
+
+// Eq makes predicate `column = v`
+func Eq(column string, v value.Value) Predicate {
+	return eqPredicate{
+		column: column,
+		value:  v,
+	}
+}
+
+// In makes predicate `column IN (values...)`. All values must have the same type
+func In(column string, values ...value.Value) Predicate {
+	return inPredicate{
+		column: column,
+		values: values,
+	}
+}
+
+// Range makes predicate `from <= column AND column < to`. Nil bound is omitted,
+// so Range(column, from, nil) makes predicate `column >= from`
+func Range(column string, from, to value.Value) Predicate {
+	return rangePredicate{
+		column: column,
+		from:   from,
+		to:     to,
+	}
+}
+
+// And makes conjunction of predicates
+func And(predicates ...Predicate) Predicate {
+	return logicalPredicate{
+		operator:   "AND",
+		predicates: predicates,
+	}
+}
+
+// Or makes disjunction of predicates
+func Or(predicates ...Predicate) Predicate {
+	return logicalPredicate{
+		operator:   "OR",
+		predicates: predicates,
+	}
+}
+
+// WithSelectTablePathPrefix defines prefix for relative table path of BuildSelect
+func WithSelectTablePathPrefix(tablePathPrefix string) SelectOption {
+	return func(s *selectSettings) {
+		s.tablePathPrefix = bind.TablePathPrefix(tablePathPrefix)
+	}
+}
+
+// WithSelectOrderBy defines columns of ORDER BY clause of BuildSelect
+func WithSelectOrderBy(columns ...string) SelectOption {
+	return func(s *selectSettings) {
+		s.orderBy = append(s.orderBy, columns...)
+	}
+}
+
+// WithSelectLimit defines LIMIT of BuildSelect (passed as query parameter)
+func WithSelectLimit(limit uint64) SelectOption {
+	return func(s *selectSettings) {
+		s.limit = limit
+	}
+}
+
+// BuildSelect makes parameterized YQL query which selects columns of table (all columns if columns is empty)
+// with rows filtered by where (nil where selects all rows). Relative table path is normalized with table
+// path prefix (see WithSelectTablePathPrefix).
+//
+// All values of predicates are passed as declared query parameters, so the query text contains only
+// quoted identifiers and parameter names. Query text and parameters can be executed as
+//
+//	sql, params, err := query.BuildSelect("users", []string{"id", "name"}, query.Eq("id", types.Uint64Value(1)))
+//	...
+//	rs, err := s.QueryResultSet(ctx, sql, query.WithParameters(params))
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BuildSelect(table string, columns []string, where Predicate, opts ...SelectOption) (
+	sql string, _ *params.Params, err error,
+) {
+	if table == "" {
+		return "", nil, xerrors.WithStackTrace(errEmptySelectTableArg)
+	}
+
+	var settings selectSettings
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	table = settings.tablePathPrefix.NormalizePath(table)
+	if err = checkIdentifier(table); err != nil {
+		return "", nil, xerrors.WithStackTrace(err)
+	}
+
+	var (
+		q  selectQuery
+		sb strings.Builder
+	)
+	sb.WriteString("SELECT ")
+	if len(columns) == 0 {
+		sb.WriteString("*")
+	}
+	for i, column := range columns {
+		if err = checkIdentifier(column); err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("`" + column + "`")
+	}
+	sb.WriteString("\nFROM `" + table + "`")
+	if where != nil {
+		sb.WriteString("\nWHERE ")
+		if err = where.appendYQL(&q, &sb); err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+	}
+	for i, column := range settings.orderBy {
+		if err = checkIdentifier(column); err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+		if i == 0 {
+			sb.WriteString("\nORDER BY ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("`" + column + "`")
+	}
+	if settings.limit > 0 {
+		sb.WriteString("\nLIMIT " + q.param(value.Uint64Value(settings.limit)))
+	}
+	sb.WriteString(";")
+
+	var declares strings.Builder
+	for _, p := range q.params {
+		declares.WriteString("DECLARE " + p.Name() + " AS " + p.Value().Type().Yql() + ";\n")
+	}
+	if declares.Len() > 0 {
+		declares.WriteString("\n")
+	}
+
+	return declares.String() + sb.String(), &q.params, nil
+}
+
+// param adds query parameter with value v and returns name of parameter
+func (q *selectQuery) param(v value.Value) string {
+	name := "$p" + strconv.Itoa(len(q.params))
+	q.params = append(q.params, params.Named(name, v))
+
+	return name
+}
+
+// checkIdentifier checks that name can be quoted with backticks as is
+func checkIdentifier(name string) error {
+	if name == "" || strings.ContainsAny(name, "`\\") || strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %q", errWrongIdentifier, name))
+	}
+
+	return nil
+}
+
+func (p eqPredicate) appendYQL(q *selectQuery, sb *strings.Builder) error {
+	if err := checkIdentifier(p.column); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if p.value == nil {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: Eq(%q) without value", errEmptyPredicate, p.column))
+	}
+
+	sb.WriteString("`" + p.column + "` = " + q.param(p.value))
+
+	return nil
+}
+
+func (p inPredicate) appendYQL(q *selectQuery, sb *strings.Builder) error {
+	if err := checkIdentifier(p.column); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if len(p.values) == 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: In(%q) without values", errEmptyPredicate, p.column))
+	}
+	for _, v := range p.values[1:] {
+		if !types.Equal(v.Type(), p.values[0].Type()) {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %s and %s", errMixedInValueTypes,
+				p.values[0].Type().Yql(), v.Type().Yql(),
+			))
+		}
+	}
+
+	sb.WriteString("`" + p.column + "` IN " + q.param(value.ListValue(p.values...)))
+
+	return nil
+}
+
+func (p rangePredicate) appendYQL(q *selectQuery, sb *strings.Builder) error {
+	if err := checkIdentifier(p.column); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if p.from == nil && p.to == nil {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: Range(%q) without bounds", errEmptyPredicate, p.column))
+	}
+
+	if p.from != nil {
+		sb.WriteString("`" + p.column + "` >= " + q.param(p.from))
+	}
+	if p.from != nil && p.to != nil {
+		sb.WriteString(" AND ")
+	}
+	if p.to != nil {
+		sb.WriteString("`" + p.column + "` < " + q.param(p.to))
+	}
+
+	return nil
+}
+
+func (p logicalPredicate) appendYQL(q *selectQuery, sb *strings.Builder) error {
+	if len(p.predicates) == 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s without predicates", errEmptyPredicate, p.operator))
+	}
+
+	for i, predicate := range p.predicates {
+		if predicate == nil {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: nil predicate in %s", errEmptyPredicate, p.operator))
+		}
+		if i > 0 {
+			sb.WriteString(" " + p.operator + " ")
+		}
+		sb.WriteString("(")
+		if err := predicate.appendYQL(q, sb); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		sb.WriteString(")")
+	}
+
+	return nil
+}
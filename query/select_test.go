@@ -0,0 +1,134 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestBuildSelect(t *testing.T) {
+	const injection = "'; DROP TABLE users; --"
+	for _, tt := range []struct {
+		name    string
+		table   string
+		columns []string
+		where   Predicate
+		opts    []SelectOption
+		sql     string
+		params  map[string]string
+	}{
+		{
+			name:  "AllRows",
+			table: "users",
+			sql:   "SELECT *\nFROM `users`;",
+		},
+		{
+			name:    "Eq",
+			table:   "users",
+			columns: []string{"id", "name"},
+			where:   Eq("name", value.TextValue(injection)),
+			sql: "DECLARE $p0 AS Utf8;\n\n" +
+				"SELECT `id`, `name`\nFROM `users`\nWHERE `name` = $p0;",
+			params: map[string]string{"$p0": `"'; DROP TABLE users; --"u`},
+		},
+		{
+			name:  "In",
+			table: "users",
+			where: In("id", value.Uint64Value(1), value.Uint64Value(2)),
+			sql: "DECLARE $p0 AS List<Uint64>;\n\n" +
+				"SELECT *\nFROM `users`\nWHERE `id` IN $p0;",
+			params: map[string]string{"$p0": "[1ul,2ul]"},
+		},
+		{
+			name:  "Range",
+			table: "users",
+			where: Range("age", value.Int32Value(18), value.Int32Value(65)),
+			sql: "DECLARE $p0 AS Int32;\nDECLARE $p1 AS Int32;\n\n" +
+				"SELECT *\nFROM `users`\nWHERE `age` >= $p0 AND `age` < $p1;",
+			params: map[string]string{"$p0": "18", "$p1": "65"},
+		},
+		{
+			name:  "OpenRange",
+			table: "users",
+			where: Range("age", nil, value.Int32Value(65)),
+			sql: "DECLARE $p0 AS Int32;\n\n" +
+				"SELECT *\nFROM `users`\nWHERE `age` < $p0;",
+			params: map[string]string{"$p0": "65"},
+		},
+		{
+			name:    "Combined",
+			table:   "users",
+			columns: []string{"id"},
+			where: And(
+				Or(
+					Eq("name", value.TextValue(injection)),
+					In("id", value.Uint64Value(1)),
+				),
+				Range("age", value.Int32Value(18), nil),
+			),
+			opts: []SelectOption{
+				WithSelectTablePathPrefix("/local/db"),
+				WithSelectOrderBy("id", "age"),
+				WithSelectLimit(10),
+			},
+			sql: "DECLARE $p0 AS Utf8;\nDECLARE $p1 AS List<Uint64>;\nDECLARE $p2 AS Int32;\nDECLARE $p3 AS Uint64;\n\n" +
+				"SELECT `id`\nFROM `/local/db/users`\n" +
+				"WHERE ((`name` = $p0) OR (`id` IN $p1)) AND (`age` >= $p2)\n" +
+				"ORDER BY `id`, `age`\nLIMIT $p3;",
+			params: map[string]string{"$p0": `"'; DROP TABLE users; --"u`, "$p1": "[1ul]", "$p2": "18", "$p3": "10ul"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := BuildSelect(tt.table, tt.columns, tt.where, tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tt.sql, sql)
+			require.NotContains(t, sql, injection)
+			require.NotContains(t, sql, "DROP")
+			actual := make(map[string]string, params.Count())
+			params.Each(func(name string, v value.Value) {
+				actual[name] = v.Yql()
+			})
+			if tt.params == nil {
+				require.Empty(t, actual)
+			} else {
+				require.Equal(t, tt.params, actual)
+			}
+		})
+	}
+}
+
+func TestBuildSelectErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		table   string
+		columns []string
+		where   Predicate
+		err     error
+	}{
+		{name: "EmptyTable", err: errEmptySelectTableArg},
+		{name: "WrongTable", table: "users` WHERE 1=1; --", err: errWrongIdentifier},
+		{name: "WrongColumn", table: "users", columns: []string{"id`"}, err: errWrongIdentifier},
+		{
+			name:  "WrongPredicateColumn",
+			table: "users",
+			where: Eq("id` = 1 OR `id", value.Uint64Value(1)),
+			err:   errWrongIdentifier,
+		},
+		{name: "EmptyIn", table: "users", where: In("id"), err: errEmptyPredicate},
+		{name: "EmptyRange", table: "users", where: Range("id", nil, nil), err: errEmptyPredicate},
+		{name: "EmptyAnd", table: "users", where: And(), err: errEmptyPredicate},
+		{
+			name:  "MixedInTypes",
+			table: "users",
+			where: In("id", value.Uint64Value(1), value.TextValue("2")),
+			err:   errMixedInValueTypes,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := BuildSelect(tt.table, tt.columns, tt.where)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -2,21 +2,64 @@ package query
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	internal "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 )
 
+// LazyTxID is an ID of transaction which begin was deferred until the first query
+const LazyTxID = tx.LazyTxID
+
+// ErrNestedTxIsolation returns by nested DoTx call if it requests stronger isolation than outer transaction
+var ErrNestedTxIsolation = errors.New("nested transaction requires stronger isolation than outer transaction")
+
+// ErrCommitOutcomeUnknown returns by CommitTx if context was done before server responds to commit.
+// Transaction may be committed or not, so the caller must not consider it as failed
+var ErrCommitOutcomeUnknown = errors.New("commit outcome is unknown: context done before server response")
+
+// TxStatementError returns by Transaction.RunAll if some statement fails.
+// TxStatementError is reachable with errors.As and wraps error of statement
+type TxStatementError struct {
+	// Index is an index of failed statement in queries of RunAll
+	Index int
+	Err   error
+}
+
+func (e *TxStatementError) Error() string {
+	return fmt.Sprintf("statement #%d failed: %v", e.Index, e.Err)
+}
+
+func (e *TxStatementError) Unwrap() error {
+	return e.Err
+}
+
 type (
 	TxActor interface {
 		tx.Identifier
 		Executor
+
+		// ExecuteBatch executes statements in order and returns materialized result for each statement
+		//
+		// Statement without result sets (such as UPSERT) has result without result sets, so index of result
+		// always matches index of statement. If some statement fails, ExecuteBatch returns results of
+		// the previous statements and the error. Commit option (if any) applies to the last statement only.
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		ExecuteBatch(ctx context.Context, queries []string, opts ...ExecuteOption) ([]Result, error)
 	}
 	Transaction interface {
 		TxActor
 
 		CommitTx(ctx context.Context) (err error)
 		Rollback(ctx context.Context) (err error)
+
+		// RunAll executes statements one by one in the transaction and commits transaction on success.
+		//
+		// If some statement fails, RunAll rolls back transaction and returns *TxStatementError with
+		// index of failed statement. Commit error returns as is. Commit option (if any) is ignored.
+		RunAll(ctx context.Context, queries []string, opts ...ExecuteOption) error
 	}
 	TransactionControl  = internal.Control
 	TransactionSettings = internal.Settings
@@ -105,6 +148,22 @@ func WithStaleReadOnly() TransactionOption {
 	return internal.WithStaleReadOnly()
 }
 
+// WithLazyBegin defers begin of transaction until the first query in transaction.
+// Begin flag attaches to request of the first query, so no separated round-trip to server happens.
+// Until the first query ID of transaction returns sentinel value LazyTxID
+func WithLazyBegin() TransactionOption {
+	return internal.WithLazyBegin()
+}
+
+// WithCommitRetry makes CommitTx retry commit up to n times if commit request wasn't sent
+// (no available endpoints) or was rejected by server before processing (overloaded server).
+// Such errors keep transaction alive, so commit of the same transaction is safe. Other errors
+// (for example ABORTED, UNDETERMINED or TIMEOUT) are returned immediately because transaction
+// is already aborted or may be committed. Each retry acquires quota from retry budget of client
+func WithCommitRetry(n int) TransactionOption {
+	return internal.WithCommitRetry(n)
+}
+
 func WithInconsistentReads() internal.OnlineReadOnlyOption {
 	return internal.WithInconsistentReads()
 }
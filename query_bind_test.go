@@ -579,3 +579,66 @@ SELECT $param1, $param2`,
 		})
 	}
 }
+
+func TestQueryBindContainers(t *testing.T) {
+	b := testutil.QueryBind(ydb.WithAutoDeclare())
+	for _, tt := range []struct {
+		name string
+		list []int32
+		yql  string
+	}{
+		{
+			name: "List",
+			list: []int32{1, 2, 3},
+			yql:  "[1,2,3]",
+		},
+		{
+			name: "EmptyList",
+			list: []int32{},
+			yql:  "[]",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			yql, parameters, err := b.ToYdb("SELECT x FROM my_table WHERE x IN $list;", sql.Named("list", tt.list))
+			require.NoError(t, err)
+			require.Equal(t, `-- bind declares
+DECLARE $list AS List<Int32>;
+
+SELECT x FROM my_table WHERE x IN $list;`, yql)
+			require.Len(t, parameters, 1)
+			require.Equal(t, "$list", parameters[0].Name())
+			require.Equal(t, tt.yql, parameters[0].Value().Yql())
+
+			var list []int32
+			require.NoError(t, types.ContainerScanner(&list).Scan(parameters[0].Value()))
+			require.Equal(t, tt.list, list)
+		})
+	}
+	t.Run("Dict", func(t *testing.T) {
+		_, parameters, err := b.ToYdb("SELECT $dict;", sql.Named("dict", map[string]int32{"a": 1}))
+		require.NoError(t, err)
+		require.Equal(t, "Dict<Utf8,Int32>", parameters[0].Value().Type().Yql())
+
+		var dict map[string]int32
+		require.NoError(t, types.ContainerScanner(&dict).Scan(parameters[0].Value()))
+		require.Equal(t, map[string]int32{"a": 1}, dict)
+	})
+	t.Run("Struct", func(t *testing.T) {
+		type row struct {
+			ID   int32  `sql:"id"`
+			Name string `sql:"name"`
+		}
+		_, parameters, err := b.ToYdb("SELECT $row;", sql.Named("row", row{ID: 1, Name: "a"}))
+		require.NoError(t, err)
+		require.Equal(t, "Struct<'id':Int32,'name':Utf8>", parameters[0].Value().Type().Yql())
+
+		var r row
+		require.NoError(t, types.ContainerScanner(&r).Scan(parameters[0].Value()))
+		require.Equal(t, row{ID: 1, Name: "a"}, r)
+	})
+	t.Run("Null", func(t *testing.T) {
+		list := []int32{1}
+		require.NoError(t, types.ContainerScanner(&list).Scan(nil))
+		require.Nil(t, list)
+	})
+}
@@ -3,6 +3,8 @@ package budget
 import (
 	"context"
 	"fmt"
+	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/jonboulle/clockwork"
@@ -17,7 +19,31 @@ type (
 		// Acquire will called on second and subsequent retry attempts
 		Acquire(ctx context.Context) error
 	}
+	// Observable is an optional interface of Budget which exposes usage of budget
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Observable interface {
+		Budget
+
+		// Remaining returns amount of budget which is available right now
+		Remaining() float64
+		// Stats returns counters of granted and denied acquisitions
+		Stats() Stats
+	}
+	// Stats is a snapshot of budget usage counters
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Stats struct {
+		Granted uint64
+		Denied  uint64
+	}
+	stats struct {
+		granted atomic.Uint64
+		denied  atomic.Uint64
+	}
 	fixedBudget struct {
+		stats
+
 		clock  clockwork.Clock
 		ticker clockwork.Ticker
 		quota  chan struct{}
@@ -25,11 +51,36 @@ type (
 	}
 	fixedBudgetOption func(q *fixedBudget)
 	percentBudget     struct {
+		stats
+
 		percent int
 		rand    xrand.Rand
 	}
 )
 
+var (
+	_ Observable = (*fixedBudget)(nil)
+	_ Observable = (*percentBudget)(nil)
+)
+
+func (s *stats) track(err error) error {
+	if err != nil {
+		s.denied.Add(1)
+	} else {
+		s.granted.Add(1)
+	}
+
+	return err
+}
+
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (s *stats) Stats() Stats {
+	return Stats{
+		Granted: s.granted.Load(),
+		Denied:  s.denied.Load(),
+	}
+}
+
 func withFixedBudgetClock(clock clockwork.Clock) fixedBudgetOption {
 	return func(q *fixedBudget) {
 		q.clock = clock
@@ -84,6 +135,21 @@ func (q *fixedBudget) Stop() {
 
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func (q *fixedBudget) Acquire(ctx context.Context) error {
+	return q.track(q.acquire(ctx))
+}
+
+// Remaining returns count of attempts which can be acquired without waiting
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (q *fixedBudget) Remaining() float64 {
+	if q.ticker == nil {
+		return math.Inf(1)
+	}
+
+	return float64(len(q.quota))
+}
+
+func (q *fixedBudget) acquire(ctx context.Context) error {
 	if err := ctx.Err(); err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -111,8 +177,15 @@ func Percent(percent int) *percentBudget {
 
 func (b *percentBudget) Acquire(ctx context.Context) error {
 	if b.rand.Int(100) < b.percent { //nolint:gomnd
-		return nil
+		return b.track(nil)
 	}
 
-	return ErrNoQuota
+	return b.track(ErrNoQuota)
+}
+
+// Remaining returns probability of successful acquisition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (b *percentBudget) Remaining() float64 {
+	return float64(b.percent) / 100 //nolint:gomnd
 }
@@ -2,6 +2,7 @@ package budget
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
@@ -70,3 +71,41 @@ func TestPercent(t *testing.T) {
 		require.LessOrEqual(t, success, int(float64(total)*(percent+0.1*percent)))
 	}, xtest.StopAfter(5*time.Second))
 }
+
+func TestLimitedStats(t *testing.T) {
+	ctx := xtest.Context(t)
+	clock := clockwork.NewFakeClock()
+	q := Limited(2, withFixedBudgetClock(clock))
+	defer q.Stop()
+	require.Equal(t, float64(2), q.Remaining())
+	require.NoError(t, q.Acquire(ctx))
+	require.NoError(t, q.Acquire(ctx))
+	require.Zero(t, q.Remaining())
+
+	timeoutCtx, cancel := xcontext.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, q.Acquire(timeoutCtx), context.DeadlineExceeded)
+	require.Equal(t, Stats{Granted: 2, Denied: 1}, q.Stats())
+}
+
+func TestUnlimitedStats(t *testing.T) {
+	q := Limited(-1)
+	require.True(t, math.IsInf(q.Remaining(), 1))
+	require.NoError(t, q.Acquire(xtest.Context(t)))
+	require.Equal(t, Stats{Granted: 1}, q.Stats())
+}
+
+func TestPercentStats(t *testing.T) {
+	ctx := xtest.Context(t)
+	var b Observable = Percent(0)
+	for i := 0; i < 10; i++ {
+		require.ErrorIs(t, b.Acquire(ctx), ErrNoQuota)
+	}
+	require.Equal(t, Stats{Denied: 10}, b.Stats())
+	require.Zero(t, b.Remaining())
+
+	b = Percent(100)
+	require.NoError(t, b.Acquire(ctx))
+	require.Equal(t, Stats{Granted: 1}, b.Stats())
+	require.Equal(t, float64(1), b.Remaining())
+}
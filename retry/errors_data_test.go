@@ -33,6 +33,9 @@ var errsToCheck = []struct {
 	backoff       backoff.Type // no backoff (=== no operationStatus), fast backoff, slow backoff
 	deleteSession bool         // close session and delete from pool
 	canRetry      map[idempotency]bool
+	// canRetryInLoop overrides canRetry for retry loops, which retry some errors with alive
+	// caller context only (such as server-side operation TIMEOUT)
+	canRetryInLoop map[idempotency]bool
 }{
 	{
 		// retryer given unknown error - we will not operationStatus and will close session
@@ -368,6 +371,10 @@ var errsToCheck = []struct {
 			idempotent:    false,
 			nonIdempotent: false,
 		},
+		canRetryInLoop: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
 	},
 	{
 		err: xerrors.Operation(
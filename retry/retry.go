@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -346,7 +348,21 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 				return v, nil
 			}
 
+			// operation failed due to expiry of caller context, next attempts will fail again
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return zeroValue, xerrors.WithStackTrace(xerrors.Join(
+					fmt.Errorf("attempt No.%d: %w", attempts, ctxErr),
+					err,
+					lastErr,
+				))
+			}
+
 			m := Check(err)
+			if xerrors.IsOperationError(err, Ydb.StatusIds_TIMEOUT) {
+				// server-side operation timeout with alive caller context may be retried by
+				// idempotent operation. Out of retry loop TIMEOUT is still non-retryable error
+				m.errType, m.backoff = xerrors.TypeConditionallyRetryable, backoff.TypeFast
+			}
 
 			if m.StatusCode() != code {
 				i = 0
@@ -16,6 +16,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 )
 
 func TestRetryModes(t *testing.T) {
@@ -168,6 +169,59 @@ func TestRetryTransportCancelled(t *testing.T) {
 	}
 }
 
+func TestRetryDeadlineClassification(t *testing.T) {
+	serverTimeout := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_TIMEOUT))
+	t.Run("CallerDeadline", func(t *testing.T) {
+		counter := 0
+		ctx, cancel := xcontext.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := Retry(ctx, func(ctx context.Context) error {
+			counter++
+			<-ctx.Done()
+
+			return xerrors.Transport(grpcStatus.Error(grpcCodes.DeadlineExceeded, ""))
+		}, WithIdempotent(true))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, 1, counter)
+	})
+	t.Run("ServerTimeout", func(t *testing.T) {
+		counter := 0
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			counter++
+			if counter == 1 {
+				return serverTimeout
+			}
+
+			return nil
+		}, WithIdempotent(true))
+		require.NoError(t, err)
+		require.Equal(t, 2, counter)
+	})
+	t.Run("ServerTimeoutNonIdempotent", func(t *testing.T) {
+		counter := 0
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			counter++
+
+			return serverTimeout
+		}, WithIdempotent(false))
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_TIMEOUT))
+		require.Equal(t, 1, counter)
+	})
+	t.Run("TransportDeadlineWithAliveContext", func(t *testing.T) {
+		counter := 0
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			counter++
+			if counter == 1 {
+				return xerrors.Transport(grpcStatus.Error(grpcCodes.DeadlineExceeded, ""))
+			}
+
+			return nil
+		}, WithIdempotent(true))
+		require.NoError(t, err)
+		require.Equal(t, 2, counter)
+	})
+}
+
 type noQuota struct{}
 
 var errNoQuota = errors.New("no quota")
@@ -188,6 +242,22 @@ func TestRetryWithBudget(t *testing.T) {
 	})
 }
 
+func TestRetryWithBudgetStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+	quota := budget.Percent(0)
+	attempts := 0
+	err := Retry(ctx, func(ctx context.Context) (err error) {
+		attempts++
+
+		return RetryableError(errors.New("custom error"))
+	}, WithBudget(quota))
+	require.ErrorIs(t, err, budget.ErrNoQuota)
+	require.Equal(t, 1, attempts)
+	require.Equal(t, budget.Stats{Granted: 0, Denied: 1}, quota.Stats())
+	require.Zero(t, quota.Remaining())
+}
+
 type MockPanicCallback struct {
 	called   bool
 	received interface{}
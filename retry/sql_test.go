@@ -13,7 +13,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
 )
 
 type mockConnector struct {
@@ -217,7 +217,11 @@ func TestDoTx(t *testing.T) {
 						WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
 						WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
 					)
-					if tt.canRetry[idempotentType] {
+					canRetry := tt.canRetry
+					if tt.canRetryInLoop != nil {
+						canRetry = tt.canRetryInLoop
+					}
+					if canRetry[idempotentType] {
 						require.NoError(t, err)
 						require.NotEmpty(t, attempts)
 						if tt.deleteSession {
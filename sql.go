@@ -5,13 +5,20 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/legacy"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/propose"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -115,8 +122,60 @@ func WithTxControl(ctx context.Context, txc *table.TransactionControl) context.C
 	return legacy.WithTxControl(ctx, txc)
 }
 
+// WithQueryTxControl defines transaction control for standalone (non-transactional) database/sql
+// statements executed over query service, for example
+//
+//	db.QueryContext(ydb.WithQueryTxControl(ctx, query.SnapshotReadOnlyTxControl()), "SELECT ...")
+//
+// Transaction control from WithTxControl is used by legacy (table service) engine only.
+// Standalone statements are autocommitted: transaction control which begins transaction
+// commits it in the same request
+func WithQueryTxControl(ctx context.Context, txc *query.TransactionControl) context.Context {
+	return xsql.WithTxControl(ctx, txc)
+}
+
+// WithStatementTablePathPrefix defines table path prefix for database/sql statements executed with ctx
+// instead of connector table path prefix. Prefix must be an absolute path, for example
+//
+//	db.QueryContext(ydb.WithStatementTablePathPrefix(ctx, "/local/tenant"), "SELECT * FROM users")
+func WithStatementTablePathPrefix(ctx context.Context, tablePathPrefix string) context.Context {
+	return xsql.WithStatementTablePathPrefix(ctx, tablePathPrefix)
+}
+
+// WithStatementTimeArgs defines YDB type of time.Time args for database/sql statements executed with ctx
+// instead of connector settings
+func WithStatementTimeArgs(ctx context.Context, timeArgs TimeArgs) context.Context {
+	return xsql.WithStatementTimeArgs(ctx, timeArgs)
+}
+
+// WithStatementTimeout limits time of database/sql statements executed with ctx independently of ctx deadline.
+// Effective timeout of statement is the minimum of timeout, ctx deadline and connector default
+// (see WithDefaultStatementTimeout)
+func WithStatementTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return xsql.WithStatementTimeout(ctx, timeout)
+}
+
+// WithOnSessionAssigned defines callback which calls with YDB session id of database/sql conn
+// before execution of each statement with ctx. Session id helps to correlate client and server logs
+func WithOnSessionAssigned(ctx context.Context, onSessionAssigned func(sessionID string)) context.Context {
+	return xsql.WithOnSessionAssigned(ctx, onSessionAssigned)
+}
+
 type ConnectorOption = xsql.Option
 
+// ErrConnectorClosed is returned on close or connect with already closed database/sql connector
+var ErrConnectorClosed = xsql.ErrConnectorClosed
+
+// StatementError is an error of database/sql statement which was rejected by server.
+// StatementError contains status code and issues of failed statement and is reachable
+// with errors.As from errors returned by database/sql:
+//
+//	var stmtErr *ydb.StatementError
+//	if errors.As(err, &stmtErr) {
+//		fmt.Println(stmtErr.Code(), stmtErr.Issues())
+//	}
+type StatementError = xsql.StatementError
+
 type QueryBindConnectorOption interface {
 	ConnectorOption
 	bind.Bind
@@ -206,6 +265,81 @@ func WithNumericArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.NumericArgs{})
 }
 
+type PlaceholderStyle = bind.PlaceholderStyle
+
+const (
+	// PlaceholderDollar is a style of numeric placeholders $1, $2, ...
+	PlaceholderDollar = bind.PlaceholderDollar
+	// PlaceholderQuestion is a style of positional placeholders ?
+	PlaceholderQuestion = bind.PlaceholderQuestion
+	// PlaceholderNamed is a style of placeholders @name for sql.Named args and @p1, @p2, ... for positional args
+	PlaceholderNamed = bind.PlaceholderNamed
+)
+
+// WithPlaceholderStyle enables rewriting of query placeholders of style into YDB parameters with
+// declarations bound from query args. Placeholders of other styles in the same query are rejected
+// with error, so query must use single placeholder style
+func WithPlaceholderStyle(style PlaceholderStyle) QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.Placeholders{Style: style})
+}
+
+// WithQueryNormalization enables sending of normalized query text: comments are removed and
+// sequences of whitespaces are replaced with single space. String literals and YQL hints are kept as is.
+// Without option query text is sent as is
+func WithQueryNormalization() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.NormalizeQuery{})
+}
+
+// WithJSONDocumentArgs enables binding of json.RawMessage args and args which implement json.Marshaler
+// (such as structs with MarshalJSON method) as YDB JsonDocument (binary JSON) values.
+// Without option such args are bound as YDB Json (text JSON) values
+func WithJSONDocumentArgs() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.JSONDocumentArgs{})
+}
+
+// WithUUIDCoercion enables binding of [16]byte args as native YDB UUID values (bytes must be in
+// RFC 4122 order, same as in uuid.UUID) and scan of UUID columns into uuid.UUID and *uuid.UUID.
+// Option applies to query service engine only.
+//
+// Without option [16]byte args and scan of UUID columns into uuid.UUID are rejected
+// because of issue https://github.com/ydb-platform/ydb-go-sdk/issues/1501
+func WithUUIDCoercion() ConnectorOption {
+	return xsql.WithUUIDCoercion()
+}
+
+// WithOnClose registers callback which calls on close of database/sql connector before closing of connector conns.
+// Callbacks are called in registration order
+func WithOnClose(onClose func()) ConnectorOption {
+	return xsql.WithOnClose(func(*xsql.Connector) {
+		onClose()
+	})
+}
+
+// WithOnClosed registers callback which calls on close of database/sql connector after all connector conns
+// are closed. Callbacks are called in registration order
+func WithOnClosed(onClosed func()) ConnectorOption {
+	return xsql.WithOnClosed(func(*xsql.Connector) {
+		onClosed()
+	})
+}
+
+// TimeArgs defines YDB type of time.Time args of database/sql statements
+type TimeArgs = bind.TimeArgs
+
+const (
+	// TimeArgsTimestamp binds time.Time args as Timestamp with microseconds precision (default)
+	TimeArgsTimestamp = bind.TimeArgsTimestamp
+	// TimeArgsDatetime binds time.Time args as Datetime. Sub-second part of time is truncated
+	TimeArgsDatetime = bind.TimeArgsDatetime
+	// TimeArgsDate binds time.Time args as Date. Time of UTC day is truncated
+	TimeArgsDate = bind.TimeArgsDate
+)
+
+// WithTimeArgs defines YDB type of time.Time args of database/sql statements
+func WithTimeArgs(timeArgs TimeArgs) QueryBindConnectorOption {
+	return xsql.WithQueryBind(timeArgs)
+}
+
 func WithDefaultTxControl(txControl *table.TransactionControl) ConnectorOption {
 	return xsql.WithTableOptions(legacy.WithDefaultTxControl(txControl))
 }
@@ -214,6 +348,16 @@ func WithDefaultDataQueryOptions(opts ...options.ExecuteDataQueryOption) Connect
 	return xsql.WithTableOptions(legacy.WithDataOpts(opts...))
 }
 
+// WithoutKeepInCache disables keep-in-cache policy which is applied by default to parameterized
+// data queries of database/sql driver over table service. Useful for workloads with a lot of
+// unique parameterized queries which pollute server query cache.
+//
+// Prepared statements (including statements from connector statement cache, see WithStatementCacheSize)
+// are executed with keep-in-cache policy regardless of this option, because they are expected to be reused
+func WithoutKeepInCache() ConnectorOption {
+	return xsql.WithTableOptions(legacy.WithoutKeepInCache())
+}
+
 func WithDefaultScanQueryOptions(opts ...options.ExecuteScanQueryOption) ConnectorOption {
 	return xsql.WithTableOptions(legacy.WithScanOpts(opts...))
 }
@@ -225,29 +369,205 @@ func WithDatabaseSQLTrace(
 	return xsql.WithTrace(&t, opts...)
 }
 
+// WithOutgoingMetadata appends gRPC metadata returned by fn to each request to YDB from database/sql driver
+//
+// WithOutgoingMetadata can be used for propagation of tracing headers (such as W3C traceparent)
+func WithOutgoingMetadata(fn func(ctx context.Context) metadata.MD) ConnectorOption {
+	return xsql.WithOutgoingMetadata(fn)
+}
+
+// WithStatementMiddleware appends middleware which intercepts query text of each statement of database/sql
+// driver after binding of placeholders and before execution. Middleware can rewrite query text (for example,
+// add query tags or hints) or abort statement with error. Middlewares are applied in order of options
+func WithStatementMiddleware(fn func(ctx context.Context, query string) (string, error)) ConnectorOption {
+	return xsql.WithStatementMiddleware(fn)
+}
+
+// WithSlowQueryThreshold makes database/sql driver explain statements which took longer than threshold.
+// Statements are explained one by one in background over single separated session of connector, plan is
+// reported to OnSlowQuery hook of database/sql trace (see WithDatabaseSQLTrace). Slow statement with the same
+// text as statement which waits for explain is skipped, as well as slow statements over limit of explain queue.
+// Explain failures don't affect result of statement
+func WithSlowQueryThreshold(threshold time.Duration) ConnectorOption {
+	return xsql.WithSlowQueryThreshold(threshold)
+}
+
+// WithDefaultStatementTimeout limits time of each database/sql statement executed over connector.
+// Timeout can be decreased for single statement with WithStatementTimeout
+func WithDefaultStatementTimeout(timeout time.Duration) ConnectorOption {
+	return xsql.WithDefaultStatementTimeout(timeout)
+}
+
+// WithIdleThreshold sets maximum idle duration of database/sql driver connection.
+// Connections which are idle longer than idleThreshold will be closed.
+//
+// Invalid value of environment variable fails opening of connector.
+// If option is not defined then YDB_DATABASE_SQL_IDLE_THRESHOLD environment variable is used (for example "5m").
+// Zero or negative idleThreshold disables closing of idle connections.
+func WithIdleThreshold(idleThreshold time.Duration) ConnectorOption {
+	return xsql.WithIdleThreshold(idleThreshold)
+}
+
+// WithKeepAlive defines minimum number of database/sql driver connections which will not be closed
+// even if they are idle longer than idle threshold (see WithIdleThreshold)
+func WithKeepAlive(minConns int) ConnectorOption {
+	return xsql.WithKeepAlive(minConns)
+}
+
+// WithMinPoolSize makes database/sql driver eagerly create n connections in background for decrease
+// latency of cold start. Warm connections are used by database/sql before creation of new ones and
+// are not closed by idle threshold (see WithIdleThreshold) until first use. Sessions of warm connections
+// are created with connect retry (see WithConnectRetry), failures are reported to database/sql trace
+// only (see WithDatabaseSQLTrace)
+func WithMinPoolSize(n int) ConnectorOption {
+	return xsql.WithMinPoolSize(n)
+}
+
+// WithStatementCacheSize defines size of LRU cache of database/sql query texts.
+// Repeated queries which are found in cache are executed with keep in cache flag
+// for reuse of compiled query on server side. Queries which differ only in whitespaces
+// and comments are the same queries for cache.
+// Zero size (by default) disables cache
+//
+// Statement cache is supported by database/sql driver over table service only, because query service
+// has no keep in cache flag (server caches compiled queries of query service implicitly).
+// Connector over query service (see WithQueryService) with non-zero cache size is not created
+func WithStatementCacheSize(size int) ConnectorOption {
+	return xsql.WithStatementCacheSize(size)
+}
+
+// WithConnectRetry makes database/sql driver retry retryable errors of session creation on connect
+// up to maxAttempts attempts with delays from backoff. Backoff is made with retry.Backoff constructor,
+// for example ydb.WithConnectRetry(5, retry.Backoff(10*time.Millisecond, 6, 1)).
+// Non-retryable errors are returned immediately. Retries are limited by context of connect and
+// retry budget (see WithRetryBudget)
+func WithConnectRetry(maxAttempts int, backoff backoff.Backoff) ConnectorOption {
+	return xsql.WithConnectRetry(maxAttempts, backoff)
+}
+
+// WithSequentialConnIDs makes database/sql driver identify conns (in connector stats and traces)
+// with sequential numbers "1", "2", ... instead of random UUIDs. Numbers are unique for lifetime of connector
+func WithSequentialConnIDs() ConnectorOption {
+	return xsql.WithSequentialConnIDs()
+}
+
+// WithServerBalancer enables (by default) or disables server-side balancing of database/sql sessions.
+// With server-side balancing server chooses node of new session (session requests are routed to node
+// of session anyway), otherwise session is created on node chosen by client-side balancer.
+// Servers which don't support server-side balancing ignore the option, so sessions are balanced
+// by client-side balancer
+func WithServerBalancer(enabled bool) ConnectorOption {
+	return xsql.WithServerBalancer(enabled)
+}
+
+// WithDisableServerBalancer disables server-side balancing of database/sql sessions.
+// It is a shortcut of WithServerBalancer(false)
 func WithDisableServerBalancer() ConnectorOption {
 	return xsql.WithDisableServerBalancer()
 }
 
+// WithNodeAffinity makes database/sql connector route statements with the same affinity key to the same
+// node for better hit rate of server-side caches. Affinity keys are mapped to nodes known by client-side
+// balancer, so the same key is mapped to the same node while set of nodes is stable.
+//
+// Session of database/sql driver connection is bound to node, so statement outside of transaction
+// with affinity node other than node of connection session is executed on additional session of
+// connection on affinity node. Additional sessions are created once per node and closed with
+// connection. If affinity node is unavailable, balancer chooses another node as usual.
+// Statements inside transactions are executed on session of transaction
+func WithNodeAffinity(key func(query string) uint64) ConnectorOption {
+	return xsql.WithNodeAffinity(key)
+}
+
+// WithGrpcDialOption defines grpc dial options of database/sql traffic, such as credentials, keepalive
+// parameters or interceptors. With grpc dial options connector dials dedicated balancer (and connections)
+// with options of parent driver and given grpc dial options instead of using balancer of parent driver.
+// Conflicting security options (for example, credentials bundle with transport credentials or
+// per-RPC credentials which require transport security over insecure connection) produce
+// an error of Connector
+func WithGrpcDialOption(opts ...grpc.DialOption) ConnectorOption {
+	return xsql.WithGrpcDialOptions(opts...)
+}
+
+type (
+	// SQLConnectorStats is a snapshot of database/sql connector state
+	SQLConnectorStats = xsql.Stats
+	// SQLConnStats is a snapshot of database/sql driver connection state
+	SQLConnStats = xsql.ConnStats
+	// SQLConnectorWithStats is an optional interface of SQLConnector which reports connector state.
+	// Connector made by Connector implements it
+	//
+	//	if c, ok := connector.(ydb.SQLConnectorWithStats); ok {
+	//		stats := c.Stats()
+	//	}
+	SQLConnectorWithStats interface {
+		// Stats returns snapshot of connector state
+		Stats() SQLConnectorStats
+	}
+	// SQLConnectorWithNativeQueryClient is an optional interface of SQLConnector which gives access
+	// to native query client of connector. Connector made by Connector implements it
+	SQLConnectorWithNativeQueryClient interface {
+		// NativeQueryClient returns native query client which shares retry budget, retry trace and
+		// database/sql trace (OnDoTx hook) with connector
+		NativeQueryClient() query.Client
+	}
+)
+
+var (
+	_ SQLConnectorWithStats             = (*xsql.Connector)(nil)
+	_ SQLConnectorWithNativeQueryClient = (*xsql.Connector)(nil)
+)
+
 type SQLConnector interface {
 	driver.Connector
 
 	Close() error
 }
 
+// Connector makes database/sql connector over parent driver.
+//
+// If grpc dial options are defined (see WithGrpcDialOption) connector dials dedicated balancer
+// with parent options and grpc dial options, otherwise connector uses balancer of parent driver.
+// Dial of dedicated balancer is bounded by dial timeout of parent driver (see WithDialTimeout)
 func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
-	c, err := xsql.Open(parent, parent.metaBalancer,
+	opts = append(
 		append(
-			append(
-				parent.databaseSQLOptions,
-				opts...,
-			),
+			make([]ConnectorOption, 0, len(parent.databaseSQLOptions)+len(opts)+4), //nolint:gomnd
+			parent.databaseSQLOptions...,
+		),
+		opts...,
+	)
+
+	cc := parent
+	if dialOpts := xsql.GrpcDialOptions(opts...); len(dialOpts) > 0 {
+		ctx := context.Background()
+		if dialTimeout := parent.config.DialTimeout(); dialTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = xcontext.WithTimeout(ctx, dialTimeout)
+			defer cancel()
+		}
+		child, err := parent.withGrpcDialOptions(ctx, dialOpts...)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		cc = child
+		opts = append(opts, xsql.WithOnClosed(func(*xsql.Connector) {
+			_ = child.Close(context.Background())
+		}))
+	}
+
+	c, err := xsql.Open(cc, cc.metaBalancer,
+		append(opts,
 			xsql.WithOnClose(d.detach),
 			xsql.WithTraceRetry(parent.config.TraceRetry()),
 			xsql.WithRetryBudget(parent.config.RetryBudget()),
 		)...,
 	)
 	if err != nil {
+		if cc != parent {
+			_ = cc.Close(context.Background())
+		}
+
 		return nil, xerrors.WithStackTrace(err)
 	}
 	d.attach(c, parent)
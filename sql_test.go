@@ -0,0 +1,35 @@
+package ydb //nolint:testpackage
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+)
+
+func TestConnectorDialTimeout(t *testing.T) {
+	const dialTimeout = 100 * time.Millisecond
+	parent := &Driver{
+		opts: []Option{
+			WithConnectionString("grpc://localhost:1/local"),
+			WithDialTimeout(dialTimeout),
+			With(config.WithGrpcOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			}))),
+		},
+		config:   config.New(config.WithDialTimeout(dialTimeout)),
+		children: make(map[uint64]*Driver),
+	}
+	start := time.Now()
+	_, err := Connector(parent, xsql.Merge(WithGrpcDialOption(grpc.WithUserAgent("test"))))
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 10*dialTimeout)
+}
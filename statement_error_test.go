@@ -0,0 +1,78 @@
+package ydb //nolint:testpackage
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	internalTable "github.com/ydb-platform/ydb-go-sdk/v3/internal/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+// tableDriver is a parent driver of database/sql connector with table client only
+type tableDriver struct {
+	table table.Client
+}
+
+func (d tableDriver) Name() string                 { return "/local" }
+func (d tableDriver) Table() table.Client          { return d.table }
+func (d tableDriver) Query() *internalQuery.Client { return nil }
+func (d tableDriver) Scripting() scripting.Client  { return nil }
+func (d tableDriver) Scheme() scheme.Client        { return nil }
+
+func TestStatementError(t *testing.T) {
+	ctx := xtest.Context(t)
+	balancer := testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+		testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+			return &Ydb_Table.CreateSessionResult{
+				SessionId: testutil.SessionID(),
+			}, nil
+		},
+		testutil.TableExecuteDataQuery: func(interface{}) (proto.Message, error) {
+			return nil, xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_PRECONDITION_FAILED),
+				xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message:   "Conflict with existing key.",
+					IssueCode: 2012,
+					Severity:  1,
+				}}),
+			)
+		},
+		testutil.TableDeleteSession: func(interface{}) (proto.Message, error) {
+			return &Ydb_Table.DeleteSessionResponse{}, nil
+		},
+	}))
+	client := internalTable.New(ctx, balancer, config.New())
+	defer func() {
+		_ = client.Close(ctx)
+	}()
+
+	c, err := xsql.Open(tableDriver{table: client}, balancer, WithQueryService(false))
+	require.NoError(t, err)
+
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, "INSERT INTO t (id) VALUES (1)")
+	require.Error(t, err)
+
+	var stmtErr *StatementError
+	require.True(t, errors.As(err, &stmtErr))
+	require.Equal(t, Ydb.StatusIds_PRECONDITION_FAILED, stmtErr.Code())
+	require.Len(t, stmtErr.Issues(), 1)
+	require.EqualValues(t, 2012, stmtErr.Issues()[0].GetIssueCode())
+}
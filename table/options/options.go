@@ -875,6 +875,15 @@ func WithKeepInCache(keepInCache bool) ExecuteDataQueryOption {
 	)
 }
 
+// WithoutKeepInCache disables keep-in-cache flag in query cache policy.
+//
+// Data queries with parameters are executed with keep-in-cache policy by default.
+// Workloads with a lot of unique parameterized queries can use WithoutKeepInCache
+// for avoid pollution of server query cache
+func WithoutKeepInCache() ExecuteDataQueryOption {
+	return WithKeepInCache(false)
+}
+
 type withCallOptions []grpc.CallOption
 
 func (opts withCallOptions) ApplyExecuteScanQueryOption(d *ExecuteScanQueryDesc) []grpc.CallOption {
@@ -137,7 +137,7 @@ type Session interface {
 	// Execute executes query.
 	//
 	// By default, Execute have a flag options.WithKeepInCache(true) if params is not empty. For redefine behavior -
-	// append option options.WithKeepInCache(false)
+	// append option options.WithKeepInCache(false) or options.WithoutKeepInCache()
 	Execute(ctx context.Context, tx *TransactionControl, sql string, params *params.Params,
 		opts ...options.ExecuteDataQueryOption,
 	) (txr Transaction, r result.Result, err error)
@@ -213,6 +213,10 @@ type TransactionIdentifier = tx.Identifier
 type TransactionActor interface {
 	TransactionIdentifier
 
+	// Execute executes query in transaction.
+	//
+	// By default, Execute have a flag options.WithKeepInCache(true) if params is not empty. For redefine behavior -
+	// append option options.WithoutKeepInCache()
 	Execute(
 		ctx context.Context,
 		sql string,
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -19,6 +21,38 @@ func CastTo(v Value, dst interface{}) error {
 	return value.CastTo(v, dst)
 }
 
+type containerScanner struct {
+	dst interface{}
+}
+
+// ContainerScanner returns sql.Scanner which scans YDB container values (List, Dict and Struct)
+// received with database/sql into dst. Destination must be a pointer to slice for List values,
+// pointer to map for Dict values or pointer to struct with sql tags of fields for Struct values.
+// NULL value resets destination to zero value
+//
+//	var ids []int32
+//	err := row.Scan(types.ContainerScanner(&ids))
+func ContainerScanner(dst interface{}) sql.Scanner {
+	return containerScanner{dst: dst}
+}
+
+func (s containerScanner) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		ptr := reflect.ValueOf(s.dst)
+		if ptr.Kind() != reflect.Pointer || ptr.IsNil() {
+			return xerrors.WithStackTrace(fmt.Errorf("cannot scan NULL into %T", s.dst))
+		}
+		ptr.Elem().SetZero()
+
+		return nil
+	case Value:
+		return CastTo(v, s.dst)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("cannot scan %T into %T", src, s.dst))
+	}
+}
+
 // IsOptional checks if type is optional and returns innerType if it is.
 func IsOptional(t Type) (isOptional bool, innerType Type) {
 	if optionalType, isOptional := t.(interface {
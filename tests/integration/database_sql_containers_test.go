@@ -120,6 +120,64 @@ func TestDatabaseSqlContainers(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDatabaseSqlContainersBind(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nativeDriver, err := ydb.Open(ctx,
+		os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = nativeDriver.Close(ctx)
+	}()
+
+	connector, err := ydb.Connector(nativeDriver, ydb.WithAutoDeclare())
+	require.NoError(t, err)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	db := sql.OpenDB(connector)
+
+	var (
+		xs   []int32
+		list []int32
+	)
+	err = retry.Do(ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+		xs = xs[:0]
+		rows, err := cc.QueryContext(ctx, `
+			SELECT x FROM AS_TABLE(AsList(AsStruct(1 AS x), AsStruct(2 AS x), AsStruct(3 AS x)))
+			WHERE x IN $list
+			ORDER BY x;`,
+			sql.Named("list", []int32{1, 3}),
+		)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = rows.Close()
+		}()
+		for rows.Next() {
+			var x int32
+			if err = rows.Scan(&x); err != nil {
+				return err
+			}
+			xs = append(xs, x)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		return cc.QueryRowContext(ctx, `SELECT $list;`, sql.Named("list", []int32{1, 3})).
+			Scan(types.ContainerScanner(&list))
+	}, retry.WithIdempotent(true))
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 3}, xs)
+	require.Equal(t, []int32{1, 3}, list)
+}
+
 type testDatabaseSqlContainersExampleStruct struct {
 	foo int
 	bar int
@@ -0,0 +1,53 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestDatabaseSqlDecimal(t *testing.T) {
+	scope := newScope(t)
+	db := scope.SQLDriverWithFolder(
+		ydb.WithTablePathPrefix(scope.Folder()),
+		ydb.WithAutoDeclare(),
+		ydb.WithPositionalArgs(),
+	)
+
+	for _, s := range []string{
+		"0.0000000001",
+		"-1234567890123456789012345.6789012345",
+		"9999999999999999999999999.9999999999",
+	} {
+		t.Run(s, func(t *testing.T) {
+			v, err := types.DecimalValueFromString(s, 35, 10)
+			scope.Require.NoError(err)
+			expected, err := types.ToDecimal(v)
+			scope.Require.NoError(err)
+
+			var (
+				yqlType  string
+				actual   types.Decimal
+				optional *types.Decimal
+			)
+			err = retry.Do(scope.Ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+				return cc.QueryRowContext(ctx,
+					`SELECT FormatType(TypeOf(?)), ?, ?`, *expected, *expected, expected,
+				).Scan(&yqlType, &actual, &optional)
+			})
+			scope.Require.NoError(err)
+			scope.Require.Equal("Decimal(35,10)", yqlType)
+			scope.Require.Equal(*expected, actual)
+			scope.Require.Equal(s, actual.String())
+			scope.Require.NotNil(optional)
+			scope.Require.Equal(*expected, *optional)
+		})
+	}
+}
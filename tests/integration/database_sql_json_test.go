@@ -0,0 +1,82 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func TestDatabaseSqlJSONRoundTrip(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	nativeDriver, err := ydb.Open(ctx,
+		os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = nativeDriver.Close(ctx)
+	}()
+
+	for _, tt := range []struct {
+		name    string
+		opts    []ydb.ConnectorOption
+		yqlType string
+	}{
+		{
+			name:    "Json",
+			opts:    []ydb.ConnectorOption{ydb.WithAutoDeclare()},
+			yqlType: "Json",
+		},
+		{
+			name:    "JsonDocument",
+			opts:    []ydb.ConnectorOption{ydb.WithAutoDeclare(), ydb.WithJSONDocumentArgs()},
+			yqlType: "JsonDocument",
+		},
+	} {
+		for _, queryService := range []bool{false, true} {
+			t.Run(tt.name, func(t *testing.T) {
+				connector, err := ydb.Connector(nativeDriver,
+					append(tt.opts, ydb.WithQueryService(queryService))...,
+				)
+				require.NoError(t, err)
+				defer func() {
+					_ = connector.Close()
+				}()
+
+				db := sql.OpenDB(connector)
+
+				src := struct {
+					ID   uint64   `json:"id"`
+					Tags []string `json:"tags"`
+				}{ID: 1, Tags: []string{"a", "b"}}
+				raw, err := json.Marshal(src)
+				require.NoError(t, err)
+
+				var (
+					yqlType string
+					dst     json.RawMessage
+				)
+				err = retry.Do(ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+					return cc.QueryRowContext(ctx, `SELECT FormatType(TypeOf($doc)), $doc;`,
+						sql.Named("doc", json.RawMessage(raw)),
+					).Scan(&yqlType, &dst)
+				}, retry.WithIdempotent(true))
+				require.NoError(t, err)
+				require.Equal(t, tt.yqlType, yqlType)
+				require.JSONEq(t, string(raw), string(dst))
+			})
+		}
+	}
+}
@@ -0,0 +1,33 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"math/rand"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func TestDatabaseSqlReaderArgs(t *testing.T) {
+	scope := newScope(t)
+	db := scope.SQLDriverWithFolder(
+		ydb.WithAutoDeclare(),
+	)
+
+	blob := make([]byte, 4<<20)
+	_, _ = rand.New(rand.NewSource(0)).Read(blob)
+
+	var res []byte
+	err := retry.Do(scope.Ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+		// reader is consumed by bind, so each attempt requires new reader
+		return cc.QueryRowContext(ctx, `SELECT $blob`, sql.Named("blob", bytes.NewReader(blob))).Scan(&res)
+	})
+	scope.Require.NoError(err)
+	scope.Require.Equal(blob, res)
+}
@@ -0,0 +1,84 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func TestDatabaseSqlTimeArgs(t *testing.T) {
+	scope := newScope(t)
+	db := scope.SQLDriverWithFolder(
+		ydb.WithTablePathPrefix(scope.Folder()),
+		ydb.WithAutoDeclare(),
+		ydb.WithPositionalArgs(),
+	)
+	v := time.Date(2023, 3, 1, 16, 34, 18, 123456789, time.UTC)
+
+	for _, tt := range []struct {
+		name     string
+		timeArgs *ydb.TimeArgs
+		yqlType  string
+		expected time.Time
+	}{
+		{
+			name:     "Default",
+			yqlType:  "Timestamp",
+			expected: time.Date(2023, 3, 1, 16, 34, 18, 123456000, time.UTC),
+		},
+		{
+			name: "Timestamp",
+			timeArgs: func() *ydb.TimeArgs {
+				timeArgs := ydb.TimeArgsTimestamp
+
+				return &timeArgs
+			}(),
+			yqlType:  "Timestamp",
+			expected: time.Date(2023, 3, 1, 16, 34, 18, 123456000, time.UTC),
+		},
+		{
+			name: "Datetime",
+			timeArgs: func() *ydb.TimeArgs {
+				timeArgs := ydb.TimeArgsDatetime
+
+				return &timeArgs
+			}(),
+			yqlType:  "Datetime",
+			expected: time.Date(2023, 3, 1, 16, 34, 18, 0, time.UTC),
+		},
+		{
+			name: "Date",
+			timeArgs: func() *ydb.TimeArgs {
+				timeArgs := ydb.TimeArgsDate
+
+				return &timeArgs
+			}(),
+			yqlType:  "Date",
+			expected: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				yqlType string
+				actual  time.Time
+			)
+			err := retry.Do(scope.Ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+				if tt.timeArgs != nil {
+					ctx = ydb.WithStatementTimeArgs(ctx, *tt.timeArgs)
+				}
+
+				return cc.QueryRowContext(ctx, `SELECT FormatType(TypeOf(?)), ?`, v, v).Scan(&yqlType, &actual)
+			})
+			scope.Require.NoError(err)
+			scope.Require.Equal(tt.yqlType, yqlType)
+			scope.Require.Equal(tt.expected, actual.UTC())
+		})
+	}
+}
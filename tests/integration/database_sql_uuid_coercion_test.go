@@ -0,0 +1,72 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func TestDatabaseSqlUUIDCoercion(t *testing.T) {
+	scope := newScope(t)
+	db := scope.SQLDriverWithFolder(
+		ydb.WithQueryService(true),
+		ydb.WithAutoDeclare(),
+		ydb.WithUUIDCoercion(),
+	)
+
+	_, err := db.ExecContext(scope.Ctx, `
+		CREATE TABLE uuids (
+			id Int64 NOT NULL,
+			val Uuid,
+			PRIMARY KEY (id)
+		)`,
+	)
+	scope.Require.NoError(err)
+
+	var (
+		id      = uuid.MustParse("6E73B41C-4EDE-4D08-9CFB-B7462D9E498B")
+		idBytes = [16]byte(uuid.MustParse("0C1E6F32-5BB1-4B7B-8D2F-2D4A6E3C9F01"))
+	)
+
+	err = retry.Do(scope.Ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+		_, err := cc.ExecContext(ctx, `
+			UPSERT INTO uuids (id, val) VALUES
+				(1, $uuid),
+				(2, $bytes),
+				(3, $null);`,
+			sql.Named("uuid", &id),
+			sql.Named("bytes", &idBytes),
+			sql.Named("null", (*uuid.UUID)(nil)),
+		)
+
+		return err
+	})
+	scope.Require.NoError(err)
+
+	var (
+		fromUUID  uuid.UUID
+		fromBytes *uuid.UUID
+		fromNull  *uuid.UUID
+	)
+	err = retry.Do(scope.Ctx, db, func(ctx context.Context, cc *sql.Conn) error {
+		return cc.QueryRowContext(ctx, `
+			SELECT
+				(SELECT val FROM uuids WHERE id = 1),
+				(SELECT val FROM uuids WHERE id = 2),
+				(SELECT val FROM uuids WHERE id = 3);`,
+		).Scan(&fromUUID, &fromBytes, &fromNull)
+	})
+	scope.Require.NoError(err)
+	scope.Require.Equal(id, fromUUID)
+	scope.Require.NotNil(fromBytes)
+	scope.Require.Equal(uuid.UUID(idBytes), *fromBytes)
+	scope.Require.Nil(fromNull)
+}
@@ -0,0 +1,61 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestDatabaseSqlWithGrpcDialOption(t *testing.T) {
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+
+	nativeDriver, err := ydb.Open(ctx, os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = nativeDriver.Close(ctx)
+	}()
+
+	var (
+		mu      sync.Mutex
+		methods []string
+	)
+	connector, err := ydb.Connector(nativeDriver,
+		ydb.WithQueryService(false),
+		ydb.WithGrpcDialOption(grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string,
+			req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+		) error {
+			mu.Lock()
+			methods = append(methods, method)
+			mu.Unlock()
+
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})),
+	)
+	require.NoError(t, err)
+
+	db := sql.OpenDB(connector)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err = db.ExecContext(ctx, "SELECT 1;")
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Contains(t, methods, "/Ydb.Table.V1.TableService/ExecuteDataQuery")
+}
@@ -468,6 +468,35 @@ SELECT * FROM AS_TABLE($arg);
 		require.Error(t, secondRowError)
 		require.NotErrorIs(t, secondRowError, io.EOF)
 	})
+	t.Run("ColumnDescriptors", func(t *testing.T) {
+		scope := newScope(t)
+
+		err := scope.Driver().Query().Do(scope.Ctx, func(ctx context.Context, s query.Session) error {
+			res, err := s.Query(ctx, "SELECT 1 AS a, 'x' AS b")
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = res.Close(ctx)
+			}()
+
+			rs, err := res.NextResultSet(ctx)
+			if err != nil {
+				return err
+			}
+
+			// columns available before iterating rows
+			columns := rs.ColumnDescriptors()
+			require.Len(t, columns, 2)
+			require.Equal(t, "a", columns[0].Name)
+			require.Equal(t, "Int32", columns[0].Type.Yql())
+			require.Equal(t, "b", columns[1].Name)
+			require.Equal(t, "String", columns[1].Type.Yql())
+
+			return nil
+		})
+		require.NoError(t, err)
+	})
 }
 
 func TestQueryPartLimiter(t *testing.T) {
@@ -538,3 +567,62 @@ SELECT * FROM AS_TABLE($arg);
 	require.Equal(t, 1, partsWithBigSize)
 	require.Greater(t, partsWithLittleSize, 1)
 }
+
+func TestQueryRowsAffected(t *testing.T) {
+	scope := newScope(t)
+	tablePath := scope.TablePath()
+
+	err := scope.Driver().Query().Exec(scope.Ctx, fmt.Sprintf(
+		"UPSERT INTO `%s` (id, val) VALUES (1, 'a'), (2, 'b'), (3, 'a'), (4, 'a'), (5, 'c')", tablePath,
+	))
+	require.NoError(t, err)
+
+	t.Run("WithStats", func(t *testing.T) {
+		err = scope.Driver().Query().Do(scope.Ctx, func(ctx context.Context, s query.Session) error {
+			res, err := s.Query(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE val = 'a'", tablePath),
+				query.WithStatsMode(query.StatsModeBasic, nil),
+			)
+			if err != nil {
+				return err
+			}
+			for {
+				if _, err = res.NextResultSet(ctx); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+
+			rowsAffected, err := res.(query.RowsAffectedResult).RowsAffected()
+			require.NoError(t, err)
+			require.EqualValues(t, 3, rowsAffected)
+
+			return nil
+		})
+		require.NoError(t, err)
+	})
+	t.Run("WithoutStats", func(t *testing.T) {
+		err = scope.Driver().Query().Do(scope.Ctx, func(ctx context.Context, s query.Session) error {
+			res, err := s.Query(ctx, fmt.Sprintf("DELETE FROM `%s` WHERE val = 'b'", tablePath))
+			if err != nil {
+				return err
+			}
+			for {
+				if _, err = res.NextResultSet(ctx); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+
+			rowsAffected, err := res.(query.RowsAffectedResult).RowsAffected()
+			require.Error(t, err)
+			require.EqualValues(t, -1, rowsAffected)
+
+			return nil
+		})
+		require.NoError(t, err)
+	})
+}
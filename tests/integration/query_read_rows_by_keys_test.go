@@ -0,0 +1,84 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestQueryReadRowsByKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+
+	db, err := ydb.Open(ctx,
+		os.Getenv("YDB_CONNECTION_STRING"),
+		ydb.WithAccessTokenCredentials(os.Getenv("YDB_ACCESS_TOKEN_CREDENTIALS")),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	tableName := path.Join(t.Name(), "test")
+
+	err = db.Query().Exec(ctx, "DROP TABLE IF EXISTS `"+path.Join(db.Name(), tableName)+"`;")
+	require.NoError(t, err)
+
+	err = db.Query().Exec(ctx, "CREATE TABLE `"+path.Join(db.Name(), tableName)+"` ("+`
+			id Uint64,
+			name Utf8,
+			value Utf8,
+			PRIMARY KEY(id, name)
+		)`,
+	)
+	require.NoError(t, err)
+
+	err = db.Query().Exec(ctx, "UPSERT INTO `"+path.Join(db.Name(), tableName)+"` (id, name, value) VALUES "+
+		`(1, "a", "1a"), (2, "b", "2b"), (3, "c", "3c");`,
+	)
+	require.NoError(t, err)
+
+	key := func(id uint64, name string) query.KeyTuple {
+		return query.Key(
+			query.KeyColumn("id", types.Uint64Value(id)),
+			query.KeyColumn("name", types.TextValue(name)),
+		)
+	}
+
+	err = db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		rows, err := query.ReadRowsByKeys(ctx, tx, tableName, []query.KeyTuple{
+			key(1, "a"), key(2, "x"), key(3, "c"), key(4, "d"),
+		}, []string{"value"}, query.WithReadRowsTablePathPrefix(db.Name()))
+		if err != nil {
+			return err
+		}
+
+		require.Len(t, rows, 2)
+		require.NotContains(t, rows, key(2, "x"))
+		require.NotContains(t, rows, key(4, "d"))
+		for k, exp := range map[query.KeyTuple]string{
+			key(1, "a"): "1a",
+			key(3, "c"): "3c",
+		} {
+			require.Contains(t, rows, k)
+			var v *string
+			require.NoError(t, rows[k].ScanNamed(query.Named("value", &v)))
+			require.NotNil(t, v)
+			require.Equal(t, exp, *v)
+		}
+
+		return nil
+	}, query.WithIdempotent())
+	require.NoError(t, err)
+}
@@ -53,13 +53,6 @@ FROM ` + "`%s`" + ` WHERE id = $id AND hash = Digest::NumericHash($id);
 )
 
 var (
-	readTx = table.TxControl(
-		table.BeginTx(
-			table.WithOnlineReadOnly(),
-		),
-		table.CommitTx(),
-	)
-
 	writeTx = table.SerializableReadWriteTxControl(
 		table.CommitTx(),
 	)
@@ -132,7 +125,7 @@ func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (res genera
 	ctx, cancel := context.WithTimeout(ctx, time.Duration(s.cfg.ReadTimeout)*time.Millisecond)
 	defer cancel()
 
-	err = retry.Do(ydb.WithTxControl(ctx, readTx), s.db,
+	err = retry.Do(ydb.WithTxControl(ctx, s.cfg.ReadTxMode.TableTxControl()), s.db,
 		func(ctx context.Context, cc *sql.Conn) (err error) {
 			if err = ctx.Err(); err != nil {
 				return err
@@ -9,6 +9,7 @@ require (
 	github.com/ydb-platform/ydb-go-sdk/v3 v3.67.0
 	golang.org/x/sync v0.7.0
 	golang.org/x/time v0.5.0
+	google.golang.org/grpc v1.64.1
 	gorm.io/gorm v1.25.10
 	xorm.io/xorm v1.3.2
 )
@@ -42,7 +43,6 @@ require (
 	golang.org/x/text v0.16.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20240318140521-94a12d6c2237 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240515191416-fc5f0ca64291 // indirect
-	google.golang.org/grpc v1.64.1 // indirect
 	google.golang.org/protobuf v1.34.1 // indirect
 	modernc.org/sqlite v1.24.0 // indirect
 	xorm.io/builder v0.3.11-0.20220531020008-1bd24a7dc978 // indirect
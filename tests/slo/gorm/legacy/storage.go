@@ -31,13 +31,6 @@ WITH (
 )`
 
 var (
-	readTx = table.TxControl(
-		table.BeginTx(
-			table.WithOnlineReadOnly(),
-		),
-		table.CommitTx(),
-	)
-
 	writeTx = table.SerializableReadWriteTxControl(
 		table.CommitTx(),
 	)
@@ -91,7 +84,7 @@ func (s *Storage) Read(ctx context.Context, id generator.RowID) (r generator.Row
 		return generator.Row{}, attempts, err
 	}
 
-	err = retry.Do(ydbSDK.WithTxControl(ctx, readTx), db,
+	err = retry.Do(ydbSDK.WithTxControl(ctx, s.cfg.ReadTxMode.TableTxControl()), db,
 		func(ctx context.Context, cc *sql.Conn) (err error) {
 			if err = ctx.Err(); err != nil {
 				return err
@@ -5,6 +5,10 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
+
+	"slo/internal/generator"
 )
 
 var ErrWrongArgs = errors.New("wrong args")
@@ -22,15 +26,48 @@ type Config struct {
 	PartitionSize      uint64
 	InitialDataCount   uint64
 
+	InitialDataConcurrency int
+
+	// PayloadSize is a distribution of payload sizes of generated rows
+	PayloadSize generator.Size
+
+	// GeneratorState is a path of checkpoint file of rows generator. CreateMode writes checkpoint
+	// after initial data load, RunMode continues ids from checkpoint and updates it at exit
+	GeneratorState string
+
+	// VerifyInitialData enables check of initially created rows after load.
+	// VerifySampleSize limits amount of checked random rows, zero means all rows
+	VerifyInitialData bool
+	VerifySampleSize  uint64
+
 	PushGateway  string
-	ReportPeriod int
+	ReportPeriod time.Duration
+
+	// HealthAddr is an address of health probe and metrics HTTP server. Empty address disables server
+	HealthAddr string
 
 	ReadRPS     int
 	ReadTimeout int
+	ReadTxMode  ReadTxMode
 
 	WriteRPS     int
 	WriteTimeout int
 
+	// RateJitter is an amplitude of random shift of each read and write operation around its scheduled time,
+	// zero disables jitter. RateJitterSeed makes sequence of shifts reproducible
+	RateJitter     time.Duration
+	RateJitterSeed int64
+
+	// OperationTimeout limits each read and write operation of workers. Zero disables limit
+	OperationTimeout time.Duration
+
+	ReadYourWrites bool
+
+	// DeadLettersSize limits amount of kept failed operations (first ones), zero disables collection.
+	// Kept failed operations are written into DeadLettersPath at shutdown if path isn't empty
+	DeadLettersSize int
+	DeadLettersPath string
+
 	Time         int
 	ShutdownTime int
 }
@@ -46,6 +83,8 @@ func New() (*Config, error) {
 
 	fs := flag.FlagSet{}
 
+	var payloadSize payloadSizeFlags
+
 	switch os.Args[1] {
 	case "create":
 		if len(os.Args) < 4 {
@@ -68,6 +107,17 @@ func New() (*Config, error) {
 			"initial-data-count", 1000, "amount of initially created rows")
 		fs.Uint64Var(&cfg.InitialDataCount,
 			"c", 1000, "amount of initially created rows (shorthand)")
+		fs.IntVar(&cfg.InitialDataConcurrency,
+			"initial-data-concurrency", 100, "maximum amount of concurrent writes of initially created rows")
+
+		payloadSize.register(&fs)
+		registerGeneratorState(&fs, &cfg.GeneratorState)
+
+		fs.BoolVar(&cfg.VerifyInitialData,
+			"verify-initial-data", false, "check that all initially created rows are persisted")
+		fs.Uint64Var(&cfg.VerifySampleSize,
+			"verify-sample-size", 0, "amount of random rows for check of initial data, zero means all rows")
+		fs.IntVar(&cfg.ReadTimeout, "read-timeout", 10000, "read timeout milliseconds")
 	case "cleanup":
 		if len(os.Args) < 4 {
 			fmt.Print(cleanupHelp)
@@ -91,15 +141,43 @@ func New() (*Config, error) {
 		fs.Uint64Var(&cfg.InitialDataCount,
 			"c", 1000, "amount of initially created rows (shorthand)")
 
+		payloadSize.register(&fs)
+		registerGeneratorState(&fs, &cfg.GeneratorState)
+
 		fs.StringVar(&cfg.PushGateway, "prom-pgw", "", "prometheus push gateway")
-		fs.IntVar(&cfg.ReportPeriod, "report-period", 250, "prometheus push period in milliseconds")
+		cfg.ReportPeriod = 250 * time.Millisecond
+		fs.Var((*period)(&cfg.ReportPeriod), "report-period",
+			"prometheus push period in milliseconds or as duration string (e.g. 500ms, 2s)")
+
+		fs.StringVar(&cfg.HealthAddr, "health-addr", "",
+			"address of HTTP server with /healthz probe and /metrics (e.g. :8080), disabled by default")
 
 		fs.IntVar(&cfg.ReadRPS, "read-rps", 1000, "read RPS")
 		fs.IntVar(&cfg.WriteRPS, "write-rps", 100, "write RPS")
 		fs.IntVar(&cfg.ReadTimeout, "read-timeout", 10000, "read timeout milliseconds")
+		cfg.ReadTxMode = ReadTxModeSnapshot
+		fs.Var(&cfg.ReadTxMode, "read-tx-mode",
+			"transaction mode of read queries: default, snapshot, online or stale")
+
+		fs.Var((*period)(&cfg.RateJitter), "rate-jitter",
+			"amplitude of random shift of read and write operations around scheduled time in milliseconds or as duration string "+
+				"(e.g. 500ms, 2s), disabled by default")
+		fs.Int64Var(&cfg.RateJitterSeed, "rate-jitter-seed", 0,
+			"seed of random shifts of read and write operations, random by default")
+
+		fs.Var((*period)(&cfg.OperationTimeout), "operation-timeout",
+			"timeout of each read and write operation in milliseconds or as duration string (e.g. 500ms, 2s)")
+
+		fs.BoolVar(&cfg.ReadYourWrites, "read-your-writes", false,
+			"read each written row and count violations if written value is not visible")
+
+		fs.IntVar(&cfg.DeadLettersSize, "dead-letters-size", 100,
+			"amount of first failed operations kept for diagnosis, 0 disables collection")
+		fs.StringVar(&cfg.DeadLettersPath, "dead-letters-path", "",
+			"path of file for dump of kept failed operations at shutdown, disabled by default")
 
 		fs.IntVar(&cfg.Time, "time", 600, "run time in seconds")
-		fs.IntVar(&cfg.ShutdownTime, "shutdown-time", 30, "time to wait before force kill workers")
+		fs.IntVar(&cfg.ShutdownTime, "shutdown-time", 30, "time to wait for in-flight operations before force kill workers")
 	default:
 		fmt.Print(mainHelp)
 
@@ -118,5 +196,84 @@ func New() (*Config, error) {
 		return nil, err
 	}
 
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Mode == RunMode && cfg.RateJitterSeed == 0 {
+		cfg.RateJitterSeed = time.Now().UnixNano()
+	}
+
+	if cfg.Mode == CreateMode || cfg.Mode == RunMode {
+		size, err := generator.NewSize(payloadSize.distribution, payloadSize.min, payloadSize.max, payloadSize.s)
+		if err != nil {
+			return nil, err
+		}
+		cfg.PayloadSize = size
+	}
+
 	return cfg, nil
 }
+
+// validate checks consistency of parsed flags
+func (cfg *Config) validate() error {
+	if cfg.Mode == CreateMode && cfg.InitialDataConcurrency <= 0 {
+		return fmt.Errorf("non-positive initial data concurrency: %d", cfg.InitialDataConcurrency)
+	}
+
+	if cfg.RateJitter < 0 {
+		return fmt.Errorf("negative rate jitter: %v", cfg.RateJitter)
+	}
+
+	// stale read may not see just committed row, so each check would count false violation
+	if cfg.ReadYourWrites && cfg.ReadTxMode == ReadTxModeStale {
+		return fmt.Errorf("%w: read your writes can't be verified with %q read tx mode", ErrWrongArgs, cfg.ReadTxMode)
+	}
+
+	return nil
+}
+
+// payloadSizeFlags are flags of payload size distribution of generated rows
+type payloadSizeFlags struct {
+	distribution string
+	min, max     int
+	s            float64
+}
+
+func (f *payloadSizeFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.distribution, "payload-size-distribution", "uniform",
+		"distribution of payload sizes of generated rows: constant (max size), uniform or zipf")
+	fs.IntVar(&f.min, "payload-min-size", generator.MinLength, "minimum payload size of generated rows in bytes")
+	fs.IntVar(&f.max, "payload-max-size", generator.MaxLength, "maximum payload size of generated rows in bytes")
+	fs.Float64Var(&f.s, "payload-zipf-s", 1.1, "exponent of zipf distribution of payload sizes, must be greater than 1")
+}
+
+func registerGeneratorState(fs *flag.FlagSet, path *string) {
+	fs.StringVar(path, "generator-state", "",
+		"path of checkpoint file of rows generator for unique ids across runs, disabled by default")
+}
+
+// period is a flag value which accepts milliseconds (for backward compatibility) or duration string
+type period time.Duration
+
+func (p *period) String() string {
+	return time.Duration(*p).String()
+}
+
+func (p *period) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		ms, msErr := strconv.Atoi(s)
+		if msErr != nil {
+			return err
+		}
+		d = time.Duration(ms) * time.Millisecond
+	}
+	if d <= 0 {
+		return fmt.Errorf("non-positive period: %q", s)
+	}
+
+	*p = period(d)
+
+	return nil
+}
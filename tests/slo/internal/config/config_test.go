@@ -0,0 +1,92 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		cfg  Config
+		err  bool
+	}{
+		{
+			name: "ReadYourWritesWithSnapshotReads",
+			cfg:  Config{Mode: RunMode, ReadYourWrites: true, ReadTxMode: ReadTxModeSnapshot},
+		},
+		{
+			name: "ReadYourWritesWithOnlineReads",
+			cfg:  Config{Mode: RunMode, ReadYourWrites: true, ReadTxMode: ReadTxModeOnline},
+		},
+		{
+			name: "ReadYourWritesWithStaleReads",
+			cfg:  Config{Mode: RunMode, ReadYourWrites: true, ReadTxMode: ReadTxModeStale},
+			err:  true,
+		},
+		{
+			name: "StaleReadsWithoutReadYourWrites",
+			cfg:  Config{Mode: RunMode, ReadTxMode: ReadTxModeStale},
+		},
+		{
+			name: "NegativeRateJitter",
+			cfg:  Config{Mode: RunMode, RateJitter: -1},
+			err:  true,
+		},
+		{
+			name: "NonPositiveInitialDataConcurrency",
+			cfg:  Config{Mode: CreateMode},
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate()
+			if !tt.err && err != nil {
+				t.Fatal(err)
+			}
+			if tt.err && err == nil {
+				t.Fatal("inconsistent config accepted")
+			}
+		})
+	}
+
+	err := (&Config{Mode: RunMode, ReadYourWrites: true, ReadTxMode: ReadTxModeStale}).validate()
+	if !errors.Is(err, ErrWrongArgs) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	for _, tt := range []struct {
+		value  string
+		period time.Duration
+		err    bool
+	}{
+		{value: "250", period: 250 * time.Millisecond},
+		{value: "500ms", period: 500 * time.Millisecond},
+		{value: "2s", period: 2 * time.Second},
+		{value: "1m30s", period: 90 * time.Second},
+		{value: "0", err: true},
+		{value: "-1s", err: true},
+		{value: "second", err: true},
+	} {
+		t.Run(tt.value, func(t *testing.T) {
+			var p period
+			err := p.Set(tt.value)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("wrong period %q accepted", tt.value)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if time.Duration(p) != tt.period {
+				t.Fatalf("unexpected period %v of %q", time.Duration(p), tt.value)
+			}
+		})
+	}
+}
@@ -20,7 +20,20 @@ Options:
   -partition-size        <int>    partition size in mb
                                    
   -c -initial-data-count <int>    amount of initially created rows
+  -initial-data-concurrency <int> maximum amount of concurrent writes of initially created rows
                                    
+  -payload-size-distribution <string> distribution of payload sizes of generated rows:
+                                  constant (max size), uniform (default) or zipf
+  -payload-min-size      <int>    minimum payload size in bytes (20 by default)
+  -payload-max-size      <int>    maximum payload size in bytes (40 by default)
+  -payload-zipf-s        <float>  exponent of zipf distribution, must be greater than 1 (1.1 by default)
+  -generator-state       <string> path of checkpoint file of rows generator, written after load
+                                  for continue ids in run mode, disabled by default
+                                   
+  -verify-initial-data            check that all initially created rows are persisted
+  -verify-sample-size    <int>    amount of random rows for check, all rows by default
+                                   
+  -read-timeout          <int>    read timeout milliseconds
   -write-timeout         <int>    write timeout milliseconds
 `
 	cleanupHelp = `Usage: slo-go-workload cleanup <endpoint> <db> [options]
@@ -45,16 +58,46 @@ Options:
                          
   -initial-data-count    <int>    amount of initially created rows
                          
+  -payload-size-distribution <string> distribution of payload sizes of generated rows:
+                                  constant (max size), uniform (default) or zipf
+  -payload-min-size      <int>    minimum payload size in bytes (20 by default)
+  -payload-max-size      <int>    maximum payload size in bytes (40 by default)
+  -payload-zipf-s        <float>  exponent of zipf distribution, must be greater than 1 (1.1 by default)
+  -generator-state       <string> path of checkpoint file of rows generator, ids are continued
+                                  from checkpoint and checkpoint is updated at exit, disabled by default
+                         
   -prom-pgw              <string> prometheus push gateway
   -report-period         <int>    prometheus push period in milliseconds
+                         <string> or as duration string (e.g. 500ms, 2s)
+                         
+  -health-addr           <string> address of HTTP server with /healthz probe and /metrics
+                                  (e.g. :8080), disabled by default
                          
   -read-rps              <int>    read RPS
   -read-timeout          <int>    read timeout milliseconds
+  -read-tx-mode          <string> transaction mode of read queries:
+                                  default, snapshot (default), online or stale
                          
   -write-rps             <int>    write RPS
   -write-timeout         <int>    write timeout milliseconds
                          
+  -rate-jitter           <int>    amplitude of random shift of each read and write operation around
+                         <string> its scheduled time in milliseconds or as duration string (e.g. 50ms),
+                                  average RPS is kept, disabled by default
+  -rate-jitter-seed      <int>    seed of random shifts for reproducible runs, random by default
+                         
+  -operation-timeout     <int>    timeout of each read and write operation of workers in milliseconds
+                         <string> or as duration string (e.g. 500ms, 2s), disabled by default
+                         
+  -read-your-writes               read each written row and count violations if written value
+                                  is not visible, not compatible with stale read tx mode
+                         
+  -dead-letters-size     <int>    amount of first failed operations kept for diagnosis,
+                                  0 disables collection
+  -dead-letters-path     <string> path of file for dump of kept failed operations at shutdown
+                                  as JSON lines, disabled by default
+                         
   -time                  <int>    run time in seconds
-  -shutdown-time         <int>    graceful shutdown time in seconds
+  -shutdown-time         <int>    graceful shutdown time in seconds (drain of in-flight operations)
 `
 )
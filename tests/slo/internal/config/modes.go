@@ -1,5 +1,12 @@
 package config
 
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
 type AppMode int
 
 const (
@@ -7,3 +14,65 @@ const (
 	CleanupMode
 	RunMode
 )
+
+// ReadTxMode is a transaction mode of read workers. Implements flag.Value
+type ReadTxMode string
+
+const (
+	ReadTxModeDefault  ReadTxMode = "default"
+	ReadTxModeSnapshot ReadTxMode = "snapshot"
+	ReadTxModeOnline   ReadTxMode = "online"
+	ReadTxModeStale    ReadTxMode = "stale"
+)
+
+var readTxModes = []ReadTxMode{
+	ReadTxModeDefault,
+	ReadTxModeSnapshot,
+	ReadTxModeOnline,
+	ReadTxModeStale,
+}
+
+func (m *ReadTxMode) String() string {
+	return string(*m)
+}
+
+func (m *ReadTxMode) Set(s string) error {
+	for _, mode := range readTxModes {
+		if ReadTxMode(s) == mode {
+			*m = mode
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown read tx mode %q, expected one of %v", s, readTxModes)
+}
+
+// QueryTxControl returns transaction control of read queries over query service for the mode
+func (m ReadTxMode) QueryTxControl() *query.TransactionControl {
+	switch m {
+	case ReadTxModeDefault:
+		return query.DefaultTxControl()
+	case ReadTxModeSnapshot:
+		return query.SnapshotReadOnlyTxControl()
+	case ReadTxModeStale:
+		return query.StaleReadOnlyTxControl()
+	default:
+		return query.OnlineReadOnlyTxControl()
+	}
+}
+
+// TableTxControl returns transaction control of read queries over table service
+// (including database/sql and ORMs over it) for the mode
+func (m ReadTxMode) TableTxControl() *table.TransactionControl {
+	switch m {
+	case ReadTxModeDefault:
+		return table.DefaultTxControl()
+	case ReadTxModeSnapshot:
+		return table.SnapshotReadOnlyTxControl()
+	case ReadTxModeStale:
+		return table.StaleReadOnlyTxControl()
+	default:
+		return table.OnlineReadOnlyTxControl()
+	}
+}
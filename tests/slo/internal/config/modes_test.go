@@ -0,0 +1,82 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func TestReadTxMode(t *testing.T) {
+	for _, tt := range []struct {
+		flag  string
+		query *query.TransactionControl
+		table *table.TransactionControl
+	}{
+		{
+			flag:  "default",
+			query: query.DefaultTxControl(),
+			table: table.DefaultTxControl(),
+		},
+		{
+			flag:  "snapshot",
+			query: query.SnapshotReadOnlyTxControl(),
+			table: table.SnapshotReadOnlyTxControl(),
+		},
+		{
+			flag:  "online",
+			query: query.OnlineReadOnlyTxControl(),
+			table: table.OnlineReadOnlyTxControl(),
+		},
+		{
+			flag:  "stale",
+			query: query.StaleReadOnlyTxControl(),
+			table: table.StaleReadOnlyTxControl(),
+		},
+	} {
+		t.Run(tt.flag, func(t *testing.T) {
+			var mode ReadTxMode
+			if err := mode.Set(tt.flag); err != nil {
+				t.Fatal(err)
+			}
+			if act := mode.QueryTxControl(); !reflect.DeepEqual(act, tt.query) {
+				t.Fatalf("unexpected query tx control %v, expected %v", act, tt.query)
+			}
+			if act := mode.TableTxControl(); !reflect.DeepEqual(act, tt.table) {
+				t.Fatalf("unexpected table tx control %v, expected %v", act, tt.table)
+			}
+		})
+	}
+
+	mode := ReadTxModeSnapshot
+	if err := mode.Set("serializable"); err == nil {
+		t.Fatal("unknown read tx mode accepted")
+	}
+	if mode != ReadTxModeSnapshot {
+		t.Fatalf("read tx mode changed by unknown value: %v", mode)
+	}
+}
+
+func TestDefaultReadTxMode(t *testing.T) {
+	args := os.Args
+	defer func() {
+		os.Args = args
+	}()
+	os.Args = []string{"slo", "run", "grpc://localhost:2136", "/local"}
+
+	cfg, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.ReadTxMode != ReadTxModeSnapshot {
+		t.Fatalf("unexpected default read tx mode %v", cfg.ReadTxMode)
+	}
+	if act := cfg.ReadTxMode.QueryTxControl(); !reflect.DeepEqual(act, query.SnapshotReadOnlyTxControl()) {
+		t.Fatalf("unexpected default query tx control %v", act)
+	}
+	if act := cfg.ReadTxMode.TableTxControl(); !reflect.DeepEqual(act, table.SnapshotReadOnlyTxControl()) {
+		t.Fatalf("unexpected default table tx control %v", act)
+	}
+}
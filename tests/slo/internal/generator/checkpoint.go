@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Restore makes generator from checkpoint in file at path (see Checkpoint). If path is empty
+// or there is no checkpoint file yet, generator starts from id with new seed
+func Restore(path string, id RowID, opts ...Option) (*Generator, error) {
+	if path == "" {
+		return New(id, opts...), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return New(id, opts...), nil
+		}
+
+		return nil, fmt.Errorf("read generator state: %w", err)
+	}
+
+	var state State
+	if err = json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("decode generator state from %s: %w", path, err)
+	}
+
+	return NewFrom(state, opts...), nil
+}
+
+// Checkpoint writes state of generator into file at path for restore generator in next runs
+// with Restore. Checkpoint is no-op for empty path
+func (g *Generator) Checkpoint(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(g.State())
+	if err != nil {
+		return fmt.Errorf("encode generator state: %w", err)
+	}
+
+	// write into temporary file and rename it for keep previous checkpoint on failures
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("write generator state: %w", err)
+	}
+
+	if err = os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write generator state: %w", err)
+	}
+
+	return nil
+}
@@ -1,7 +1,6 @@
 package generator
 
 import (
-	crypto "crypto/rand"
 	"encoding/base64"
 	"math/rand"
 	"sync"
@@ -13,14 +12,62 @@ const (
 	MaxLength = 40
 )
 
+// State is a checkpoint of generator. Generator restored from the state
+// continues the sequence of rows after the checkpoint
+type State struct {
+	NextID RowID
+	Seed   int64
+}
+
 type Generator struct {
-	currentID RowID
-	mu        sync.Mutex
+	currentID   RowID
+	seed        int64
+	payloadSize Size
+	mu          sync.Mutex
+}
+
+type Option func(g *Generator)
+
+// WithPayloadSize defines distribution of payload sizes. Nil size keeps default
+// uniform distribution in [MinLength, MaxLength]
+func WithPayloadSize(size Size) Option {
+	return func(g *Generator) {
+		if size != nil {
+			g.payloadSize = size
+		}
+	}
+}
+
+func New(id RowID, opts ...Option) *Generator {
+	return NewFrom(State{
+		NextID: id,
+		Seed:   time.Now().UnixNano(),
+	}, opts...)
 }
 
-func New(id RowID) *Generator {
-	return &Generator{
-		currentID: id,
+// NewFrom creates generator from state of another (possibly finished) generator.
+// Restored generator produces the same rows if it is created with the same options
+func NewFrom(state State, opts ...Option) *Generator {
+	g := &Generator{
+		currentID:   state.NextID,
+		seed:        state.Seed,
+		payloadSize: UniformSize{Min: MinLength, Max: MaxLength},
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// State returns checkpoint of generator for restore it with NewFrom
+func (g *Generator) State() State {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return State{
+		NextID: g.currentID,
+		Seed:   g.seed,
 	}
 }
 
@@ -29,14 +76,18 @@ func (g *Generator) Generate() (Row, error) {
 	id := g.currentID
 	g.currentID++
 	g.mu.Unlock()
+
+	// payload of row depends on seed and id only, so restored generator produces the same rows
+	r := rand.New(newRowSource(g.seed, id)) //nolint:gosec // speed more important
+
 	e := Row{
 		ID:               id,
-		PayloadDouble:    func(a float64) *float64 { return &a }(rand.Float64()), //nolint:gosec // speed more important
+		PayloadDouble:    func(a float64) *float64 { return &a }(r.Float64()),
 		PayloadTimestamp: func(a time.Time) *time.Time { return &a }(time.Now()),
 	}
 
 	var err error
-	e.PayloadStr, err = g.genPayloadString()
+	e.PayloadStr, err = g.genPayloadString(r)
 	if err != nil {
 		return Row{}, err
 	}
@@ -44,12 +95,10 @@ func (g *Generator) Generate() (Row, error) {
 	return e, nil
 }
 
-func (g *Generator) genPayloadString() (*string, error) {
-	l := MinLength + rand.Intn(MaxLength-MinLength+1) //nolint:gosec // speed more important
-
-	sl := make([]byte, l)
+func (g *Generator) genPayloadString(r *rand.Rand) (*string, error) {
+	sl := make([]byte, g.payloadSize.size(r))
 
-	if _, err := crypto.Read(sl); err != nil {
+	if _, err := r.Read(sl); err != nil {
 		return nil, err
 	}
 
@@ -57,3 +106,34 @@ func (g *Generator) genPayloadString() (*string, error) {
 
 	return &s, nil
 }
+
+// rowSource is a splitmix64 random source
+type rowSource struct {
+	state uint64
+}
+
+var _ rand.Source64 = (*rowSource)(nil)
+
+func newRowSource(seed int64, id RowID) *rowSource {
+	s := &rowSource{}
+	s.Seed(seed ^ int64(id*0x9e3779b97f4a7c15)) //nolint:gosec
+
+	return s
+}
+
+func (s *rowSource) Seed(seed int64) {
+	s.state = uint64(seed)
+}
+
+func (s *rowSource) Uint64() uint64 {
+	s.state += 0x9e3779b97f4a7c15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+
+	return z ^ (z >> 31)
+}
+
+func (s *rowSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
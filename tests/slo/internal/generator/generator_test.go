@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// payload returns part of row which depends on generator state only (timestamp is a time of generation)
+func payload(t *testing.T, g *Generator) Row {
+	t.Helper()
+
+	row, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	row.PayloadTimestamp = nil
+
+	return row
+}
+
+func TestNewFrom(t *testing.T) {
+	state := State{NextID: 100, Seed: 42}
+	lhs, rhs := NewFrom(state), NewFrom(state)
+	for i := 0; i < 1000; i++ {
+		row := payload(t, lhs)
+		if row.ID != state.NextID+RowID(i) {
+			t.Fatalf("unexpected id %d of %d-th row", row.ID, i)
+		}
+		if other := payload(t, rhs); !reflect.DeepEqual(row, other) {
+			t.Fatalf("rows of generators with the same state differ: %+v != %+v", row, other)
+		}
+	}
+	if s := lhs.State(); s != (State{NextID: 1100, Seed: 42}) {
+		t.Fatalf("unexpected state %+v", s)
+	}
+
+	// generator restored from the middle continues the same sequence
+	g := NewFrom(state)
+	for i := 0; i < 500; i++ {
+		payload(t, g)
+	}
+	restored := NewFrom(g.State())
+	for i := 0; i < 500; i++ {
+		if lhs, rhs := payload(t, g), payload(t, restored); !reflect.DeepEqual(lhs, rhs) {
+			t.Fatalf("restored generator differs: %+v != %+v", lhs, rhs)
+		}
+	}
+
+	if reflect.DeepEqual(payload(t, NewFrom(State{Seed: 1})), payload(t, NewFrom(State{Seed: 2}))) {
+		t.Fatal("generators with different seeds produce the same rows")
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "generator.json")
+
+	// no checkpoint yet
+	g, err := Restore(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := g.State().NextID; id != 10 {
+		t.Fatalf("unexpected next id %d", id)
+	}
+
+	for i := 0; i < 5; i++ {
+		payload(t, g)
+	}
+	if err = g.Checkpoint(path); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, err := Restore(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lhs, rhs := g.State(), restored.State(); lhs != rhs {
+		t.Fatalf("restored state %+v differs from checkpoint %+v", rhs, lhs)
+	}
+	if lhs, rhs := payload(t, g), payload(t, restored); !reflect.DeepEqual(lhs, rhs) {
+		t.Fatalf("restored generator differs: %+v != %+v", lhs, rhs)
+	}
+
+	// checkpoint is disabled by empty path
+	g, err = Restore("", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := g.State().NextID; id != 10 {
+		t.Fatalf("unexpected next id %d", id)
+	}
+	if err = g.Checkpoint(""); err != nil {
+		t.Fatal(err)
+	}
+}
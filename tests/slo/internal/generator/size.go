@@ -0,0 +1,110 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+)
+
+var ErrWrongPayloadSize = errors.New("wrong payload size")
+
+// Size is a distribution of payload sizes. Payload of row is a base64 encoded string
+// of random bytes, Size defines amount of random bytes
+type Size interface {
+	size(r *rand.Rand) int
+}
+
+type (
+	// ConstantSize makes payloads of the same size
+	ConstantSize int
+	// UniformSize makes payloads of sizes uniformly distributed in [Min, Max]
+	UniformSize struct {
+		Min, Max int
+	}
+	// ZipfSize makes payloads of sizes in [Min, Max] with Zipf distribution: size Min+k is
+	// chosen with probability proportional to 1/(1+k)^S, so small payloads are much more frequent
+	ZipfSize struct {
+		Min, Max int
+		S        float64
+
+		zipf *zipf
+	}
+	// zipf is a Zipf distribution which is built once and generates values from source of row
+	zipf struct {
+		mu  sync.Mutex
+		src rowRand
+		z   *rand.Zipf
+	}
+	// rowRand is a source which delegates to random generator of current row
+	rowRand struct {
+		r *rand.Rand
+	}
+)
+
+func (s ConstantSize) size(*rand.Rand) int {
+	return int(s)
+}
+
+func (s UniformSize) size(r *rand.Rand) int {
+	return s.Min + r.Intn(s.Max-s.Min+1)
+}
+
+func (s ZipfSize) size(r *rand.Rand) int {
+	if s.zipf == nil {
+		return s.Min + int(rand.NewZipf(r, s.S, 1, uint64(s.Max-s.Min)).Uint64())
+	}
+
+	return s.Min + int(s.zipf.uint64(r))
+}
+
+func newZipf(s float64, imax uint64) *zipf {
+	z := &zipf{}
+	z.z = rand.NewZipf(rand.New(&z.src), s, 1, imax) //nolint:gosec // speed more important
+
+	return z
+}
+
+// uint64 returns next value of distribution with values of row random generator r.
+// It returns the same values as rand.NewZipf(r, ...).Uint64() without rebuilding of distribution
+func (z *zipf) uint64(r *rand.Rand) uint64 {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	z.src.r = r
+	defer func() {
+		z.src.r = nil
+	}()
+
+	return z.z.Uint64()
+}
+
+func (s *rowRand) Int63() int64 {
+	return s.r.Int63()
+}
+
+func (s *rowRand) Seed(int64) {}
+
+// NewSize makes payload size distribution by name (constant, uniform or zipf) and parameters.
+// Constant distribution makes payloads of max size, s is an exponent of zipf distribution
+func NewSize(distribution string, minSize, maxSize int, s float64) (Size, error) {
+	if minSize < 0 || minSize > maxSize {
+		return nil, fmt.Errorf("%w: sizes [%d, %d]", ErrWrongPayloadSize, minSize, maxSize)
+	}
+
+	switch distribution {
+	case "constant":
+		return ConstantSize(maxSize), nil
+	case "uniform":
+		return UniformSize{Min: minSize, Max: maxSize}, nil
+	case "zipf":
+		if s <= 1 {
+			return nil, fmt.Errorf("%w: zipf exponent %v must be greater than 1", ErrWrongPayloadSize, s)
+		}
+
+		return ZipfSize{Min: minSize, Max: maxSize, S: s, zipf: newZipf(s, uint64(maxSize-minSize))}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown distribution %q (expected constant, uniform or zipf)",
+			ErrWrongPayloadSize, distribution)
+	}
+}
@@ -0,0 +1,131 @@
+package generator
+
+import (
+	"encoding/base64"
+	"errors"
+	"math"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// histogram returns amount of generated rows by size of decoded payload
+func histogram(t *testing.T, size Size, n int) map[int]int {
+	t.Helper()
+
+	g := NewFrom(State{Seed: 42}, WithPayloadSize(size))
+	hist := make(map[int]int)
+	for i := 0; i < n; i++ {
+		row, err := g.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		payload, err := base64.StdEncoding.DecodeString(*row.PayloadStr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hist[len(payload)]++
+	}
+
+	return hist
+}
+
+func TestSizeHistogram(t *testing.T) {
+	const n = 100000
+
+	t.Run("Constant", func(t *testing.T) {
+		size, err := NewSize("constant", 10, 30, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hist := histogram(t, size, n); len(hist) != 1 || hist[30] != n {
+			t.Fatalf("unexpected histogram %v", hist)
+		}
+	})
+	t.Run("Uniform", func(t *testing.T) {
+		size, err := NewSize("uniform", 10, 29, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hist := histogram(t, size, n)
+		if len(hist) != 20 {
+			t.Fatalf("unexpected sizes %v", hist)
+		}
+		for s := 10; s < 30; s++ {
+			if exp := float64(n) / 20; math.Abs(float64(hist[s])-exp) > 0.05*exp {
+				t.Fatalf("frequency %d of size %d differs from expected %v", hist[s], s, exp)
+			}
+		}
+	})
+	t.Run("Zipf", func(t *testing.T) {
+		const s = 1.5
+		size, err := NewSize("zipf", 10, 29, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hist := histogram(t, size, n)
+		var norm float64
+		for k := 0; k < 20; k++ {
+			norm += math.Pow(1+float64(k), -s)
+		}
+		// most frequent sizes have enough samples for check of frequencies
+		for k := 0; k < 5; k++ {
+			if exp := float64(n) * math.Pow(1+float64(k), -s) / norm; math.Abs(float64(hist[10+k])-exp) > 0.05*exp {
+				t.Fatalf("frequency %d of size %d differs from expected %v", hist[10+k], 10+k, exp)
+			}
+		}
+		for size := range hist {
+			if size < 10 || size > 29 {
+				t.Fatalf("unexpected size %d", size)
+			}
+		}
+	})
+}
+
+func TestZipfSizeCache(t *testing.T) {
+	cached, err := NewSize("zipf", 20, 1000, 1.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncached := ZipfSize{Min: 20, Max: 1000, S: 1.1}
+	for seed := int64(0); seed < 1000; seed++ {
+		lhs := cached.size(rand.New(rand.NewSource(seed)))   //nolint:gosec
+		rhs := uncached.size(rand.New(rand.NewSource(seed))) //nolint:gosec
+		if lhs != rhs {
+			t.Fatalf("cached distribution returns %d instead of %d for seed %d", lhs, rhs, seed)
+		}
+	}
+
+	// cached distribution is shared by concurrent writers
+	g := New(0, WithPayloadSize(cached))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := g.Generate(); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewSize(t *testing.T) {
+	for _, tt := range []struct {
+		distribution string
+		min, max     int
+		s            float64
+	}{
+		{distribution: "uniform", min: 10, max: 5},
+		{distribution: "uniform", min: -1, max: 5},
+		{distribution: "zipf", min: 1, max: 5, s: 1},
+		{distribution: "normal", min: 1, max: 5},
+	} {
+		if _, err := NewSize(tt.distribution, tt.min, tt.max, tt.s); !errors.Is(err, ErrWrongPayloadSize) {
+			t.Fatalf("unexpected error %v for %+v", err, tt)
+		}
+	}
+}
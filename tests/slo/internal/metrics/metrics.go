@@ -2,9 +2,11 @@ package metrics
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/prometheus/client_golang/prometheus/push"
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 )
@@ -16,9 +18,10 @@ const (
 
 type (
 	Metrics struct {
-		p     *push.Pusher
-		ref   string
-		label string
+		p        *push.Pusher
+		registry *prometheus.Registry
+		ref      string
+		label    string
 
 		errorsTotal *prometheus.CounterVec
 
@@ -33,6 +36,17 @@ type (
 		retriesFailureTotal *prometheus.CounterVec
 
 		pendingOperations *prometheus.GaugeVec
+
+		operationOutcomesTotal *prometheus.CounterVec
+
+		readYourWritesViolationsTotal *prometheus.CounterVec
+
+		abortedAttemptsTotal *prometheus.CounterVec
+
+		retriesPerOperationMean *prometheus.GaugeVec
+		retriesPerOperationMax  *prometheus.GaugeVec
+
+		abandonedOperationsTotal *prometheus.CounterVec
 		// sdk_cpu_usage_seconds_total *prometheus.CounterVec
 		// sdk_memory_usage_bytes *prometheus.GaugeVec
 		// sdk_connections_open *prometheus.GaugeVec
@@ -148,19 +162,78 @@ func New(url, ref, label, jobName string) (*Metrics, error) {
 		[]string{"operation_type"},
 	)
 
+	m.operationOutcomesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sdk_operation_outcomes_total",
+			Help: "Total number of operations, categorized by type and outcome (retries and errors classification).",
+		},
+		[]string{"operation_type", "outcome"},
+	)
+
+	m.readYourWritesViolationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sdk_read_your_writes_violations_total",
+			Help: "Total number of reads which don't observe the value written just before, categorized by type.",
+		},
+		[]string{"operation_type"},
+	)
+
+	m.abortedAttemptsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sdk_aborted_attempts_total",
+			Help: "Total number of attempts aborted because of transaction locks invalidation, categorized by type.",
+		},
+		[]string{"operation_type"},
+	)
+
+	m.retriesPerOperationMean = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sdk_retries_per_operation_mean",
+			Help: "Mean number of retries performed by the SDK per operation in the last period, categorized by type.",
+		},
+		[]string{"operation_type"},
+	)
+
+	m.retriesPerOperationMax = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sdk_retries_per_operation_max",
+			Help: "Max number of retries performed by the SDK per operation in the last period, categorized by type.",
+		},
+		[]string{"operation_type"},
+	)
+
+	m.abandonedOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sdk_abandoned_operations_total",
+			Help: "Total number of in-flight operations abandoned after shutdown drain window, categorized by type.",
+		},
+		[]string{"operation_type"},
+	)
+
+	m.registry = prometheus.NewRegistry()
+	m.registry.MustRegister(
+		m.operationsTotal,
+		m.operationsSuccessTotal,
+		m.operationsFailureTotal,
+		m.operationLatencySeconds,
+		m.retryAttempts,
+		m.retryAttemptsTotal,
+		m.retriesSuccessTotal,
+		m.retriesFailureTotal,
+		m.pendingOperations,
+		m.operationOutcomesTotal,
+		m.readYourWritesViolationsTotal,
+		m.abortedAttemptsTotal,
+		m.retriesPerOperationMean,
+		m.retriesPerOperationMax,
+		m.abandonedOperationsTotal,
+	)
+
 	m.p = push.New(url, jobName).
 		Grouping("ref", m.ref).
 		Grouping("sdk", fmt.Sprintf("%s-%s", sdk, m.label)).
 		Grouping("sdk_version", sdkVersion).
-		Collector(m.operationsTotal).
-		Collector(m.operationsSuccessTotal).
-		Collector(m.operationsFailureTotal).
-		Collector(m.operationLatencySeconds).
-		Collector(m.retryAttempts).
-		Collector(m.retryAttemptsTotal).
-		Collector(m.retriesSuccessTotal).
-		Collector(m.retriesFailureTotal).
-		Collector(m.pendingOperations)
+		Gatherer(m.registry)
 
 	return m, m.Reset() //nolint:gocritic
 }
@@ -169,6 +242,11 @@ func (m *Metrics) Push() error {
 	return m.p.Push()
 }
 
+// Handler returns handler which serves current metrics in Prometheus text format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
 func (m *Metrics) Reset() error {
 	m.errorsTotal.Reset()
 
@@ -184,9 +262,38 @@ func (m *Metrics) Reset() error {
 
 	m.pendingOperations.Reset()
 
+	m.operationOutcomesTotal.Reset()
+
+	m.readYourWritesViolationsTotal.Reset()
+
+	m.abortedAttemptsTotal.Reset()
+
+	m.retriesPerOperationMean.Reset()
+	m.retriesPerOperationMax.Reset()
+
+	m.abandonedOperationsTotal.Reset()
+
 	return m.Push()
 }
 
+func (m *Metrics) ReadYourWritesViolation() {
+	m.readYourWritesViolationsTotal.WithLabelValues(OperationTypeRead).Add(1)
+}
+
+func (m *Metrics) AbandonedOperation(name SpanName) {
+	m.abandonedOperationsTotal.WithLabelValues(name).Add(1)
+}
+
+func (m *Metrics) AbortedAttempts(name SpanName, n uint64) {
+	m.abortedAttemptsTotal.WithLabelValues(name).Add(float64(n))
+}
+
+// RetriesPerOperation reports distribution of retries per operation in the last period
+func (m *Metrics) RetriesPerOperation(name SpanName, stats RetryStats) {
+	m.retriesPerOperationMean.WithLabelValues(name).Set(stats.Mean())
+	m.retriesPerOperationMax.WithLabelValues(name).Set(float64(stats.Max))
+}
+
 func (m *Metrics) Start(name SpanName) Span {
 	j := Span{
 		name:  name,
@@ -199,6 +306,10 @@ func (m *Metrics) Start(name SpanName) Span {
 	return j
 }
 
+func (j Span) Outcome(outcome OperationOutcome) {
+	j.m.operationOutcomesTotal.WithLabelValues(j.name, outcome).Add(1)
+}
+
 func (j Span) Finish(err error, attempts int) {
 	latency := time.Since(j.start)
 	j.m.pendingOperations.WithLabelValues(j.name).Sub(1)
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type (
+	// RetryCounter counts retries performed by SDK per logical operation
+	RetryCounter struct {
+		mu    sync.Mutex
+		stats RetryStats
+	}
+	// RetryStats describes distribution of retries per operation
+	RetryStats struct {
+		Operations uint64
+		Retries    uint64
+		Max        uint64
+	}
+)
+
+// Mean returns mean number of retries per operation
+func (s RetryStats) Mean() float64 {
+	if s.Operations == 0 {
+		return 0
+	}
+
+	return float64(s.Retries) / float64(s.Operations)
+}
+
+// Trace returns retry trace which counts retries of every retry loop of operation.
+// Nested retry loops are skipped because retries of them are counted by head loop
+func (c *RetryCounter) Trace() *trace.Retry {
+	return &trace.Retry{
+		OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+			if info.NestedCall {
+				return nil
+			}
+
+			return func(info trace.RetryLoopDoneInfo) {
+				c.Observe(info.Attempts)
+			}
+		},
+	}
+}
+
+// Observe counts operation done with attempts (first attempt is not a retry)
+func (c *RetryCounter) Observe(attempts int) {
+	var retries uint64
+	if attempts > 1 {
+		retries = uint64(attempts - 1)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.Operations++
+	c.stats.Retries += retries
+	if retries > c.stats.Max {
+		c.stats.Max = retries
+	}
+}
+
+// Swap returns stats since previous call
+func (c *RetryCounter) Swap() (stats RetryStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats, c.stats = c.stats, RetryStats{}
+
+	return stats
+}
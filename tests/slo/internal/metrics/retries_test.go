@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestRetryCounter(t *testing.T) {
+	var c RetryCounter
+
+	if stats := c.Swap(); stats != (RetryStats{}) || stats.Mean() != 0 {
+		t.Fatalf("unexpected stats without operations %+v", stats)
+	}
+
+	for _, attempts := range []int{1, 3, 0, 6} {
+		c.Observe(attempts)
+	}
+	stats := c.Swap()
+	if exp := (RetryStats{Operations: 4, Retries: 7, Max: 5}); stats != exp {
+		t.Fatalf("unexpected stats %+v, expected %+v", stats, exp)
+	}
+	if mean := stats.Mean(); mean != 1.75 {
+		t.Fatalf("unexpected mean %v", mean)
+	}
+
+	// stats are reset by swap
+	if stats = c.Swap(); stats != (RetryStats{}) {
+		t.Fatalf("stats aren't reset after swap: %+v", stats)
+	}
+}
+
+func TestRetryCounterTrace(t *testing.T) {
+	var c RetryCounter
+
+	// operations with fixed numbers of retries
+	for _, retries := range []int{0, 2, 4} {
+		attempts := 0
+		err := retry.Retry(context.Background(), func(context.Context) error {
+			attempts++
+			if attempts <= retries {
+				return retry.RetryableError(errors.New("retry me"))
+			}
+
+			return nil
+		}, retry.WithTrace(c.Trace()))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// retries of nested loop are counted by head loop
+	if done := c.Trace().OnRetry(trace.RetryLoopStartInfo{NestedCall: true}); done != nil {
+		t.Fatal("nested retry loop is observed")
+	}
+
+	stats := c.Swap()
+	if exp := (RetryStats{Operations: 3, Retries: 6, Max: 4}); stats != exp {
+		t.Fatalf("unexpected stats %+v, expected %+v", stats, exp)
+	}
+	if mean := stats.Mean(); mean != 2 {
+		t.Fatalf("unexpected mean %v", mean)
+	}
+}
@@ -19,3 +19,12 @@ const (
 	OperationStatusSuccess = "success"
 	OperationStatusFailue  = "failure"
 )
+
+type OperationOutcome = string
+
+const (
+	OperationOutcomeRetriedSuccess      OperationOutcome = "retried_success"
+	OperationOutcomeRetryableFailure    OperationOutcome = "retryable_failure"
+	OperationOutcomeNonRetryableFailure OperationOutcome = "non_retryable_failure"
+	OperationOutcomeDeadlineExceeded    OperationOutcome = "deadline_exceeded"
+)
@@ -0,0 +1,83 @@
+package workers
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"slo/internal/generator"
+	"slo/internal/metrics"
+)
+
+type (
+	// failedOperation is a record of failed read or write operation
+	failedOperation struct {
+		Time      time.Time        `json:"time"`
+		Operation metrics.SpanName `json:"operation"`
+		RowID     generator.RowID  `json:"row_id"`
+		Attempts  int              `json:"attempts"`
+		Error     string           `json:"error"`
+	}
+	// deadLetters keeps first failed operations of run up to the size limit and counts all failed operations
+	deadLetters struct {
+		mu         sync.Mutex
+		size       int
+		operations []failedOperation
+		total      uint64
+	}
+)
+
+func newDeadLetters(size int) *deadLetters {
+	return &deadLetters{
+		size:       size,
+		operations: make([]failedOperation, 0, size),
+	}
+}
+
+func (d *deadLetters) add(op metrics.SpanName, rowID generator.RowID, attempts int, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.total++
+
+	if len(d.operations) < d.size {
+		d.operations = append(d.operations, failedOperation{
+			Time:      time.Now(),
+			Operation: op,
+			RowID:     rowID,
+			Attempts:  attempts,
+			Error:     err.Error(),
+		})
+	}
+}
+
+// dump writes kept failed operations into file at path as JSON lines in order of failures
+// and returns number of written and number of all failed operations
+func (d *deadLetters) dump(path string) (written int, total uint64, _ error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, d.total, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	bw := bufio.NewWriter(f)
+	enc := json.NewEncoder(bw)
+	for i := range d.operations {
+		if err = enc.Encode(&d.operations[i]); err != nil {
+			return i, d.total, err
+		}
+	}
+
+	if err = bw.Flush(); err != nil {
+		return 0, d.total, err
+	}
+
+	return len(d.operations), d.total, f.Close()
+}
@@ -0,0 +1,104 @@
+package workers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+	"slo/internal/metrics"
+)
+
+// readDeadLetters returns failed operations dumped into file at path
+func readDeadLetters(t *testing.T, path string) (ops []failedOperation) {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var op failedOperation
+		if err = json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			t.Fatal(err)
+		}
+		ops = append(ops, op)
+	}
+	if err = scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	return ops
+}
+
+func TestDeadLetters(t *testing.T) {
+	const (
+		size     = 3
+		failures = 10
+	)
+
+	d := newDeadLetters(size)
+	for i := 0; i < failures; i++ {
+		d.add(metrics.OperationTypeWrite, generator.RowID(i), i+1, errors.New("write failed"))
+	}
+
+	path := filepath.Join(t.TempDir(), "dead-letters.jsonl")
+	written, total, err := d.dump(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != size || total != failures {
+		t.Fatalf("unexpected amount of dumped failures %d of %d", written, total)
+	}
+
+	ops := readDeadLetters(t, path)
+	if len(ops) != size {
+		t.Fatalf("unexpected amount of failures in file %d", len(ops))
+	}
+	for i, op := range ops {
+		if op.RowID != generator.RowID(i) || op.Attempts != i+1 ||
+			op.Operation != metrics.OperationTypeWrite || op.Error != "write failed" {
+			t.Fatalf("unexpected failure %d: %+v", i, op)
+		}
+	}
+}
+
+func TestDeadLettersOnClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dead-letters.jsonl")
+	w := newTestWorkers(t, &config.Config{
+		DeadLettersSize: 2,
+		DeadLettersPath: path,
+	}, &stubStorage{
+		write: func(context.Context, generator.Row) (int, error) {
+			return 2, errors.New("write failed")
+		},
+	}, nil)
+
+	gen := generator.New(0)
+	for i := 0; i < 5; i++ {
+		if err := w.write(context.Background(), gen); err == nil {
+			t.Fatal("write succeeded")
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ops := readDeadLetters(t, path)
+	if len(ops) != 2 {
+		t.Fatalf("unexpected amount of failures in file %d", len(ops))
+	}
+	for i, op := range ops {
+		if op.RowID != generator.RowID(i) || op.Attempts != 2 || op.Operation != metrics.OperationTypeWrite {
+			t.Fatalf("unexpected failure %d: %+v", i, op)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"slo/internal/metrics"
+)
+
+// errOperationAbandoned is a cause of cancellation of in-flight operation which didn't finish
+// within drain window after shutdown
+var errOperationAbandoned = errors.New("operation abandoned after shutdown drain window")
+
+// withDrain detaches operation context from cancellation of worker context, so in-flight operation
+// isn't cut off on shutdown. Operation is canceled with errOperationAbandoned if it doesn't finish
+// within cfg.ShutdownTime after shutdown. Zero shutdown time disables drain
+func (w *Workers) withDrain(ctx context.Context) (context.Context, context.CancelFunc) {
+	if w.cfg.ShutdownTime <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	opCtx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+	stopDrain := context.AfterFunc(ctx, w.startDrain)
+	stopAbandon := context.AfterFunc(w.abandonCtx, func() {
+		cancel(context.Cause(w.abandonCtx))
+	})
+
+	return opCtx, func() {
+		stopDrain()
+		stopAbandon()
+		cancel(nil)
+	}
+}
+
+// startDrain starts drain window after shutdown. In-flight operations are abandoned when window expires
+func (w *Workers) startDrain() {
+	w.drainOnce.Do(func() {
+		time.AfterFunc(time.Duration(w.cfg.ShutdownTime)*time.Second, func() {
+			w.abandon(errOperationAbandoned)
+		})
+	})
+}
+
+// countAbandoned counts operation if it was abandoned after drain window
+func (w *Workers) countAbandoned(ctx context.Context, name metrics.SpanName) {
+	if errors.Is(context.Cause(ctx), errOperationAbandoned) {
+		w.abandoned.Add(1)
+		w.m.AbandonedOperation(name)
+	}
+}
@@ -0,0 +1,66 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+	"slo/internal/metrics"
+)
+
+func TestDrain(t *testing.T) {
+	t.Run("Finished", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		w := newTestWorkers(t, &config.Config{
+			InitialDataCount: 10,
+			ShutdownTime:     1,
+		}, &stubStorage{
+			write: func(opCtx context.Context, _ generator.Row) (int, error) {
+				// operation finishes shortly after shutdown
+				cancel()
+				time.Sleep(50 * time.Millisecond)
+
+				return 1, opCtx.Err()
+			},
+		}, nil)
+
+		if err := w.write(ctx, generator.New(0)); err != nil {
+			t.Fatal(err)
+		}
+		if n := w.abandoned.Load(); n != 0 {
+			t.Fatalf("unexpected amount of abandoned operations %d", n)
+		}
+	})
+	t.Run("Abandoned", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		w := newTestWorkers(t, &config.Config{
+			InitialDataCount: 10,
+			ShutdownTime:     1,
+		}, &stubStorage{
+			read: func(opCtx context.Context, _ generator.RowID) (generator.Row, int, error) {
+				cancel()
+				<-opCtx.Done()
+
+				return generator.Row{}, 1, opCtx.Err()
+			},
+		}, nil)
+
+		start := time.Now()
+		if err := w.read(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if d := time.Since(start); d < time.Second {
+			t.Fatalf("operation abandoned before end of drain window: %v", d)
+		}
+		if n := w.abandoned.Load(); n != 1 {
+			t.Fatalf("unexpected amount of abandoned operations %d", n)
+		}
+		labels := `operation_type="` + metrics.OperationTypeRead + `"`
+		if n := metric(t, w, "sdk_abandoned_operations_total", labels); n != 1 {
+			t.Fatalf("unexpected abandoned operations metric %v", n)
+		}
+	})
+}
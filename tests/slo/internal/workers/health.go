@@ -0,0 +1,60 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"slo/internal/log"
+)
+
+const healthShutdownTimeout = 5 * time.Second
+
+// MarkStarted marks workload as running, so health probe starts to return 200
+func (w *Workers) MarkStarted() {
+	w.started.Store(true)
+}
+
+// Health serves health probe (/healthz) and current metrics (/metrics) on configured address
+// until ctx is done. Health probe returns 503 before MarkStarted call and 200 after
+func (w *Workers) Health(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(rw http.ResponseWriter, _ *http.Request) {
+		if !w.started.Load() {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+	})
+	mux.Handle("/metrics", w.m.Handler())
+
+	srv := &http.Server{
+		Addr:              w.cfg.HealthAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Second,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("health server failed: %v", err)
+		}
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), healthShutdownTimeout)
+		defer cancel()
+
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("health server shutdown failed: %v", err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package workers
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"slo/internal/config"
+)
+
+func TestHealth(t *testing.T) {
+	// reserve free port for health server
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	if err = l.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	w := newTestWorkers(t, &config.Config{HealthAddr: addr, InitialDataCount: 10}, &stubStorage{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go w.Health(ctx, &wg)
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get("http://" + addr + path) //nolint:noctx
+		if err != nil {
+			return 0, err.Error()
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return resp.StatusCode, string(body)
+	}
+
+	// wait for start of server
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		code, body := get("/healthz")
+		if code == http.StatusServiceUnavailable {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("health server isn't started: %d %s", code, body)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	w.MarkStarted()
+	if code, body := get("/healthz"); code != http.StatusOK {
+		t.Fatalf("unexpected health probe response after start: %d %s", code, body)
+	}
+	if err = w.read(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if code, body := get("/metrics"); code != http.StatusOK || !strings.Contains(body, "sdk_operations_total") {
+		t.Fatalf("unexpected metrics response: %d %s", code, body)
+	}
+
+	cancel()
+	wg.Wait()
+	if code, _ := get("/healthz"); code != 0 {
+		t.Fatalf("health server isn't stopped: %d", code)
+	}
+}
@@ -0,0 +1,86 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+var errRateLimiterBurst = errors.New("rate limiter burst is zero")
+
+// Limiter schedules operations of read and write workers
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Jitter shifts operations scheduled by rate limiters by random offset from [-amplitude, amplitude].
+// Each operation is shifted independently and schedule of rate limiter isn't changed, so average
+// rate of operations stays the same while operations aren't spaced uniformly anymore
+type Jitter struct {
+	amplitude time.Duration
+
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+// NewJitter makes jitter with given amplitude. Same seed gives same sequence of offsets.
+// Zero amplitude disables jitter
+func NewJitter(amplitude time.Duration, seed int64) *Jitter {
+	return &Jitter{
+		amplitude: amplitude,
+		r:         rand.New(rand.NewSource(seed)), //nolint:gosec // reproducibility more important
+	}
+}
+
+// Wrap returns limiter which shifts each operation scheduled by rl with jitter
+func (j *Jitter) Wrap(rl *rate.Limiter) Limiter {
+	if j.amplitude <= 0 {
+		return rl
+	}
+
+	return &jitterLimiter{rl: rl, j: j}
+}
+
+func (j *Jitter) offset() time.Duration {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return time.Duration(j.r.Int63n(2*int64(j.amplitude)+1)) - j.amplitude
+}
+
+type jitterLimiter struct {
+	rl *rate.Limiter
+	j  *Jitter
+}
+
+func (l *jitterLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r := l.rl.Reserve()
+	if !r.OK() {
+		return errRateLimiterBurst
+	}
+
+	delay := r.Delay() + l.j.offset()
+	if delay <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		r.Cancel()
+
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
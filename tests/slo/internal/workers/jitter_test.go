@@ -0,0 +1,88 @@
+package workers
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestJitterOffsets(t *testing.T) {
+	const amplitude = 4 * time.Millisecond
+
+	a, b := NewJitter(amplitude, 42), NewJitter(amplitude, 42)
+	for i := 0; i < 1000; i++ {
+		offset := a.offset()
+		if offset < -amplitude || offset > amplitude {
+			t.Fatalf("offset %v out of amplitude %v", offset, amplitude)
+		}
+		if other := b.offset(); other != offset {
+			t.Fatalf("different offsets %v and %v with same seed", offset, other)
+		}
+	}
+}
+
+func TestJitterWrap(t *testing.T) {
+	rl := rate.NewLimiter(rate.Limit(100), 1)
+	if l := NewJitter(0, 42).Wrap(rl); l != Limiter(rl) {
+		t.Fatalf("rate limiter is wrapped with disabled jitter: %T", l)
+	}
+	if _, ok := NewJitter(time.Millisecond, 42).Wrap(rl).(*jitterLimiter); !ok {
+		t.Fatal("rate limiter isn't wrapped with jitter")
+	}
+}
+
+func TestJitterSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test")
+	}
+
+	const (
+		rps       = 200
+		waits     = 100
+		amplitude = 4 * time.Millisecond
+		target    = time.Second / rps
+	)
+
+	// intervals returns mean and standard deviation of intervals between sequential waits of l
+	intervals := func(l Limiter) (mean, stddev float64) {
+		ctx := context.Background()
+		if err := l.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		values := make([]float64, 0, waits)
+		prev := time.Now()
+		for i := 0; i < waits; i++ {
+			if err := l.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+			now := time.Now()
+			values = append(values, float64(now.Sub(prev)))
+			prev = now
+		}
+		for _, v := range values {
+			mean += v
+		}
+		mean /= float64(len(values))
+		for _, v := range values {
+			stddev += (v - mean) * (v - mean)
+		}
+
+		return mean, math.Sqrt(stddev / float64(len(values)))
+	}
+
+	uniformMean, uniformStddev := intervals(NewJitter(0, 42).Wrap(rate.NewLimiter(rps, 1)))
+	jitterMean, jitterStddev := intervals(NewJitter(amplitude, 42).Wrap(rate.NewLimiter(rps, 1)))
+
+	for _, mean := range []float64{uniformMean, jitterMean} {
+		if math.Abs(mean-float64(target)) > 0.2*float64(target) {
+			t.Fatalf("average interval %v differs from target %v", time.Duration(mean), target)
+		}
+	}
+	if jitterStddev <= uniformStddev {
+		t.Fatalf("jitter doesn't spread operations: stddev %v with jitter, %v without jitter",
+			time.Duration(jitterStddev), time.Duration(uniformStddev))
+	}
+}
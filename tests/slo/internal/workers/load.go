@@ -0,0 +1,38 @@
+package workers
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+)
+
+// Load writes cfg.InitialDataCount generated rows with at most
+// cfg.InitialDataConcurrency concurrent writes. Load stops scheduling writes
+// after the first failed one and returns its error.
+func Load(ctx context.Context, cfg *config.Config, s ReadWriter, gen *generator.Generator) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(cfg.InitialDataConcurrency)
+
+	for i := uint64(0); i < cfg.InitialDataCount && ctx.Err() == nil; i++ {
+		g.Go(func() error {
+			// write may be scheduled while waiting for free slot after failure of another write
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			row, err := gen.Generate()
+			if err != nil {
+				return err
+			}
+
+			_, err = s.Write(ctx, row)
+
+			return err
+		})
+	}
+
+	return g.Wait()
+}
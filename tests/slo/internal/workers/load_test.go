@@ -0,0 +1,85 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+)
+
+func TestLoad(t *testing.T) {
+	const (
+		count       = 100
+		concurrency = 4
+	)
+
+	t.Run("BoundedConcurrency", func(t *testing.T) {
+		var (
+			inFlight, maxInFlight atomic.Int64
+			mu                    sync.Mutex
+			written               = make(map[generator.RowID]struct{})
+		)
+		err := Load(context.Background(), &config.Config{
+			InitialDataCount:       count,
+			InitialDataConcurrency: concurrency,
+		}, &stubStorage{
+			write: func(_ context.Context, row generator.Row) (int, error) {
+				n := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					m := maxInFlight.Load()
+					if n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+
+				mu.Lock()
+				defer mu.Unlock()
+				written[row.ID] = struct{}{}
+
+				return 1, nil
+			},
+		}, generator.New(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(written) != count {
+			t.Fatalf("unexpected amount of written rows %d", len(written))
+		}
+		if n := maxInFlight.Load(); n > concurrency {
+			t.Fatalf("%d concurrent writes exceed limit %d", n, concurrency)
+		}
+	})
+	t.Run("FailFast", func(t *testing.T) {
+		var (
+			errWrite = errors.New("write failed")
+			writes   atomic.Int64
+		)
+		err := Load(context.Background(), &config.Config{
+			InitialDataCount:       count,
+			InitialDataConcurrency: concurrency,
+		}, &stubStorage{
+			write: func(ctx context.Context, row generator.Row) (int, error) {
+				writes.Add(1)
+				if row.ID == 0 {
+					return 1, errWrite
+				}
+				<-ctx.Done()
+
+				return 1, ctx.Err()
+			},
+		}, generator.New(0))
+		if !errors.Is(err, errWrite) {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if n := writes.Load(); n > concurrency {
+			t.Fatalf("%d writes scheduled after failure", n-concurrency)
+		}
+	})
+}
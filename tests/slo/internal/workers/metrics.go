@@ -7,19 +7,79 @@ import (
 	"golang.org/x/time/rate"
 
 	"slo/internal/log"
+	"slo/internal/metrics"
 )
 
+// abortsCounter is an optional interface of ReadWriter which counts attempts of operations
+// aborted because of transaction locks invalidation
+type abortsCounter interface {
+	// Aborts returns numbers of aborted read and write attempts since previous call
+	Aborts() (read, write uint64)
+}
+
+// retriesCounter is an optional interface of ReadWriter which counts retries performed by SDK
+// per operation
+type retriesCounter interface {
+	// Retries returns retry stats of read and write operations since previous call
+	Retries() (read, write metrics.RetryStats)
+}
+
 func (w *Workers) Metrics(ctx context.Context, wg *sync.WaitGroup, rl *rate.Limiter) {
 	defer wg.Done()
 	for {
 		err := rl.Wait(ctx)
 		if err != nil {
+			w.flush()
+
 			return
 		}
 
+		w.countAborts()
+		w.countRetries()
+
 		err = w.m.Push()
 		if err != nil {
 			log.Printf("error while pushing: %v", err)
 		}
 	}
 }
+
+// flush waits for in-flight operations and pushes final report with operations
+// completed after the last tick
+func (w *Workers) flush() {
+	w.ops.Lock()
+	defer w.ops.Unlock()
+
+	w.countAborts()
+	w.countRetries()
+
+	if n := w.abandoned.Load(); n > 0 {
+		log.Printf("%d in-flight operations abandoned after shutdown drain window", n)
+	}
+
+	if err := w.m.Push(); err != nil {
+		log.Printf("error while final pushing: %v", err)
+	}
+}
+
+func (w *Workers) countAborts() {
+	c, ok := w.s.(abortsCounter)
+	if !ok {
+		return
+	}
+
+	read, write := c.Aborts()
+	w.m.AbortedAttempts(metrics.OperationTypeRead, read)
+	w.m.AbortedAttempts(metrics.OperationTypeWrite, write)
+}
+
+func (w *Workers) countRetries() {
+	c, ok := w.s.(retriesCounter)
+	if !ok {
+		return
+	}
+
+	read, write := c.Retries()
+	w.m.RetriesPerOperation(metrics.OperationTypeRead, read)
+	w.m.RetriesPerOperation(metrics.OperationTypeWrite, write)
+}
@@ -0,0 +1,140 @@
+package workers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+	"slo/internal/metrics"
+)
+
+func TestMetricsFinalFlush(t *testing.T) {
+	var (
+		workers atomic.Pointer[Workers]
+
+		mu sync.Mutex
+		// pushed are numbers of write operations in reports pushed by Metrics
+		pushed []float64
+	)
+	w := newTestWorkers(t, &config.Config{}, &stubStorage{}, func() {
+		if w := workers.Load(); w != nil {
+			mu.Lock()
+			defer mu.Unlock()
+
+			pushed = append(pushed, metric(t, w, "sdk_operations_total", `operation_type="write"`))
+		}
+	})
+	workers.Store(w)
+	reports := func() []float64 {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]float64(nil), pushed...)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	// the first report is pushed immediately, the next one is far after the end of test
+	go w.Metrics(ctx, &wg, rate.NewLimiter(rate.Every(time.Hour), 1))
+
+	for len(reports()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// operation completes after the last tick
+	if err := w.write(ctx, generator.New(0)); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+	wg.Wait()
+
+	if r := reports(); len(r) != 2 || r[0] != 0 || r[1] != 1 {
+		t.Fatalf("unexpected reports %v, final report must include tail operation", r)
+	}
+}
+
+// retriesStorage is a stubStorage which reports fixed retry stats
+type retriesStorage struct {
+	stubStorage
+
+	read, write metrics.RetryStats
+}
+
+func (s *retriesStorage) Retries() (read, write metrics.RetryStats) {
+	return s.read, s.write
+}
+
+func TestMetricsRetries(t *testing.T) {
+	var (
+		workers atomic.Pointer[Workers]
+
+		mu sync.Mutex
+		// pushed are mean and max retries of read and write operations in the first pushed report
+		pushed map[string]float64
+	)
+	w := newTestWorkers(t, &config.Config{}, &retriesStorage{
+		read:  metrics.RetryStats{Operations: 4, Retries: 2, Max: 1},
+		write: metrics.RetryStats{Operations: 2, Retries: 6, Max: 5},
+	}, func() {
+		w := workers.Load()
+		if w == nil {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if pushed != nil {
+			return
+		}
+		pushed = make(map[string]float64)
+		for _, op := range []metrics.SpanName{metrics.OperationTypeRead, metrics.OperationTypeWrite} {
+			labels := `operation_type="` + op + `"`
+			pushed[op+" mean"] = metric(t, w, "sdk_retries_per_operation_mean", labels)
+			pushed[op+" max"] = metric(t, w, "sdk_retries_per_operation_max", labels)
+		}
+	})
+	workers.Store(w)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go w.Metrics(ctx, &wg, rate.NewLimiter(rate.Every(time.Hour), 1))
+
+	for {
+		mu.Lock()
+		done := pushed != nil
+		mu.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	wg.Wait()
+
+	exp := map[string]float64{
+		"read mean":  0.5,
+		"read max":   1,
+		"write mean": 3,
+		"write max":  5,
+	}
+	for k, v := range exp {
+		if pushed[k] != v {
+			t.Fatalf("unexpected reported retries %v, expected %v", pushed, exp)
+		}
+	}
+}
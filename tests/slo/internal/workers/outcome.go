@@ -0,0 +1,36 @@
+package workers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"google.golang.org/grpc/codes"
+
+	"slo/internal/metrics"
+)
+
+// outcome classifies result of operation. Successful operations without retries are not classified
+func outcome(err error, attempts int) (_ metrics.OperationOutcome, ok bool) {
+	switch {
+	case err == nil && attempts > 1:
+		return metrics.OperationOutcomeRetriedSuccess, true
+	case err == nil:
+		return "", false
+	case errors.Is(err, context.DeadlineExceeded) || ydb.IsTransportError(err, codes.DeadlineExceeded):
+		return metrics.OperationOutcomeDeadlineExceeded, true
+	case retry.Check(err).MustRetry(true):
+		return metrics.OperationOutcomeRetryableFailure, true
+	default:
+		return metrics.OperationOutcomeNonRetryableFailure, true
+	}
+}
+
+func finish(span metrics.Span, err error, attempts int) {
+	span.Finish(err, attempts)
+
+	if o, ok := outcome(err, attempts); ok {
+		span.Outcome(o)
+	}
+}
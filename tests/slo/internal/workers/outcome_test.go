@@ -0,0 +1,89 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+	"slo/internal/metrics"
+)
+
+func TestOutcome(t *testing.T) {
+	errNonRetryable := errors.New("non-retryable")
+	for _, tt := range []struct {
+		name     string
+		err      error
+		attempts int
+		outcome  metrics.OperationOutcome
+	}{
+		{
+			name:     "Success",
+			attempts: 1,
+		},
+		{
+			name:     "RetriedSuccess",
+			attempts: 3,
+			outcome:  metrics.OperationOutcomeRetriedSuccess,
+		},
+		{
+			name:     "RetryableFailure",
+			err:      retry.RetryableError(errors.New("retryable")),
+			attempts: 3,
+			outcome:  metrics.OperationOutcomeRetryableFailure,
+		},
+		{
+			name:     "NonRetryableFailure",
+			err:      errNonRetryable,
+			attempts: 1,
+			outcome:  metrics.OperationOutcomeNonRetryableFailure,
+		},
+		{
+			name:     "DeadlineExceeded",
+			err:      fmt.Errorf("read: %w", context.DeadlineExceeded),
+			attempts: 2,
+			outcome:  metrics.OperationOutcomeDeadlineExceeded,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWorkers(t, &config.Config{InitialDataCount: 10}, &stubStorage{
+				read: func(_ context.Context, rowID generator.RowID) (generator.Row, int, error) {
+					return generator.Row{ID: rowID}, tt.attempts, tt.err
+				},
+				write: func(context.Context, generator.Row) (int, error) {
+					return tt.attempts, tt.err
+				},
+			}, nil)
+
+			ctx := context.Background()
+			if err := w.read(ctx); !errors.Is(err, tt.err) {
+				t.Fatalf("unexpected read error %v", err)
+			}
+			if err := w.write(ctx, generator.New(0)); !errors.Is(err, tt.err) {
+				t.Fatalf("unexpected write error %v", err)
+			}
+
+			for _, op := range []metrics.SpanName{metrics.OperationTypeRead, metrics.OperationTypeWrite} {
+				for _, outcome := range []metrics.OperationOutcome{
+					metrics.OperationOutcomeRetriedSuccess,
+					metrics.OperationOutcomeRetryableFailure,
+					metrics.OperationOutcomeNonRetryableFailure,
+					metrics.OperationOutcomeDeadlineExceeded,
+				} {
+					exp := 0.0
+					if outcome == tt.outcome {
+						exp = 1
+					}
+					labels := fmt.Sprintf(`operation_type=%q,outcome=%q`, op, outcome)
+					if act := metric(t, w, "sdk_operation_outcomes_total", labels); act != exp {
+						t.Fatalf("unexpected %v operations with %v outcome: %v", op, outcome, act)
+					}
+				}
+			}
+		})
+	}
+}
@@ -5,13 +5,11 @@ import (
 	"math/rand"
 	"sync"
 
-	"golang.org/x/time/rate"
-
 	"slo/internal/log"
 	"slo/internal/metrics"
 )
 
-func (w *Workers) Read(ctx context.Context, wg *sync.WaitGroup, rl *rate.Limiter) {
+func (w *Workers) Read(ctx context.Context, wg *sync.WaitGroup, rl Limiter) {
 	defer wg.Done()
 	for {
 		select {
@@ -38,11 +36,24 @@ func (w *Workers) Read(ctx context.Context, wg *sync.WaitGroup, rl *rate.Limiter
 func (w *Workers) read(ctx context.Context) error {
 	id := uint64(rand.Intn(int(w.cfg.InitialDataCount))) //nolint:gosec // speed more important
 
+	w.ops.RLock()
+	defer w.ops.RUnlock()
+
+	// new operations aren't issued after shutdown
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ctx, cancel := w.withOperationTimeout(ctx)
+	defer cancel()
+
 	m := w.m.Start(metrics.OperationTypeRead)
 
 	_, attempts, err := w.s.Read(ctx, id)
 
-	m.Finish(err, attempts)
+	finish(m, err, attempts)
+	w.countAbandoned(ctx, metrics.OperationTypeRead)
+	w.failed(metrics.OperationTypeRead, id, attempts, err)
 
 	return err
 }
@@ -0,0 +1,74 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+	"slo/internal/metrics"
+)
+
+func TestOperationTimeout(t *testing.T) {
+	// stub operations block until derived context of operation is done
+	w := newTestWorkers(t, &config.Config{
+		InitialDataCount: 10,
+		OperationTimeout: 10 * time.Millisecond,
+	}, &stubStorage{
+		read: func(ctx context.Context, _ generator.RowID) (generator.Row, int, error) {
+			<-ctx.Done()
+
+			return generator.Row{}, 1, ctx.Err()
+		},
+		write: func(ctx context.Context, _ generator.Row) (int, error) {
+			<-ctx.Done()
+
+			return 1, ctx.Err()
+		},
+	}, nil)
+
+	ctx := context.Background()
+	start := time.Now()
+	if err := w.read(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected read error %v", err)
+	}
+	if err := w.write(ctx, generator.New(0)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected write error %v", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("operations aren't limited by timeout: %v", d)
+	}
+
+	for _, op := range []metrics.SpanName{metrics.OperationTypeRead, metrics.OperationTypeWrite} {
+		labels := `operation_type="` + op + `",outcome="` + metrics.OperationOutcomeDeadlineExceeded + `"`
+		if n := metric(t, w, "sdk_operation_outcomes_total", labels); n != 1 {
+			t.Fatalf("unexpected %v timeouts: %v", op, n)
+		}
+	}
+
+	// worker context isn't affected by timeouts of operations
+	if err := ctx.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// derived context of operation is canceled after operation even without timeout expiration
+	var opCtx context.Context
+	w = newTestWorkers(t, &config.Config{
+		InitialDataCount: 10,
+		OperationTimeout: time.Hour,
+	}, &stubStorage{
+		read: func(ctx context.Context, rowID generator.RowID) (generator.Row, int, error) {
+			opCtx = ctx
+
+			return generator.Row{ID: rowID}, 1, nil
+		},
+	}, nil)
+	if err := w.read(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := opCtx.Err(); !errors.Is(err, context.Canceled) {
+		t.Fatalf("context of finished operation isn't canceled: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+	"slo/internal/log"
+)
+
+var ErrInitialDataMissing = errors.New("initial data missing")
+
+// Verify checks that rows written by Load are persisted. Verify reads all cfg.InitialDataCount
+// rows or cfg.VerifySampleSize random rows with at most cfg.InitialDataConcurrency concurrent reads.
+// Row is missing if it is not found or can't be read. Verify returns ErrInitialDataMissing with
+// amount of missing rows if some of checked rows are missing
+func Verify(ctx context.Context, cfg *config.Config, s ReadWriter) error {
+	ids := verifyIDs(cfg.InitialDataCount, cfg.VerifySampleSize)
+
+	var (
+		missing  atomic.Uint64
+		logError sync.Once
+	)
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(cfg.InitialDataConcurrency)
+
+	for _, id := range ids {
+		g.Go(func() error {
+			row, _, err := s.Read(ctx, id)
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			if err != nil || row.ID != id || row.PayloadStr == nil {
+				if err != nil {
+					logError.Do(func() {
+						log.Printf("verify row %d failed (next errors are not logged): %v", id, err)
+					})
+				}
+				missing.Add(1)
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	if n := missing.Load(); n > 0 {
+		return fmt.Errorf("%w: %d of %d checked rows are missing (expected %d rows)",
+			ErrInitialDataMissing, n, len(ids), cfg.InitialDataCount)
+	}
+
+	return nil
+}
+
+// verifyIDs returns ids of rows for check: all ids of count rows or sampleSize distinct random ids
+func verifyIDs(count, sampleSize uint64) []generator.RowID {
+	if sampleSize == 0 || sampleSize >= count {
+		ids := make([]generator.RowID, count)
+		for i := range ids {
+			ids[i] = generator.RowID(i)
+		}
+
+		return ids
+	}
+
+	ids := make([]generator.RowID, 0, sampleSize)
+	seen := make(map[generator.RowID]struct{}, sampleSize)
+	for uint64(len(ids)) < sampleSize {
+		id := generator.RowID(rand.Int63n(int64(count))) //nolint:gosec // speed more important
+		if _, has := seen[id]; has {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
@@ -0,0 +1,125 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+)
+
+// mapStorage keeps written rows in memory and silently drops writes of rows accepted by drop
+type mapStorage struct {
+	mu   sync.Mutex
+	rows map[generator.RowID]generator.Row
+	drop func(id generator.RowID) bool
+}
+
+func (s *mapStorage) Read(_ context.Context, rowID generator.RowID) (generator.Row, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rows[rowID], 1, nil
+}
+
+func (s *mapStorage) Write(_ context.Context, row generator.Row) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.drop == nil || !s.drop(row.ID) {
+		s.rows[row.ID] = row
+	}
+
+	return 1, nil
+}
+
+func TestVerify(t *testing.T) {
+	const count = 100
+
+	for _, tt := range []struct {
+		name       string
+		sampleSize uint64
+		drop       func(id generator.RowID) bool
+		missing    string
+	}{
+		{
+			name: "AllRows",
+		},
+		{
+			name:    "AllRowsWithLost",
+			drop:    func(id generator.RowID) bool { return id%10 == 0 },
+			missing: "10 of 100 checked rows are missing",
+		},
+		{
+			name:       "Sample",
+			sampleSize: 20,
+		},
+		{
+			name:       "SampleWithLost",
+			sampleSize: 20,
+			drop:       func(generator.RowID) bool { return true },
+			missing:    "20 of 20 checked rows are missing",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				InitialDataCount:       count,
+				InitialDataConcurrency: 4,
+				VerifySampleSize:       tt.sampleSize,
+			}
+			s := &mapStorage{
+				rows: make(map[generator.RowID]generator.Row),
+				drop: tt.drop,
+			}
+			if err := Load(context.Background(), cfg, s, generator.New(0)); err != nil {
+				t.Fatal(err)
+			}
+
+			err := Verify(context.Background(), cfg, s)
+			if tt.missing == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return
+			}
+			if !errors.Is(err, ErrInitialDataMissing) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.missing) {
+				t.Fatalf("unexpected amount of missing rows in %q", err.Error())
+			}
+		})
+	}
+}
+
+func TestVerifyIDs(t *testing.T) {
+	for _, tt := range []struct {
+		count, sampleSize uint64
+		exp               int
+	}{
+		{count: 10, sampleSize: 0, exp: 10},
+		{count: 10, sampleSize: 10, exp: 10},
+		{count: 10, sampleSize: 20, exp: 10},
+		{count: 1000, sampleSize: 999, exp: 999},
+		{count: 1000, sampleSize: 10, exp: 10},
+	} {
+		ids := verifyIDs(tt.count, tt.sampleSize)
+		if len(ids) != tt.exp {
+			t.Fatalf("unexpected amount of ids %d for count %d and sample size %d", len(ids), tt.count, tt.sampleSize)
+		}
+		seen := make(map[generator.RowID]struct{}, len(ids))
+		for _, id := range ids {
+			if uint64(id) >= tt.count {
+				t.Fatalf("id %d out of range %d", id, tt.count)
+			}
+			if _, has := seen[id]; has {
+				t.Fatalf("duplicate id %d", id)
+			}
+			seen[id] = struct{}{}
+		}
+	}
+}
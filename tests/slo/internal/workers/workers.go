@@ -2,6 +2,8 @@ package workers
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	"slo/internal/config"
 	"slo/internal/generator"
@@ -18,6 +20,22 @@ type Workers struct {
 	cfg *config.Config
 	s   ReadWriter
 	m   *metrics.Metrics
+
+	// dl keeps first failed operations, nil if collection of failed operations is disabled
+	dl *deadLetters
+
+	// ops guards in-flight operations for final metrics flush
+	ops sync.RWMutex
+
+	// started reports that all workers are started (see MarkStarted)
+	started atomic.Bool
+
+	// abandonCtx is canceled when drain window after shutdown expires (see withDrain)
+	abandonCtx context.Context
+	abandon    context.CancelCauseFunc
+	drainOnce  sync.Once
+	// abandoned counts in-flight operations which didn't finish within drain window
+	abandoned atomic.Uint64
 }
 
 func New(cfg *config.Config, s ReadWriter, ref, label, jobName string) (*Workers, error) {
@@ -28,13 +46,54 @@ func New(cfg *config.Config, s ReadWriter, ref, label, jobName string) (*Workers
 		return nil, err
 	}
 
-	return &Workers{
+	w := &Workers{
 		cfg: cfg,
 		s:   s,
 		m:   m,
-	}, nil
+	}
+	w.abandonCtx, w.abandon = context.WithCancelCause(context.Background())
+	if cfg.DeadLettersSize > 0 {
+		w.dl = newDeadLetters(cfg.DeadLettersSize)
+	}
+
+	return w, nil
+}
+
+// withOperationTimeout derives context of single read or write operation from worker context.
+// In-flight operation is drained on shutdown (see withDrain).
+// Returned cancel must be called after operation
+func (w *Workers) withOperationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := w.withDrain(ctx)
+	if w.cfg.OperationTimeout <= 0 {
+		return ctx, cancel
+	}
+
+	ctx, cancelTimeout := context.WithTimeout(ctx, w.cfg.OperationTimeout)
+
+	return ctx, func() {
+		cancelTimeout()
+		cancel()
+	}
+}
+
+// failed records failed operation if collection of failed operations is enabled
+func (w *Workers) failed(op metrics.SpanName, rowID generator.RowID, attempts int, err error) {
+	if w.dl != nil && err != nil {
+		w.dl.add(op, rowID, attempts, err)
+	}
 }
 
 func (w *Workers) Close() error {
+	if w.dl != nil && w.cfg.DeadLettersPath != "" {
+		written, total, err := w.dl.dump(w.cfg.DeadLettersPath)
+		if err != nil {
+			log.Printf("dump failed operations error: %v", err)
+		} else {
+			log.Printf("dumped %d of %d failed operations into %s", written, total, w.cfg.DeadLettersPath)
+		}
+	}
+
+	w.abandon(nil)
+
 	return w.m.Reset()
 }
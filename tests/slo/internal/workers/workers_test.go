@@ -0,0 +1,86 @@
+package workers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"slo/internal/config"
+	"slo/internal/generator"
+)
+
+// stubStorage is a ReadWriter with overridable operations. By default operations succeed
+// with single attempt and read returns row with requested id
+type stubStorage struct {
+	read  func(ctx context.Context, rowID generator.RowID) (generator.Row, int, error)
+	write func(ctx context.Context, row generator.Row) (int, error)
+}
+
+func (s *stubStorage) Read(ctx context.Context, rowID generator.RowID) (generator.Row, int, error) {
+	if s.read == nil {
+		return generator.Row{ID: rowID}, 1, nil
+	}
+
+	return s.read(ctx, rowID)
+}
+
+func (s *stubStorage) Write(ctx context.Context, row generator.Row) (int, error) {
+	if s.write == nil {
+		return 1, nil
+	}
+
+	return s.write(ctx, row)
+}
+
+// newTestWorkers makes workers over s which push metrics into stub push gateway.
+// onPush is called on each push of metrics if not nil
+func newTestWorkers(t *testing.T, cfg *config.Config, s ReadWriter, onPush func()) *Workers {
+	t.Helper()
+
+	pgw := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		if onPush != nil {
+			onPush()
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(pgw.Close)
+
+	cfg.PushGateway = pgw.URL
+
+	w, err := New(cfg, s, "ref", "label", "job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = w.Close()
+	})
+
+	return w
+}
+
+// metric returns current value of metric with labels (for example `operation_type="read"`).
+// Absent metric has zero value
+func metric(t *testing.T, w *Workers, name, labels string) float64 {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	w.m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	prefix := name + "{" + labels + "} "
+	for _, line := range strings.Split(rec.Body.String(), "\n") {
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		v, err := strconv.ParseFloat(strings.TrimPrefix(line, prefix), 64)
+		if err != nil {
+			t.Errorf("parse %q: %v", line, err)
+		}
+
+		return v
+	}
+
+	return 0
+}
@@ -4,14 +4,12 @@ import (
 	"context"
 	"sync"
 
-	"golang.org/x/time/rate"
-
 	"slo/internal/generator"
 	"slo/internal/log"
 	"slo/internal/metrics"
 )
 
-func (w *Workers) Write(ctx context.Context, wg *sync.WaitGroup, rl *rate.Limiter, gen *generator.Generator) {
+func (w *Workers) Write(ctx context.Context, wg *sync.WaitGroup, rl Limiter, gen *generator.Generator) {
 	defer wg.Done()
 	for {
 		select {
@@ -41,11 +39,75 @@ func (w *Workers) write(ctx context.Context, gen *generator.Generator) error {
 		return err
 	}
 
+	w.ops.RLock()
+	defer w.ops.RUnlock()
+
+	// new operations aren't issued after shutdown
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+
+	err = w.writeRow(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if w.cfg.ReadYourWrites {
+		return w.readYourWrite(ctx, row)
+	}
+
+	return nil
+}
+
+func (w *Workers) writeRow(ctx context.Context, row generator.Row) error {
+	ctx, cancel := w.withOperationTimeout(ctx)
+	defer cancel()
+
 	m := w.m.Start(metrics.OperationTypeWrite)
 
 	attempts, err := w.s.Write(ctx, row)
 
-	m.Finish(err, attempts)
+	finish(m, err, attempts)
+	w.countAbandoned(ctx, metrics.OperationTypeWrite)
+	w.failed(metrics.OperationTypeWrite, row.ID, attempts, err)
 
 	return err
 }
+
+// readYourWrite reads just written row and counts violation if written value is not visible.
+// All storages write rows in serializable read-write transactions, so committed row must be visible
+// to the next read in default, snapshot or online read tx mode (stale read tx mode is rejected by config).
+func (w *Workers) readYourWrite(ctx context.Context, written generator.Row) error {
+	ctx, cancel := w.withOperationTimeout(ctx)
+	defer cancel()
+
+	m := w.m.Start(metrics.OperationTypeRead)
+
+	row, attempts, err := w.s.Read(ctx, written.ID)
+
+	finish(m, err, attempts)
+	w.countAbandoned(ctx, metrics.OperationTypeRead)
+	w.failed(metrics.OperationTypeRead, written.ID, attempts, err)
+
+	if err != nil {
+		return err
+	}
+
+	if !sameRow(written, row) {
+		log.Printf("read your writes violation: row %d not visible after write", written.ID)
+		w.m.ReadYourWritesViolation()
+	}
+
+	return nil
+}
+
+func sameRow(lhs, rhs generator.Row) bool {
+	if lhs.ID != rhs.ID {
+		return false
+	}
+	if (lhs.PayloadStr == nil) != (rhs.PayloadStr == nil) {
+		return false
+	}
+
+	return lhs.PayloadStr == nil || *lhs.PayloadStr == *rhs.PayloadStr
+}
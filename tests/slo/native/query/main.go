@@ -9,7 +9,6 @@ import (
 	"syscall"
 	"time"
 
-	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 
 	"slo/internal/config"
@@ -74,32 +73,28 @@ func main() {
 		}
 		log.Println("create table ok")
 
-		gen := generator.New(0)
+		gen := generator.New(0, generator.WithPayloadSize(cfg.PayloadSize))
 
-		g := errgroup.Group{}
-
-		for i := uint64(0); i < cfg.InitialDataCount; i++ {
-			g.Go(func() (err error) {
-				e, err := gen.Generate()
-				if err != nil {
-					return err
-				}
-
-				_, err = s.Write(ctx, e)
-				if err != nil {
-					return err
-				}
-
-				return nil
-			})
-		}
-
-		err = g.Wait()
+		err = workers.Load(ctx, cfg, s, gen)
 		if err != nil {
 			panic(err)
 		}
 
 		log.Println("entries write ok")
+
+		err = gen.Checkpoint(cfg.GeneratorState)
+		if err != nil {
+			panic(fmt.Errorf("checkpoint generator failed: %w", err))
+		}
+
+		if cfg.VerifyInitialData {
+			err = workers.Verify(ctx, cfg, s)
+			if err != nil {
+				panic(fmt.Errorf("verify initial data failed: %w", err))
+			}
+
+			log.Println("initial data verify ok")
+		}
 	case config.CleanupMode:
 		err = s.DropTable(ctx)
 		if err != nil {
@@ -108,7 +103,17 @@ func main() {
 
 		log.Println("cleanup table ok")
 	case config.RunMode:
-		gen := generator.New(cfg.InitialDataCount)
+		gen, err := generator.Restore(cfg.GeneratorState, cfg.InitialDataCount,
+			generator.WithPayloadSize(cfg.PayloadSize),
+		)
+		if err != nil {
+			panic(fmt.Errorf("restore generator failed: %w", err))
+		}
+		defer func() {
+			if err := gen.Checkpoint(cfg.GeneratorState); err != nil {
+				log.Printf("checkpoint generator failed: %v", err)
+			}
+		}()
 
 		w, err := workers.New(cfg, s, ref, label, jobName)
 		if err != nil {
@@ -124,24 +129,36 @@ func main() {
 
 		wg := sync.WaitGroup{}
 
-		readRL := rate.NewLimiter(rate.Limit(cfg.ReadRPS), 1)
+		if cfg.HealthAddr != "" {
+			wg.Add(1)
+			go w.Health(ctx, &wg)
+		}
+
+		jitter := workers.NewJitter(cfg.RateJitter, cfg.RateJitterSeed)
+		if cfg.RateJitter > 0 {
+			log.Printf("rate jitter %v with seed %d", cfg.RateJitter, cfg.RateJitterSeed)
+		}
+
+		readRL := jitter.Wrap(rate.NewLimiter(rate.Limit(cfg.ReadRPS), 1))
 		wg.Add(cfg.ReadRPS)
 		for i := 0; i < cfg.ReadRPS; i++ {
 			go w.Read(ctx, &wg, readRL)
 		}
 		log.Println("started " + strconv.Itoa(cfg.ReadRPS) + " read workers")
 
-		writeRL := rate.NewLimiter(rate.Limit(cfg.WriteRPS), 1)
+		writeRL := jitter.Wrap(rate.NewLimiter(rate.Limit(cfg.WriteRPS), 1))
 		wg.Add(cfg.WriteRPS)
 		for i := 0; i < cfg.WriteRPS; i++ {
 			go w.Write(ctx, &wg, writeRL, gen)
 		}
 		log.Println("started " + strconv.Itoa(cfg.WriteRPS) + " write workers")
 
-		metricsRL := rate.NewLimiter(rate.Every(time.Duration(cfg.ReportPeriod)*time.Millisecond), 1)
+		metricsRL := rate.NewLimiter(rate.Every(cfg.ReportPeriod), 1)
 		wg.Add(1)
 		go w.Metrics(ctx, &wg, metricsRL)
 
+		w.MarkStarted()
+
 		wg.Wait()
 	default:
 		panic(fmt.Errorf("unknown mode: %v", cfg.Mode))
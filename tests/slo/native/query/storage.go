@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"sync/atomic"
 	"time"
 
 	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
@@ -15,6 +16,7 @@ import (
 
 	"slo/internal/config"
 	"slo/internal/generator"
+	"slo/internal/metrics"
 )
 
 type Storage struct {
@@ -26,6 +28,14 @@ type Storage struct {
 
 		Stop()
 	}
+
+	// readAborts and writeAborts count attempts aborted because of transaction locks invalidation
+	readAborts  atomic.Uint64
+	writeAborts atomic.Uint64
+
+	// readRetries and writeRetries count retries of read and write operations
+	readRetries  metrics.RetryCounter
+	writeRetries metrics.RetryCounter
 }
 
 const writeQuery = `
@@ -96,6 +106,26 @@ func NewStorage(ctx context.Context, cfg *config.Config, poolSize int, label str
 	return s, nil
 }
 
+// Aborts returns numbers of read and write attempts aborted because of transaction locks
+// invalidation since previous call
+func (s *Storage) Aborts() (read, write uint64) {
+	return s.readAborts.Swap(0), s.writeAborts.Swap(0)
+}
+
+// Retries returns retry stats of read and write operations since previous call
+func (s *Storage) Retries() (read, write metrics.RetryStats) {
+	return s.readRetries.Swap(), s.writeRetries.Swap()
+}
+
+// countAbort counts attempt failed with err if attempt is aborted because of transaction locks invalidation
+func countAbort(counter *atomic.Uint64, err error) error {
+	if ydb.IsOperationErrorTransactionLocksInvalidated(err) {
+		counter.Add(1)
+	}
+
+	return err
+}
+
 func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generator.Row, attempts int, finalErr error) {
 	if err := ctx.Err(); err != nil {
 		return generator.Row{}, attempts, err
@@ -108,6 +138,10 @@ func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generato
 
 	err := s.db.Query().Do(ctx,
 		func(ctx context.Context, session query.Session) (err error) {
+			defer func() {
+				err = countAbort(&s.readAborts, err)
+			}()
+
 			if err = ctx.Err(); err != nil {
 				return err
 			}
@@ -119,10 +153,7 @@ func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generato
 						Param("$id").Uint64(entryID).
 						Build(),
 				),
-				query.WithTxControl(query.TxControl(
-					query.BeginTx(query.WithOnlineReadOnly()),
-					query.CommitTx(),
-				)),
+				query.WithTxControl(s.cfg.ReadTxMode.QueryTxControl()),
 			)
 			if err != nil {
 				return err
@@ -165,6 +196,7 @@ func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generato
 			},
 		}),
 		query.WithLabel("READ"),
+		query.WithRetryTrace(s.readRetries.Trace()),
 	)
 
 	return e, attempts, err
@@ -180,7 +212,7 @@ func (s *Storage) Write(ctx context.Context, e generator.Row) (attempts int, fin
 
 	err := s.db.Query().Do(ctx,
 		func(ctx context.Context, session query.Session) (err error) {
-			return session.Exec(ctx,
+			return countAbort(&s.writeAborts, session.Exec(ctx,
 				fmt.Sprintf(writeQuery, s.tablePath),
 				query.WithParameters(
 					ydb.ParamsBuilder().
@@ -190,7 +222,7 @@ func (s *Storage) Write(ctx context.Context, e generator.Row) (attempts int, fin
 						Param("$payload_timestamp").Timestamp(*e.PayloadTimestamp).
 						Build(),
 				),
-			)
+			))
 		},
 		query.WithIdempotent(),
 		query.WithTrace(&trace.Query{
@@ -201,6 +233,7 @@ func (s *Storage) Write(ctx context.Context, e generator.Row) (attempts int, fin
 			},
 		}),
 		query.WithLabel("WRITE"),
+		query.WithRetryTrace(s.writeRetries.Trace()),
 	)
 
 	return attempts, err
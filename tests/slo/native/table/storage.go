@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -17,6 +18,7 @@ import (
 
 	"slo/internal/config"
 	"slo/internal/generator"
+	"slo/internal/metrics"
 )
 
 const (
@@ -44,13 +46,6 @@ FROM ` + "`%s`" + ` WHERE id = $id AND hash = Digest::NumericHash($id);
 )
 
 var (
-	readTx = table.TxControl(
-		table.BeginTx(
-			table.WithOnlineReadOnly(),
-		),
-		table.CommitTx(),
-	)
-
 	writeTx = table.SerializableReadWriteTxControl(
 		table.CommitTx(),
 	)
@@ -67,6 +62,10 @@ type Storage struct {
 
 		Stop()
 	}
+
+	// readRetries and writeRetries count retries of read and write operations
+	readRetries  metrics.RetryCounter
+	writeRetries metrics.RetryCounter
 }
 
 func NewStorage(ctx context.Context, cfg *config.Config, poolSize int) (*Storage, error) {
@@ -99,6 +98,11 @@ func NewStorage(ctx context.Context, cfg *config.Config, poolSize int) (*Storage
 	return s, nil
 }
 
+// Retries returns retry stats of read and write operations since previous call
+func (s *Storage) Retries() (read, write metrics.RetryStats) {
+	return s.readRetries.Swap(), s.writeRetries.Swap()
+}
+
 func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generator.Row, attempts int, err error) {
 	if err = ctx.Err(); err != nil {
 		return generator.Row{}, attempts, err
@@ -116,7 +120,7 @@ func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generato
 			}
 
 			var res result.Result
-			_, res, err = session.Execute(ctx, readTx, s.selectQuery,
+			_, res, err = session.Execute(ctx, s.cfg.ReadTxMode.TableTxControl(), s.selectQuery,
 				table.NewQueryParameters(
 					table.ValueParam("$id", types.Uint64Value(entryID)),
 				),
@@ -150,6 +154,7 @@ func (s *Storage) Read(ctx context.Context, entryID generator.RowID) (_ generato
 			return res.Err()
 		},
 		table.WithIdempotent(),
+		table.WithRetryOptions([]retry.Option{retry.WithTrace(s.readRetries.Trace())}),
 		table.WithTrace(trace.Table{
 			OnDo: func(info trace.TableDoStartInfo) func(trace.TableDoDoneInfo) {
 				return func(info trace.TableDoDoneInfo) {
@@ -196,6 +201,7 @@ func (s *Storage) Write(ctx context.Context, e generator.Row) (attempts int, _ e
 			return res.Close()
 		},
 		table.WithIdempotent(),
+		table.WithRetryOptions([]retry.Option{retry.WithTrace(s.writeRetries.Trace())}),
 		table.WithTrace(trace.Table{
 			OnDo: func(info trace.TableDoStartInfo) func(trace.TableDoDoneInfo) {
 				return func(info trace.TableDoDoneInfo) {
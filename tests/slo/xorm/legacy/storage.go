@@ -22,13 +22,6 @@ import (
 )
 
 var (
-	readTx = table.TxControl(
-		table.BeginTx(
-			table.WithOnlineReadOnly(),
-		),
-		table.CommitTx(),
-	)
-
 	writeTx = table.SerializableReadWriteTxControl(
 		table.CommitTx(),
 	)
@@ -126,7 +119,7 @@ func (s *Storage) Read(ctx context.Context, id generator.RowID) (row generator.R
 
 	row.ID = id
 
-	err = retry.Do(ydb.WithTxControl(ctx, readTx), s.x.DB().DB,
+	err = retry.Do(ydb.WithTxControl(ctx, s.cfg.ReadTxMode.TableTxControl()), s.x.DB().DB,
 		func(ctx context.Context, _ *sql.Conn) (err error) {
 			has, err := s.x.Context(ctx).Where("hash = Digest::NumericHash(?)", id).Get(&row)
 			if err != nil {
@@ -0,0 +1,93 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+var (
+	_ TopicMessageCommitReader = (*topicreader.Reader)(nil)
+
+	errWrongBatchSize = errors.New("ydb: wrong topic messages batch size")
+)
+
+// TopicMessageCommitReader is interface for read and commit topic messages, for example topicreader.Reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TopicMessageCommitReader interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+// ReadAndCommitBatch reads up to n messages, handles each of them with f and commits all read messages
+// once after all of them were handled successfully. f decodes message content with existing unmarshal
+// helpers, for example UnmarshalWithDecoder, JSONUnmarshal or ProtoUnmarshal.
+// If f returns error then messages are not committed and the error is returned.
+// If reader returns io.EOF then ReadAndCommitBatch commits messages which were read before and returns nil.
+//
+// Messages of one partition are committed with single commit range, so batch of single partition
+// is committed with single call of Commit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadAndCommitBatch(
+	ctx context.Context,
+	r TopicMessageCommitReader,
+	n int,
+	f func(msg *topicreader.Message) error,
+) error {
+	if n <= 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %d", errWrongBatchSize, n))
+	}
+
+	var ranges []topicreadercommon.CommitRange
+	for read := 0; read < n; read++ {
+		if err := ctx.Err(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		msg, err := r.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+
+		if err = f(msg); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		ranges = appendCommitRange(ranges, topicreadercommon.GetCommitRange(msg))
+	}
+
+	for i := range ranges {
+		if err := r.Commit(ctx, ranges[i]); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+// appendCommitRange extends commit range of partition session of cr or appends cr as commit range
+// of new partition session. Messages of partition session are read in order of offsets
+func appendCommitRange(
+	ranges []topicreadercommon.CommitRange, cr topicreadercommon.CommitRange,
+) []topicreadercommon.CommitRange {
+	for i := range ranges {
+		if ranges[i].PartitionSession == cr.PartitionSession {
+			ranges[i].CommitOffsetEnd = cr.CommitOffsetEnd
+
+			return ranges
+		}
+	}
+
+	return append(ranges, cr)
+}
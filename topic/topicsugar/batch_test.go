@@ -0,0 +1,174 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type testCommitReader struct {
+	messages  []*topicreader.Message
+	committed []topicreadercommon.CommitRange
+	reads     int
+}
+
+// newTestCommitReader makes reader of n json messages with offsets from 0 of partitions
+// number of messages modulo partitions
+func newTestCommitReader(ctx context.Context, n, partitions int) *testCommitReader {
+	sessions := make([]*topicreadercommon.PartitionSession, partitions)
+	for i := range sessions {
+		sessions[i] = topicreadercommon.NewPartitionSession(ctx, "topic", int64(i), 0, "", 0, 0, 0)
+	}
+
+	r := &testCommitReader{}
+	for i := 0; i < n; i++ {
+		r.messages = append(r.messages, topicreadercommon.NewPublicMessageBuilder().
+			PartitionSession(sessions[i%partitions]).
+			DataAndUncompressedSize([]byte(fmt.Sprintf(`{"n":%d}`, i))).
+			Offset(int64(i/partitions)).
+			Build(),
+		)
+	}
+
+	return r
+}
+
+func (r *testCommitReader) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	if r.reads >= len(r.messages) {
+		return nil, io.EOF
+	}
+	r.reads++
+
+	return r.messages[r.reads-1], nil
+}
+
+func (r *testCommitReader) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.committed = append(r.committed, topicreadercommon.GetCommitRange(obj))
+
+	return nil
+}
+
+// commitRange returns commit range of messages from first to last inclusive of single partition
+func commitRange(first, last *topicreader.Message) topicreadercommon.CommitRange {
+	cr := topicreadercommon.GetCommitRange(first)
+	cr.CommitOffsetEnd = topicreadercommon.GetCommitRange(last).CommitOffsetEnd
+
+	return cr
+}
+
+func TestReadAndCommitBatch(t *testing.T) {
+	type testStruct struct {
+		N int `json:"n"`
+	}
+	decode := func(handled *[]int) func(msg *topicreader.Message) error {
+		return func(msg *topicreader.Message) error {
+			var v testStruct
+			if err := UnmarshalWithDecoder(msg, JSONDecoder, &v); err != nil {
+				return err
+			}
+			*handled = append(*handled, v.N)
+
+			return nil
+		}
+	}
+	t.Run("FullBatch", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 5, 1)
+		var handled []int
+		err := ReadAndCommitBatch(ctx, r, 3, decode(&handled))
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1, 2}, handled)
+		require.Equal(t, []topicreadercommon.CommitRange{commitRange(r.messages[0], r.messages[2])}, r.committed)
+		require.Equal(t, 3, r.reads)
+	})
+	t.Run("SeveralPartitions", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 6, 2)
+		var handled []int
+		err := ReadAndCommitBatch(ctx, r, 5, decode(&handled))
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1, 2, 3, 4}, handled)
+		require.Equal(t, []topicreadercommon.CommitRange{
+			commitRange(r.messages[0], r.messages[4]),
+			commitRange(r.messages[1], r.messages[3]),
+		}, r.committed)
+	})
+	t.Run("EarlyError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 5, 1)
+		testErr := errors.New("test")
+		err := ReadAndCommitBatch(ctx, r, 3, func(msg *topicreader.Message) error {
+			if msg.Offset == 1 {
+				return testErr
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, testErr)
+		require.Empty(t, r.committed)
+		require.Equal(t, 2, r.reads)
+	})
+	t.Run("DecodeError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 5, 1)
+		r.messages[1] = topicreadercommon.NewPublicMessageBuilder().
+			PartitionSession(topicreadercommon.GetCommitRange(r.messages[0]).PartitionSession).
+			DataAndUncompressedSize([]byte("{")).
+			Offset(1).
+			Build()
+		var handled []int
+		err := ReadAndCommitBatch(ctx, r, 3, decode(&handled))
+		require.Error(t, err)
+		require.Equal(t, []int{0}, handled)
+		require.Empty(t, r.committed)
+	})
+	t.Run("ShortBatch", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 2, 1)
+		var handled []int
+		err := ReadAndCommitBatch(ctx, r, 3, decode(&handled))
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1}, handled)
+		require.Equal(t, []topicreadercommon.CommitRange{commitRange(r.messages[0], r.messages[1])}, r.committed)
+	})
+	t.Run("EmptyStream", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 0, 1)
+		err := ReadAndCommitBatch(ctx, r, 3, func(msg *topicreader.Message) error {
+			return nil
+		})
+		require.NoError(t, err)
+		require.Empty(t, r.committed)
+	})
+	t.Run("WrongSize", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		r := newTestCommitReader(ctx, 5, 1)
+		for _, n := range []int{0, -1} {
+			err := ReadAndCommitBatch(ctx, r, n, func(msg *topicreader.Message) error {
+				return nil
+			})
+			require.ErrorIs(t, err, errWrongBatchSize)
+		}
+		require.Zero(t, r.reads)
+	})
+	t.Run("CanceledContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(xtest.Context(t))
+		r := newTestCommitReader(ctx, 5, 1)
+		err := ReadAndCommitBatch(ctx, r, 3, func(msg *topicreader.Message) error {
+			cancel()
+
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Empty(t, r.committed)
+		require.Equal(t, 1, r.reads)
+	})
+}
@@ -0,0 +1,47 @@
+package topicsugar
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// DecodeToChan reads messages from r, decodes them with decode and sends decoded values to out
+// in order of messages. It runs until ctx is done or read or decode fails and returns the error
+// (ctx.Err() after ctx is done). Sending to out is interrupted by ctx, so DecodeToChan returns
+// promptly after cancel even if nobody receives from out.
+// DecodeToChan never closes out: the channel is owned by the caller.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DecodeToChan[T any](
+	ctx context.Context,
+	r TopicMessageReader,
+	out chan<- T,
+	decode func(msg *topicreader.Message) (T, error),
+) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		msg, err := r.ReadMessage(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+
+			return err
+		}
+
+		v, err := decode(msg)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case out <- v:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+func TestDecodeToChan(t *testing.T) {
+	offset := func(msg *topicreader.Message) (int64, error) {
+		return msg.Offset, nil
+	}
+	t.Run("OrderedDelivery", func(t *testing.T) {
+		errRead := errors.New("read error")
+		out := make(chan int64, 3)
+		err := DecodeToChan(xtest.Context(t), &testMessageReader{
+			messages: testMessages(1, 2, 3),
+			err:      errRead,
+		}, out, offset)
+		require.ErrorIs(t, err, errRead)
+		require.Len(t, out, 3)
+		require.Equal(t, []int64{1, 2, 3}, []int64{<-out, <-out, <-out})
+	})
+	t.Run("DecodeError", func(t *testing.T) {
+		errDecode := errors.New("decode error")
+		out := make(chan int64, 3)
+		err := DecodeToChan(xtest.Context(t), &testMessageReader{
+			messages: testMessages(1, 2, 3),
+		}, out, func(msg *topicreader.Message) (int64, error) {
+			if msg.Offset == 2 {
+				return 0, errDecode
+			}
+
+			return msg.Offset, nil
+		})
+		require.ErrorIs(t, err, errDecode)
+		require.Len(t, out, 1)
+		require.EqualValues(t, 1, <-out)
+	})
+	t.Run("CancelOnRead", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(xtest.Context(t))
+		out := make(chan int64)
+		done := make(chan error, 1)
+		go func() {
+			done <- DecodeToChan(ctx, &testMessageReader{messages: testMessages(1, 2)}, out, offset)
+		}()
+		require.EqualValues(t, 1, <-out)
+		require.EqualValues(t, 2, <-out)
+		cancel()
+		select {
+		case err := <-done:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("DecodeToChan not returned after cancel")
+		}
+		// out isn't closed
+		select {
+		case _, ok := <-out:
+			require.True(t, ok)
+		default:
+		}
+	})
+	t.Run("CancelOnSend", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(xtest.Context(t))
+		out := make(chan int64)
+		done := make(chan error, 1)
+		r := &testMessageReader{messages: testMessages(1, 2, 3)}
+		go func() {
+			done <- DecodeToChan(ctx, r, out, offset)
+		}()
+		require.EqualValues(t, 1, <-out)
+		// nobody receives second value
+		cancel()
+		select {
+		case err := <-done:
+			require.ErrorIs(t, err, context.Canceled)
+		case <-time.After(time.Second):
+			t.Fatal("DecodeToChan not returned after cancel")
+		}
+		require.Equal(t, 2, r.reads)
+	})
+}
@@ -0,0 +1,108 @@
+//go:build go1.23
+
+package topicsugar
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type (
+	decodeOptions struct {
+		skipErrors bool
+	}
+
+	// DecodeOption is an option of DecodeJSON and DecodeProto iterators
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	DecodeOption func(opts *decodeOptions)
+)
+
+// WithSkipDecodeErrors makes decode iterators skip messages which can't be decoded
+// instead of yielding decode error
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSkipDecodeErrors() DecodeOption {
+	return func(opts *decodeOptions) {
+		opts.skipErrors = true
+	}
+}
+
+// DecodeJSON returns iterator over values of T unmarshalled from json content of topic messages
+//
+//	for v, err := range topicsugar.DecodeJSON[Event](ctx, r) {
+//		...
+//	}
+//
+// Decode error is yielded with zero value, iteration continues until the caller breaks the loop.
+// Read errors and context done ends iteration same as Messages.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DecodeJSON[T any](ctx context.Context, r TopicMessageReader, opts ...DecodeOption) xiter.Seq2[T, error] {
+	return decode(ctx, r, func(mess *topicreader.Message) (v T, _ error) {
+		if err := UnmarshalWithDecoder(mess, JSONDecoder, &v); err != nil {
+			var zero T
+
+			return zero, err
+		}
+
+		return v, nil
+	}, opts...)
+}
+
+// DecodeProto returns iterator over protobuf messages of type T unmarshalled from content of topic messages
+//
+// Decode error is yielded with zero value, iteration continues until the caller breaks the loop.
+// Read errors and context done ends iteration same as Messages.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DecodeProto[T proto.Message](
+	ctx context.Context, r TopicMessageReader, opts ...DecodeOption,
+) xiter.Seq2[T, error] {
+	return decode(ctx, r, func(mess *topicreader.Message) (v T, _ error) {
+		v = v.ProtoReflect().New().Interface().(T) //nolint:forcetypeassert
+		if err := UnmarshalWithDecoder(mess, ProtoDecoder, v); err != nil {
+			var zero T
+
+			return zero, err
+		}
+
+		return v, nil
+	}, opts...)
+}
+
+func decode[T any](
+	ctx context.Context, r TopicMessageReader, decodeMessage func(mess *topicreader.Message) (T, error),
+	opts ...DecodeOption,
+) xiter.Seq2[T, error] {
+	var options decodeOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	return func(yield func(T, error) bool) {
+		for mess, err := range Messages(ctx, r) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+
+				return
+			}
+
+			v, err := decodeMessage(mess)
+			if err != nil && options.skipErrors {
+				continue
+			}
+
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+//go:build go1.23
+
+package topicsugar
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+var errEndOfTopic = errors.New("end of topic")
+
+func testDataMessages(payloads ...[]byte) []*topicreader.Message {
+	messages := make([]*topicreader.Message, 0, len(payloads))
+	for _, data := range payloads {
+		messages = append(messages, topicreadercommon.NewPublicMessageBuilder().
+			DataAndUncompressedSize(data).
+			Build(),
+		)
+	}
+
+	return messages
+}
+
+func TestDecodeJSON(t *testing.T) {
+	type event struct {
+		ID int `json:"id"`
+	}
+	newReader := func() *testMessageReader {
+		return &testMessageReader{
+			messages: testDataMessages([]byte(`{"id":1}`), []byte(`{"id":`), []byte(`{"id":3}`)),
+			err:      errEndOfTopic,
+		}
+	}
+
+	t.Run("YieldDecodeErrors", func(t *testing.T) {
+		var (
+			events []event
+			errs   []error
+		)
+		for v, err := range DecodeJSON[event](xtest.Context(t), newReader()) {
+			events = append(events, v)
+			errs = append(errs, err)
+		}
+		require.Equal(t, []event{{ID: 1}, {}, {ID: 3}, {}}, events)
+		require.Len(t, errs, 4)
+		require.NoError(t, errs[0])
+		require.Error(t, errs[1])
+		require.NotErrorIs(t, errs[1], errEndOfTopic)
+		require.NoError(t, errs[2])
+		require.ErrorIs(t, errs[3], errEndOfTopic)
+	})
+	t.Run("SkipDecodeErrors", func(t *testing.T) {
+		var events []event
+		for v, err := range DecodeJSON[event](xtest.Context(t), newReader(), WithSkipDecodeErrors()) {
+			if err != nil {
+				require.ErrorIs(t, err, errEndOfTopic)
+
+				break
+			}
+			events = append(events, v)
+		}
+		require.Equal(t, []event{{ID: 1}, {ID: 3}}, events)
+	})
+	t.Run("BreakOnDecodeError", func(t *testing.T) {
+		r := newReader()
+
+		var events []event
+		for v, err := range DecodeJSON[event](xtest.Context(t), r) {
+			if err != nil {
+				break
+			}
+			events = append(events, v)
+		}
+		require.Equal(t, []event{{ID: 1}}, events)
+		require.Equal(t, 2, r.reads)
+	})
+}
+
+func TestDecodeProto(t *testing.T) {
+	valid := func(v string) []byte {
+		data, err := proto.Marshal(wrapperspb.String(v))
+		require.NoError(t, err)
+
+		return data
+	}
+	r := &testMessageReader{
+		messages: testDataMessages(valid("a"), []byte{0xff}, valid("c")),
+		err:      errEndOfTopic,
+	}
+
+	var (
+		values []string
+		errs   int
+	)
+	for v, err := range DecodeProto[*wrapperspb.StringValue](xtest.Context(t), r) {
+		if err != nil {
+			require.Nil(t, v)
+			if errors.Is(err, errEndOfTopic) {
+				break
+			}
+			errs++
+
+			continue
+		}
+		values = append(values, v.GetValue())
+	}
+	require.Equal(t, []string{"a", "c"}, values)
+	require.Equal(t, 1, errs)
+}
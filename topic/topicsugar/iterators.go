@@ -13,13 +13,6 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
 )
 
-// TopicMessageReader is interface for topicreader.Message
-//
-// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
-type TopicMessageReader interface {
-	ReadMessage(ctx context.Context) (*topicreader.Message, error)
-}
-
 // TopicMessageIterator iterator wrapper over topic reader
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
@@ -38,6 +31,37 @@ func TopicMessageIterator(ctx context.Context, r TopicMessageReader) xiter.Seq2[
 	}
 }
 
+// Messages returns iterator over messages of topic reader
+//
+//	for msg, err := range topicsugar.Messages(ctx, r) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Iteration stops without error when ctx is done. Read error is yielded with nil message and ends iteration.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Messages(ctx context.Context, r TopicMessageReader) xiter.Seq2[*topicreader.Message, error] {
+	return func(yield func(*topicreader.Message, error) bool) {
+		for {
+			mess, err := r.ReadMessage(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					yield(nil, err)
+				}
+
+				return
+			}
+
+			if !yield(mess, nil) {
+				return
+			}
+		}
+	}
+}
+
 // BytesIterator produce iterator over topic messages with Data as []byte, []byte is content of the message
 func BytesIterator(
 	ctx context.Context,
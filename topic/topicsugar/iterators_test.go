@@ -0,0 +1,66 @@
+//go:build go1.23
+
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestMessages(t *testing.T) {
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(xtest.Context(t))
+		defer cancel()
+		r := &testMessageReader{messages: testMessages(1, 2, 3)}
+
+		var offsets []int64
+		for mess, err := range Messages(ctx, r) {
+			require.NoError(t, err)
+			offsets = append(offsets, mess.Offset)
+			if len(offsets) == 3 {
+				cancel()
+			}
+		}
+		require.Equal(t, []int64{1, 2, 3}, offsets)
+	})
+	t.Run("ReadError", func(t *testing.T) {
+		errRead := errors.New("read error")
+		r := &testMessageReader{messages: testMessages(1, 2), err: errRead}
+
+		var (
+			offsets []int64
+			errs    []error
+		)
+		for mess, err := range Messages(xtest.Context(t), r) {
+			if err != nil {
+				require.Nil(t, mess)
+				errs = append(errs, err)
+
+				continue
+			}
+			offsets = append(offsets, mess.Offset)
+		}
+		require.Equal(t, []int64{1, 2}, offsets)
+		require.Equal(t, []error{errRead}, errs)
+		require.Equal(t, 3, r.reads)
+	})
+	t.Run("Break", func(t *testing.T) {
+		r := &testMessageReader{messages: testMessages(1, 2, 3)}
+
+		var offsets []int64
+		for mess, err := range Messages(xtest.Context(t), r) {
+			require.NoError(t, err)
+			offsets = append(offsets, mess.Offset)
+			if mess.Offset == 2 {
+				break
+			}
+		}
+		require.Equal(t, []int64{1, 2}, offsets)
+		require.Equal(t, 2, r.reads)
+	})
+}
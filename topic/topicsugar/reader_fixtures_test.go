@@ -0,0 +1,41 @@
+package topicsugar
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// testMessageReader returns fixed messages, then err (if not nil) or blocks until ctx is done
+type testMessageReader struct {
+	messages []*topicreader.Message
+	err      error
+	reads    int
+}
+
+func (r *testMessageReader) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	r.reads++
+	if len(r.messages) > 0 {
+		mess := r.messages[0]
+		r.messages = r.messages[1:]
+
+		return mess, nil
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+func testMessages(offsets ...int64) []*topicreader.Message {
+	messages := make([]*topicreader.Message, 0, len(offsets))
+	for _, offset := range offsets {
+		messages = append(messages, topicreadercommon.NewPublicMessageBuilder().Offset(offset).Build())
+	}
+
+	return messages
+}
@@ -0,0 +1,93 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+var (
+	// ErrRangeCompacted returns by ReadRange if messages at start of range are not available in the partition anymore
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ErrRangeCompacted = errors.New("ydb: topic offset range is compacted")
+
+	errWrongOffsetRange = errors.New("ydb: wrong topic offset range")
+)
+
+// ReadRangeStartOffset returns reader option which seeks the partition to start offset.
+// Reader for ReadRange must be created with the option and with topicoptions.CommitModeNone
+// commit mode, for example
+//
+//	reader, err := db.Topic().StartReader(consumer, topicoptions.ReadTopic(topic),
+//		topicsugar.ReadRangeStartOffset(partition, start),
+//		topicoptions.WithReaderCommitMode(topicoptions.CommitModeNone),
+//	)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadRangeStartOffset(partition, start int64) topicoptions.ReaderOption {
+	return topicoptions.WithReaderGetPartitionStartOffset(
+		func(
+			ctx context.Context, req topicoptions.GetPartitionStartOffsetRequest,
+		) (res topicoptions.GetPartitionStartOffsetResponse, err error) {
+			if req.PartitionID == partition {
+				res.StartFrom(start)
+			}
+
+			return res, nil
+		},
+	)
+}
+
+// ReadRange reads messages of the partition with offsets from start to end inclusive and calls f for each
+// of them. ReadRange returns after message with end offset or first message after end.
+// Messages of other partitions and messages before start are skipped. ReadRange never commits messages.
+//
+// ReadRange returns ErrRangeCompacted if first available message of the partition is after start offset.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadRange(
+	ctx context.Context,
+	r TopicMessageReader,
+	partition, start, end int64,
+	f func(*topicreader.Message) error,
+) error {
+	if start < 0 || start > end {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: [%d, %d]", errWrongOffsetRange, start, end))
+	}
+
+	for first := true; ; {
+		mess, err := r.ReadMessage(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if mess.PartitionID() != partition || mess.Offset < start {
+			continue
+		}
+
+		if first && mess.Offset > start {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w: offsets [%d, %d] of partition %d requested, but first available offset is %d",
+				ErrRangeCompacted, start, end, partition, mess.Offset,
+			))
+		}
+		first = false
+
+		if mess.Offset > end {
+			return nil
+		}
+
+		if err = f(mess); err != nil {
+			return err
+		}
+
+		if mess.Offset == end {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package topicsugar
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+func TestReadRange(t *testing.T) {
+	readRange := func(r *testMessageReader, start, end int64) (offsets []int64, _ error) {
+		err := ReadRange(xtest.Context(t), r, 0, start, end, func(mess *topicreader.Message) error {
+			offsets = append(offsets, mess.Offset)
+
+			return nil
+		})
+
+		return offsets, err
+	}
+	t.Run("FullRange", func(t *testing.T) {
+		r := &testMessageReader{messages: testMessages(3, 4, 5, 6, 7)}
+		otherPartition := topicreadercommon.NewPublicMessageBuilder().PartitionID(1).Offset(5).Build()
+		r.messages = append(r.messages[:2], append([]*topicreader.Message{otherPartition}, r.messages[2:]...)...)
+
+		offsets, err := readRange(r, 4, 6)
+		require.NoError(t, err)
+		require.Equal(t, []int64{4, 5, 6}, offsets)
+		require.Len(t, r.messages, 1)
+	})
+	t.Run("SingleOffset", func(t *testing.T) {
+		r := &testMessageReader{messages: testMessages(4, 5)}
+
+		offsets, err := readRange(r, 4, 4)
+		require.NoError(t, err)
+		require.Equal(t, []int64{4}, offsets)
+	})
+	t.Run("GapAtEnd", func(t *testing.T) {
+		r := &testMessageReader{messages: testMessages(4, 5, 9)}
+
+		offsets, err := readRange(r, 4, 7)
+		require.NoError(t, err)
+		require.Equal(t, []int64{4, 5}, offsets)
+	})
+	t.Run("PartiallyCompacted", func(t *testing.T) {
+		r := &testMessageReader{messages: testMessages(6, 7)}
+
+		offsets, err := readRange(r, 4, 7)
+		require.ErrorIs(t, err, ErrRangeCompacted)
+		require.ErrorContains(t, err, "first available offset is 6")
+		require.Empty(t, offsets)
+	})
+	t.Run("FullyCompacted", func(t *testing.T) {
+		r := &testMessageReader{messages: testMessages(10)}
+
+		offsets, err := readRange(r, 4, 7)
+		require.ErrorIs(t, err, ErrRangeCompacted)
+		require.Empty(t, offsets)
+	})
+	t.Run("ReadError", func(t *testing.T) {
+		testErr := errors.New("test")
+		r := &testMessageReader{messages: testMessages(4), err: testErr}
+
+		offsets, err := readRange(r, 4, 7)
+		require.ErrorIs(t, err, testErr)
+		require.Equal(t, []int64{4}, offsets)
+	})
+	t.Run("CallbackError", func(t *testing.T) {
+		testErr := errors.New("test")
+		r := &testMessageReader{messages: testMessages(4, 5)}
+
+		err := ReadRange(xtest.Context(t), r, 0, 4, 5, func(mess *topicreader.Message) error {
+			return testErr
+		})
+		require.ErrorIs(t, err, testErr)
+		require.Equal(t, 1, r.reads)
+	})
+	t.Run("WrongRange", func(t *testing.T) {
+		r := &testMessageReader{}
+
+		_, err := readRange(r, 5, 4)
+		require.ErrorIs(t, err, errWrongOffsetRange)
+		_, err = readRange(r, -1, 4)
+		require.ErrorIs(t, err, errWrongOffsetRange)
+		require.Zero(t, r.reads)
+	})
+}
@@ -1,13 +1,22 @@
 package topicsugar
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
 )
 
+// TopicMessageReader is interface for topicreader.Message
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TopicMessageReader interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+}
+
 // ProtoUnmarshal unmarshal message content to protobuf struct
 func ProtoUnmarshal(msg *topicreader.Message, dst proto.Message) error {
 	return msg.UnmarshalTo(protobufUnmarshaler{dst: dst})
@@ -24,6 +33,28 @@ func UnmarshalMessageWith(msg *topicreader.Message, unmarshaler UnmarshalFunc, v
 	return msg.UnmarshalTo(messageUnmarshaler{unmarshaler: unmarshaler, dst: v})
 }
 
+// UnmarshalMessageWithMeta call unmarshaller func with message and its content.
+// Message fields (metadata, offset, write timestamp, etc) are available in unmarshaller func.
+// unmarshaller func must not use received byte slice after return.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalMessageWithMeta(msg *topicreader.Message, unmarshaler MessageUnmarshalFunc, v interface{}) error {
+	return msg.UnmarshalTo(messageUnmarshaler{
+		unmarshaler: func(data []byte, dst interface{}) error {
+			return unmarshaler(msg, data, dst)
+		},
+		dst: v,
+	})
+}
+
+// UnmarshalWithDecoder decode message content to dst with dec.
+// Decoder must not use received byte slice after return.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalWithDecoder(msg *topicreader.Message, dec Decoder, dst interface{}) error {
+	return UnmarshalMessageWith(msg, dec.Decode, dst)
+}
+
 // ReadMessageDataWithCallback receive full content of message as data slice MUST not be used after return from f.
 // if you need content after return from function - copy it with
 // copy(dst, data) to another byte slice
@@ -42,6 +73,48 @@ func (c messageUnmarhalerToCallback) UnmarshalYDBTopicMessage(data []byte) error
 // json.Unmarshal from standard library
 type UnmarshalFunc func(data []byte, dst interface{}) error
 
+// Decode implements Decoder
+func (f UnmarshalFunc) Decode(data []byte, dst interface{}) error {
+	return f(data, dst)
+}
+
+// Decoder is interface for custom message content formats (CBOR, protobuf Any, custom binary, etc).
+// Decode must not use received byte slice after return, copy it if content is needed later.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Decoder interface {
+	Decode(data []byte, dst interface{}) error
+}
+
+var (
+	// JSONDecoder decode json message content, dst must be pointer
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	JSONDecoder Decoder = UnmarshalFunc(json.Unmarshal)
+
+	// ProtoDecoder decode protobuf message content, dst must be proto.Message
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ProtoDecoder Decoder = protoDecoder{}
+)
+
+type protoDecoder struct{}
+
+// Decode implements Decoder
+func (protoDecoder) Decode(data []byte, dst interface{}) error {
+	m, ok := dst.(proto.Message)
+	if !ok {
+		return fmt.Errorf("ydb: proto decoder: dst of type %T is not proto.Message", dst)
+	}
+
+	return proto.Unmarshal(data, m)
+}
+
+// MessageUnmarshalFunc is func to unmarshal data to interface with access to message fields
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type MessageUnmarshalFunc func(msg *topicreader.Message, data []byte, dst interface{}) error
+
 type protobufUnmarshaler struct {
 	dst proto.Message
 }
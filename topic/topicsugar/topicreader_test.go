@@ -0,0 +1,110 @@
+package topicsugar
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+func TestUnmarshalMessageWithMeta(t *testing.T) {
+	writtenAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg := topicreadercommon.NewPublicMessageBuilder().
+		DataAndUncompressedSize([]byte(`{"a":"test"}`)).
+		Metadata(map[string][]byte{"trace-id": []byte("123")}).
+		Offset(10).
+		WrittenAt(writtenAt).
+		Build()
+
+	type testStruct struct {
+		A       string `json:"a"`
+		TraceID string `json:"-"`
+	}
+
+	var dst testStruct
+	err := UnmarshalMessageWithMeta(msg, func(msg *topicreader.Message, data []byte, dst interface{}) error {
+		require.EqualValues(t, 10, msg.Offset)
+		require.Equal(t, writtenAt, msg.WrittenAt)
+
+		v := dst.(*testStruct)
+		v.TraceID = string(msg.Metadata["trace-id"])
+
+		return json.Unmarshal(data, v)
+	}, &dst)
+	require.NoError(t, err)
+	require.Equal(t, testStruct{A: "test", TraceID: "123"}, dst)
+}
+
+type countingDecoder struct {
+	calls    int
+	received [][]byte
+}
+
+func (d *countingDecoder) Decode(data []byte, dst interface{}) error {
+	d.calls++
+	// data must not be used after return, so decoder keeps copy of it
+	d.received = append(d.received, append([]byte(nil), data...))
+	*(dst.(*string)) = string(data)
+
+	return nil
+}
+
+func TestUnmarshalWithDecoder(t *testing.T) {
+	t.Run("Custom", func(t *testing.T) {
+		dec := &countingDecoder{}
+
+		var first, second string
+		require.NoError(t, UnmarshalWithDecoder(
+			topicreadercommon.NewPublicMessageBuilder().DataAndUncompressedSize([]byte("first")).Build(),
+			dec, &first,
+		))
+		require.NoError(t, UnmarshalWithDecoder(
+			topicreadercommon.NewPublicMessageBuilder().DataAndUncompressedSize([]byte("second")).Build(),
+			dec, &second,
+		))
+
+		require.Equal(t, 2, dec.calls)
+		require.Equal(t, "first", first)
+		require.Equal(t, "second", second)
+		require.Equal(t, [][]byte{[]byte("first"), []byte("second")}, dec.received)
+	})
+	t.Run("ReadTwice", func(t *testing.T) {
+		dec := &countingDecoder{}
+		msg := topicreadercommon.NewPublicMessageBuilder().DataAndUncompressedSize([]byte("test")).Build()
+
+		var dst string
+		require.NoError(t, UnmarshalWithDecoder(msg, dec, &dst))
+		require.Error(t, UnmarshalWithDecoder(msg, dec, &dst))
+		require.Equal(t, 1, dec.calls)
+	})
+	t.Run("JSON", func(t *testing.T) {
+		msg := topicreadercommon.NewPublicMessageBuilder().DataAndUncompressedSize([]byte(`{"a":"test"}`)).Build()
+
+		var dst struct {
+			A string `json:"a"`
+		}
+		require.NoError(t, UnmarshalWithDecoder(msg, JSONDecoder, &dst))
+		require.Equal(t, "test", dst.A)
+	})
+	t.Run("Proto", func(t *testing.T) {
+		data, err := proto.Marshal(wrapperspb.String("test"))
+		require.NoError(t, err)
+		msg := topicreadercommon.NewPublicMessageBuilder().DataAndUncompressedSize(data).Build()
+
+		dst := &wrapperspb.StringValue{}
+		require.NoError(t, UnmarshalWithDecoder(msg, ProtoDecoder, dst))
+		require.Equal(t, "test", dst.GetValue())
+	})
+	t.Run("ProtoNotMessage", func(t *testing.T) {
+		msg := topicreadercommon.NewPublicMessageBuilder().DataAndUncompressedSize([]byte("test")).Build()
+
+		var dst string
+		require.Error(t, UnmarshalWithDecoder(msg, ProtoDecoder, &dst))
+	})
+}
@@ -0,0 +1,64 @@
+package topicsugar
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+// MessageOption sets optional fields of message for write
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type MessageOption func(msg *topicwriter.Message)
+
+// WithMessageMetadata adds metadata item to message
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMessageMetadata(key string, value []byte) MessageOption {
+	return func(msg *topicwriter.Message) {
+		if msg.Metadata == nil {
+			msg.Metadata = make(map[string][]byte)
+		}
+		msg.Metadata[key] = value
+	}
+}
+
+// ProtoMarshal marshal protobuf struct to message content
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ProtoMarshal(src proto.Message, opts ...MessageOption) (topicwriter.Message, error) {
+	data, err := proto.Marshal(src)
+	if err != nil {
+		return topicwriter.Message{}, err
+	}
+
+	return newMessage(data, opts...), nil
+}
+
+// JSONMarshal marshal src to json message content
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func JSONMarshal(src interface{}, opts ...MessageOption) (topicwriter.Message, error) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return topicwriter.Message{}, err
+	}
+
+	return newMessage(data, opts...), nil
+}
+
+func newMessage(data []byte, opts ...MessageOption) topicwriter.Message {
+	msg := topicwriter.Message{
+		Data: bytes.NewReader(data),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&msg)
+		}
+	}
+
+	return msg
+}
@@ -0,0 +1,64 @@
+package topicsugar
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+// readerMessage converts written message to message as it would be read by topic reader
+func readerMessage(t *testing.T, msg topicwriter.Message) *topicreader.Message {
+	t.Helper()
+
+	data, err := io.ReadAll(msg.Data)
+	require.NoError(t, err)
+
+	return topicreadercommon.NewPublicMessageBuilder().
+		DataAndUncompressedSize(data).
+		Metadata(msg.Metadata).
+		Build()
+}
+
+func TestJSONMarshal(t *testing.T) {
+	type testStruct struct {
+		A string `json:"a"`
+		B int    `json:"b"`
+	}
+
+	msg, err := JSONMarshal(testStruct{A: "test", B: 42},
+		WithMessageMetadata("key", []byte("value")),
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"key": []byte("value")}, msg.Metadata)
+
+	readMsg := readerMessage(t, msg)
+	var dst testStruct
+	require.NoError(t, JSONUnmarshal(readMsg, &dst))
+	require.Equal(t, testStruct{A: "test", B: 42}, dst)
+	require.Equal(t, []byte("value"), readMsg.Metadata["key"])
+}
+
+func TestJSONMarshalError(t *testing.T) {
+	_, err := JSONMarshal(make(chan int))
+	require.Error(t, err)
+}
+
+func TestProtoMarshal(t *testing.T) {
+	msg, err := ProtoMarshal(wrapperspb.String("test"),
+		WithMessageMetadata("a", []byte("1")),
+		WithMessageMetadata("b", []byte("2")),
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, msg.Metadata)
+
+	var dst wrapperspb.StringValue
+	require.NoError(t, ProtoUnmarshal(readerMessage(t, msg), &dst))
+	require.True(t, proto.Equal(wrapperspb.String("test"), &dst))
+}
@@ -0,0 +1,73 @@
+package trace
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicPolicy is a handling of panic recovered in hook after call of panic callback
+// (see WithDriverPanicPolicy, WithQueryPanicPolicy and others)
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+type PanicPolicy uint8
+
+const (
+	// PanicPolicySwallow calls panic callback and suppresses panic (default).
+	// Panic is recovered only if panic callback specified
+	PanicPolicySwallow = PanicPolicy(iota)
+	// PanicPolicyLogAndContinue logs panic with stack trace (see PanicLogger), calls panic callback
+	// and suppresses panic even without panic callback
+	PanicPolicyLogAndContinue
+	// PanicPolicyRepanic calls panic callback and propagates panic to caller of hook
+	PanicPolicyRepanic
+)
+
+// PanicLogger logs panic recovered in hook with PanicPolicyLogAndContinue policy.
+// Use log.PanicLogger for logging panics with SDK logger
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+type PanicLogger func(hook string, e interface{}, stack []byte)
+
+func (p PanicPolicy) String() string {
+	switch p {
+	case PanicPolicySwallow:
+		return "swallow"
+	case PanicPolicyLogAndContinue:
+		return "log-and-continue"
+	case PanicPolicyRepanic:
+		return "repanic"
+	default:
+		return fmt.Sprintf("PanicPolicy(%d)", uint8(p))
+	}
+}
+
+// mustBeKnown panics on unknown panic policy for prevent silent swallowing of panics in hooks
+func (p PanicPolicy) mustBeKnown() {
+	if p > PanicPolicyRepanic {
+		panic(fmt.Sprintf("unknown panic policy %v", p))
+	}
+}
+
+// hookPanicCallback wraps panic callback of hook according to panic policy
+func (p PanicPolicy) hookPanicCallback(hook string, cb func(e interface{}), l PanicLogger) func(e interface{}) {
+	switch p {
+	case PanicPolicyLogAndContinue:
+		return func(e interface{}) {
+			if l != nil {
+				l(hook, e, debug.Stack())
+			}
+			if cb != nil {
+				cb(e)
+			}
+		}
+	case PanicPolicyRepanic:
+		if cb == nil {
+			return nil
+		}
+
+		return func(e interface{}) {
+			cb(e)
+			panic(e)
+		}
+	default:
+		return cb
+	}
+}
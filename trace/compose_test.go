@@ -0,0 +1,173 @@
+package trace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposeHookPanicCallback(t *testing.T) {
+	var common, onNew []interface{}
+	x := &Query{
+		OnNew: func(QueryNewStartInfo) func(QueryNewDoneInfo) {
+			panic("OnNew")
+		},
+		OnClose: func(QueryCloseStartInfo) func(QueryCloseDoneInfo) {
+			return func(QueryCloseDoneInfo) {
+				panic("OnClose")
+			}
+		},
+	}
+	composed := (&Query{}).Compose(x,
+		WithQueryPanicCallback(func(e interface{}) {
+			common = append(common, e)
+		}),
+		WithQueryHookPanicCallback("OnNew", func(e interface{}) {
+			onNew = append(onNew, e)
+		}),
+	)
+
+	require.NotPanics(t, func() {
+		composed.OnNew(QueryNewStartInfo{})
+	})
+	require.Equal(t, []interface{}{"OnNew"}, onNew)
+	require.Empty(t, common)
+
+	require.NotPanics(t, func() {
+		composed.OnClose(QueryCloseStartInfo{})(QueryCloseDoneInfo{})
+	})
+	require.Equal(t, []interface{}{"OnNew"}, onNew)
+	require.Equal(t, []interface{}{"OnClose"}, common)
+}
+
+func TestComposeHookPanicCallbackNil(t *testing.T) {
+	composed := (&Query{}).Compose(
+		&Query{
+			OnNew: func(QueryNewStartInfo) func(QueryNewDoneInfo) {
+				panic("OnNew")
+			},
+		},
+		WithQueryPanicCallback(func(e interface{}) {}),
+		WithQueryHookPanicCallback("OnNew", nil),
+	)
+
+	require.PanicsWithValue(t, "OnNew", func() {
+		composed.OnNew(QueryNewStartInfo{})
+	})
+}
+
+func TestComposeHookPanicCallbackUnknownHook(t *testing.T) {
+	require.PanicsWithValue(t, "unknown Query hook OnUnknown", func() {
+		WithQueryHookPanicCallback("OnUnknown", func(e interface{}) {})
+	})
+	require.PanicsWithValue(t, "unknown Driver hook OnNew", func() {
+		WithDriverHookPanicCallback("OnNew", func(e interface{}) {})
+	})
+	require.NotPanics(t, func() {
+		WithDriverHookPanicCallback("OnInit", func(e interface{}) {})
+	})
+}
+
+func TestComposePanicPolicy(t *testing.T) {
+	x := &Query{
+		OnNew: func(QueryNewStartInfo) func(QueryNewDoneInfo) {
+			panic("OnNew")
+		},
+	}
+	for _, tt := range []struct {
+		name     string
+		policy   PanicPolicy
+		callback bool
+		panics   bool
+	}{
+		{
+			name:     "Default",
+			callback: true,
+			panics:   false,
+		},
+		{
+			name:   "DefaultWithoutCallback",
+			panics: true,
+		},
+		{
+			name:     "Swallow",
+			policy:   PanicPolicySwallow,
+			callback: true,
+			panics:   false,
+		},
+		{
+			name:     "LogAndContinue",
+			policy:   PanicPolicyLogAndContinue,
+			callback: true,
+			panics:   false,
+		},
+		{
+			name:   "LogAndContinueWithoutCallback",
+			policy: PanicPolicyLogAndContinue,
+			panics: false,
+		},
+		{
+			name:     "Repanic",
+			policy:   PanicPolicyRepanic,
+			callback: true,
+			panics:   true,
+		},
+		{
+			name:   "RepanicWithoutCallback",
+			policy: PanicPolicyRepanic,
+			panics: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var recovered []interface{}
+			opts := []QueryComposeOption{
+				WithQueryPanicPolicy(tt.policy),
+			}
+			if tt.callback {
+				opts = append(opts, WithQueryPanicCallback(func(e interface{}) {
+					recovered = append(recovered, e)
+				}))
+			}
+			composed := (&Query{}).Compose(x, opts...)
+			if tt.panics {
+				require.PanicsWithValue(t, "OnNew", func() {
+					composed.OnNew(QueryNewStartInfo{})
+				})
+			} else {
+				require.NotPanics(t, func() {
+					composed.OnNew(QueryNewStartInfo{})
+				})
+			}
+			if tt.callback {
+				require.Equal(t, []interface{}{"OnNew"}, recovered)
+			}
+		})
+	}
+}
+
+func TestComposePanicPolicyLogger(t *testing.T) {
+	var logged []string
+	composed := (&Query{}).Compose(
+		&Query{
+			OnNew: func(QueryNewStartInfo) func(QueryNewDoneInfo) {
+				panic("OnNew")
+			},
+		},
+		WithQueryPanicPolicy(PanicPolicyLogAndContinue),
+		WithQueryPanicLogger(func(hook string, e interface{}, stack []byte) {
+			require.NotEmpty(t, stack)
+			logged = append(logged, fmt.Sprintf("%s: %v", hook, e))
+		}),
+	)
+	require.NotPanics(t, func() {
+		composed.OnNew(QueryNewStartInfo{})
+	})
+	require.Equal(t, []string{"Query.OnNew: OnNew"}, logged)
+}
+
+func TestComposeUnknownPanicPolicy(t *testing.T) {
+	require.PanicsWithValue(t, "unknown panic policy PanicPolicy(3)", func() {
+		WithQueryPanicPolicy(PanicPolicyRepanic + 1)
+	})
+}
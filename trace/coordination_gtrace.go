@@ -11,7 +11,20 @@ import (
 
 // coordinationComposeOptions is a holder of options
 type coordinationComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *coordinationComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Coordination."+hook, cb, o.panicLogger)
 }
 
 // CoordinationOption specified Coordination compose option
@@ -26,6 +39,67 @@ func WithCoordinationPanicCallback(cb func(e interface{})) CoordinationComposeOp
 	}
 }
 
+// WithCoordinationPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithCoordinationPanicPolicy(policy PanicPolicy) CoordinationComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *coordinationComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithCoordinationPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithCoordinationPanicLogger(l PanicLogger) CoordinationComposeOption {
+	return func(o *coordinationComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// coordinationHooks is a set of names of Coordination hooks
+var coordinationHooks = map[string]struct{}{
+	"OnNew": {},
+	"OnCreateNode": {},
+	"OnAlterNode": {},
+	"OnDropNode": {},
+	"OnDescribeNode": {},
+	"OnSession": {},
+	"OnClose": {},
+	"OnSessionNewStream": {},
+	"OnSessionStarted": {},
+	"OnSessionStartTimeout": {},
+	"OnSessionKeepAliveTimeout": {},
+	"OnSessionStopped": {},
+	"OnSessionStopTimeout": {},
+	"OnSessionClientTimeout": {},
+	"OnSessionServerExpire": {},
+	"OnSessionServerError": {},
+	"OnSessionReceive": {},
+	"OnSessionReceiveUnexpected": {},
+	"OnSessionStop": {},
+	"OnSessionStart": {},
+	"OnSessionSend": {},
+}
+
+// WithCoordinationHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithCoordinationPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithCoordinationHookPanicCallback(hook string, cb func(e interface{})) CoordinationComposeOption {
+	if _, has := coordinationHooks[hook]; !has {
+		panic("unknown Coordination hook " + hook)
+	}
+
+	return func(o *coordinationComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Coordination which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOption) *Coordination {
@@ -42,11 +116,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnNew
 		h2 := x.OnNew
+		panicCallback := options.hookPanicCallback("OnNew")
 		ret.OnNew = func(c CoordinationNewStartInfo) func(CoordinationNewDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -58,10 +133,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationNewDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -77,11 +152,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnCreateNode
 		h2 := x.OnCreateNode
+		panicCallback := options.hookPanicCallback("OnCreateNode")
 		ret.OnCreateNode = func(c CoordinationCreateNodeStartInfo) func(CoordinationCreateNodeDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -93,10 +169,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationCreateNodeDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -112,11 +188,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnAlterNode
 		h2 := x.OnAlterNode
+		panicCallback := options.hookPanicCallback("OnAlterNode")
 		ret.OnAlterNode = func(c CoordinationAlterNodeStartInfo) func(CoordinationAlterNodeDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -128,10 +205,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationAlterNodeDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -147,11 +224,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnDropNode
 		h2 := x.OnDropNode
+		panicCallback := options.hookPanicCallback("OnDropNode")
 		ret.OnDropNode = func(c CoordinationDropNodeStartInfo) func(CoordinationDropNodeDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -163,10 +241,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationDropNodeDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -182,11 +260,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnDescribeNode
 		h2 := x.OnDescribeNode
+		panicCallback := options.hookPanicCallback("OnDescribeNode")
 		ret.OnDescribeNode = func(c CoordinationDescribeNodeStartInfo) func(CoordinationDescribeNodeDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -198,10 +277,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationDescribeNodeDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -217,11 +296,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSession
 		h2 := x.OnSession
+		panicCallback := options.hookPanicCallback("OnSession")
 		ret.OnSession = func(c CoordinationSessionStartInfo) func(CoordinationSessionDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -233,10 +313,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationSessionDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -252,11 +332,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnClose
 		h2 := x.OnClose
+		panicCallback := options.hookPanicCallback("OnClose")
 		ret.OnClose = func(c CoordinationCloseStartInfo) func(CoordinationCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -268,10 +349,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -287,11 +368,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionNewStream
 		h2 := x.OnSessionNewStream
+		panicCallback := options.hookPanicCallback("OnSessionNewStream")
 		ret.OnSessionNewStream = func(c CoordinationSessionNewStreamStartInfo) func(CoordinationSessionNewStreamDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -303,10 +385,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationSessionNewStreamDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -322,11 +404,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionStarted
 		h2 := x.OnSessionStarted
+		panicCallback := options.hookPanicCallback("OnSessionStarted")
 		ret.OnSessionStarted = func(c CoordinationSessionStartedInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -341,11 +424,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionStartTimeout
 		h2 := x.OnSessionStartTimeout
+		panicCallback := options.hookPanicCallback("OnSessionStartTimeout")
 		ret.OnSessionStartTimeout = func(c CoordinationSessionStartTimeoutInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -360,11 +444,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionKeepAliveTimeout
 		h2 := x.OnSessionKeepAliveTimeout
+		panicCallback := options.hookPanicCallback("OnSessionKeepAliveTimeout")
 		ret.OnSessionKeepAliveTimeout = func(c CoordinationSessionKeepAliveTimeoutInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -379,11 +464,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionStopped
 		h2 := x.OnSessionStopped
+		panicCallback := options.hookPanicCallback("OnSessionStopped")
 		ret.OnSessionStopped = func(c CoordinationSessionStoppedInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -398,11 +484,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionStopTimeout
 		h2 := x.OnSessionStopTimeout
+		panicCallback := options.hookPanicCallback("OnSessionStopTimeout")
 		ret.OnSessionStopTimeout = func(c CoordinationSessionStopTimeoutInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -417,11 +504,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionClientTimeout
 		h2 := x.OnSessionClientTimeout
+		panicCallback := options.hookPanicCallback("OnSessionClientTimeout")
 		ret.OnSessionClientTimeout = func(c CoordinationSessionClientTimeoutInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -436,11 +524,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionServerExpire
 		h2 := x.OnSessionServerExpire
+		panicCallback := options.hookPanicCallback("OnSessionServerExpire")
 		ret.OnSessionServerExpire = func(c CoordinationSessionServerExpireInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -455,11 +544,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionServerError
 		h2 := x.OnSessionServerError
+		panicCallback := options.hookPanicCallback("OnSessionServerError")
 		ret.OnSessionServerError = func(c CoordinationSessionServerErrorInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -474,11 +564,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionReceive
 		h2 := x.OnSessionReceive
+		panicCallback := options.hookPanicCallback("OnSessionReceive")
 		ret.OnSessionReceive = func(c CoordinationSessionReceiveStartInfo) func(CoordinationSessionReceiveDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -490,10 +581,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationSessionReceiveDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -509,11 +600,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionReceiveUnexpected
 		h2 := x.OnSessionReceiveUnexpected
+		panicCallback := options.hookPanicCallback("OnSessionReceiveUnexpected")
 		ret.OnSessionReceiveUnexpected = func(c CoordinationSessionReceiveUnexpectedInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -528,11 +620,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionStop
 		h2 := x.OnSessionStop
+		panicCallback := options.hookPanicCallback("OnSessionStop")
 		ret.OnSessionStop = func(c CoordinationSessionStopInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -547,11 +640,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionStart
 		h2 := x.OnSessionStart
+		panicCallback := options.hookPanicCallback("OnSessionStart")
 		ret.OnSessionStart = func(c CoordinationSessionStartStartInfo) func(CoordinationSessionStartDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -563,10 +657,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationSessionStartDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -582,11 +676,12 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	{
 		h1 := t.OnSessionSend
 		h2 := x.OnSessionSend
+		panicCallback := options.hookPanicCallback("OnSessionSend")
 		ret.OnSessionSend = func(c CoordinationSessionSendStartInfo) func(CoordinationSessionSendDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -598,10 +693,10 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 				r1 = h2(c)
 			}
 			return func(c CoordinationSessionSendDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -616,6 +711,164 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 	}
 	return &ret
 }
+// ComposeAll returns a new Coordination which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Coordination) ComposeAll(others ...*Coordination) *Coordination {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Coordination{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Coordination) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnNew != nil {
+		return false
+	}
+	if t.OnCreateNode != nil {
+		return false
+	}
+	if t.OnAlterNode != nil {
+		return false
+	}
+	if t.OnDropNode != nil {
+		return false
+	}
+	if t.OnDescribeNode != nil {
+		return false
+	}
+	if t.OnSession != nil {
+		return false
+	}
+	if t.OnClose != nil {
+		return false
+	}
+	if t.OnSessionNewStream != nil {
+		return false
+	}
+	if t.OnSessionStarted != nil {
+		return false
+	}
+	if t.OnSessionStartTimeout != nil {
+		return false
+	}
+	if t.OnSessionKeepAliveTimeout != nil {
+		return false
+	}
+	if t.OnSessionStopped != nil {
+		return false
+	}
+	if t.OnSessionStopTimeout != nil {
+		return false
+	}
+	if t.OnSessionClientTimeout != nil {
+		return false
+	}
+	if t.OnSessionServerExpire != nil {
+		return false
+	}
+	if t.OnSessionServerError != nil {
+		return false
+	}
+	if t.OnSessionReceive != nil {
+		return false
+	}
+	if t.OnSessionReceiveUnexpected != nil {
+		return false
+	}
+	if t.OnSessionStop != nil {
+		return false
+	}
+	if t.OnSessionStart != nil {
+		return false
+	}
+	if t.OnSessionSend != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Coordination) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnNew != nil {
+		hooks = append(hooks, "OnNew")
+	}
+	if t.OnCreateNode != nil {
+		hooks = append(hooks, "OnCreateNode")
+	}
+	if t.OnAlterNode != nil {
+		hooks = append(hooks, "OnAlterNode")
+	}
+	if t.OnDropNode != nil {
+		hooks = append(hooks, "OnDropNode")
+	}
+	if t.OnDescribeNode != nil {
+		hooks = append(hooks, "OnDescribeNode")
+	}
+	if t.OnSession != nil {
+		hooks = append(hooks, "OnSession")
+	}
+	if t.OnClose != nil {
+		hooks = append(hooks, "OnClose")
+	}
+	if t.OnSessionNewStream != nil {
+		hooks = append(hooks, "OnSessionNewStream")
+	}
+	if t.OnSessionStarted != nil {
+		hooks = append(hooks, "OnSessionStarted")
+	}
+	if t.OnSessionStartTimeout != nil {
+		hooks = append(hooks, "OnSessionStartTimeout")
+	}
+	if t.OnSessionKeepAliveTimeout != nil {
+		hooks = append(hooks, "OnSessionKeepAliveTimeout")
+	}
+	if t.OnSessionStopped != nil {
+		hooks = append(hooks, "OnSessionStopped")
+	}
+	if t.OnSessionStopTimeout != nil {
+		hooks = append(hooks, "OnSessionStopTimeout")
+	}
+	if t.OnSessionClientTimeout != nil {
+		hooks = append(hooks, "OnSessionClientTimeout")
+	}
+	if t.OnSessionServerExpire != nil {
+		hooks = append(hooks, "OnSessionServerExpire")
+	}
+	if t.OnSessionServerError != nil {
+		hooks = append(hooks, "OnSessionServerError")
+	}
+	if t.OnSessionReceive != nil {
+		hooks = append(hooks, "OnSessionReceive")
+	}
+	if t.OnSessionReceiveUnexpected != nil {
+		hooks = append(hooks, "OnSessionReceiveUnexpected")
+	}
+	if t.OnSessionStop != nil {
+		hooks = append(hooks, "OnSessionStop")
+	}
+	if t.OnSessionStart != nil {
+		hooks = append(hooks, "OnSessionStart")
+	}
+	if t.OnSessionSend != nil {
+		hooks = append(hooks, "OnSessionSend")
+	}
+	return hooks
+}
 func (t *Coordination) onNew(c CoordinationNewStartInfo) func(CoordinationNewDoneInfo) {
 	fn := t.OnNew
 	if fn == nil {
@@ -8,7 +8,20 @@ import (
 
 // discoveryComposeOptions is a holder of options
 type discoveryComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *discoveryComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Discovery."+hook, cb, o.panicLogger)
 }
 
 // DiscoveryOption specified Discovery compose option
@@ -23,6 +36,48 @@ func WithDiscoveryPanicCallback(cb func(e interface{})) DiscoveryComposeOption {
 	}
 }
 
+// WithDiscoveryPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDiscoveryPanicPolicy(policy PanicPolicy) DiscoveryComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *discoveryComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithDiscoveryPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDiscoveryPanicLogger(l PanicLogger) DiscoveryComposeOption {
+	return func(o *discoveryComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// discoveryHooks is a set of names of Discovery hooks
+var discoveryHooks = map[string]struct{}{
+	"OnDiscover": {},
+	"OnWhoAmI": {},
+}
+
+// WithDiscoveryHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithDiscoveryPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDiscoveryHookPanicCallback(hook string, cb func(e interface{})) DiscoveryComposeOption {
+	if _, has := discoveryHooks[hook]; !has {
+		panic("unknown Discovery hook " + hook)
+	}
+
+	return func(o *discoveryComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Discovery which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Discovery) Compose(x *Discovery, opts ...DiscoveryComposeOption) *Discovery {
@@ -39,11 +94,12 @@ func (t *Discovery) Compose(x *Discovery, opts ...DiscoveryComposeOption) *Disco
 	{
 		h1 := t.OnDiscover
 		h2 := x.OnDiscover
+		panicCallback := options.hookPanicCallback("OnDiscover")
 		ret.OnDiscover = func(d DiscoveryDiscoverStartInfo) func(DiscoveryDiscoverDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -55,10 +111,10 @@ func (t *Discovery) Compose(x *Discovery, opts ...DiscoveryComposeOption) *Disco
 				r1 = h2(d)
 			}
 			return func(d DiscoveryDiscoverDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -74,11 +130,12 @@ func (t *Discovery) Compose(x *Discovery, opts ...DiscoveryComposeOption) *Disco
 	{
 		h1 := t.OnWhoAmI
 		h2 := x.OnWhoAmI
+		panicCallback := options.hookPanicCallback("OnWhoAmI")
 		ret.OnWhoAmI = func(d DiscoveryWhoAmIStartInfo) func(DiscoveryWhoAmIDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -90,10 +147,10 @@ func (t *Discovery) Compose(x *Discovery, opts ...DiscoveryComposeOption) *Disco
 				r1 = h2(d)
 			}
 			return func(d DiscoveryWhoAmIDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -108,6 +165,50 @@ func (t *Discovery) Compose(x *Discovery, opts ...DiscoveryComposeOption) *Disco
 	}
 	return &ret
 }
+// ComposeAll returns a new Discovery which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Discovery) ComposeAll(others ...*Discovery) *Discovery {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Discovery{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Discovery) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnDiscover != nil {
+		return false
+	}
+	if t.OnWhoAmI != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Discovery) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnDiscover != nil {
+		hooks = append(hooks, "OnDiscover")
+	}
+	if t.OnWhoAmI != nil {
+		hooks = append(hooks, "OnWhoAmI")
+	}
+	return hooks
+}
 func (t *Discovery) onDiscover(d DiscoveryDiscoverStartInfo) func(DiscoveryDiscoverDoneInfo) {
 	fn := t.OnDiscover
 	if fn == nil {
@@ -8,7 +8,20 @@ import (
 
 // driverComposeOptions is a holder of options
 type driverComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *driverComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Driver."+hook, cb, o.panicLogger)
 }
 
 // DriverOption specified Driver compose option
@@ -23,6 +36,71 @@ func WithDriverPanicCallback(cb func(e interface{})) DriverComposeOption {
 	}
 }
 
+// WithDriverPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDriverPanicPolicy(policy PanicPolicy) DriverComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *driverComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithDriverPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDriverPanicLogger(l PanicLogger) DriverComposeOption {
+	return func(o *driverComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// driverHooks is a set of names of Driver hooks
+var driverHooks = map[string]struct{}{
+	"OnInit": {},
+	"OnWith": {},
+	"OnClose": {},
+	"OnPoolNew": {},
+	"OnPoolRelease": {},
+	"OnResolve": {},
+	"OnConnStateChange": {},
+	"OnConnInvoke": {},
+	"OnConnNewStream": {},
+	"OnConnStreamRecvMsg": {},
+	"OnConnStreamSendMsg": {},
+	"OnConnStreamCloseSend": {},
+	"OnConnStreamFinish": {},
+	"OnConnDial": {},
+	"OnConnBan": {},
+	"OnConnAllow": {},
+	"OnConnPark": {},
+	"OnConnClose": {},
+	"OnRepeaterWakeUp": {},
+	"OnBalancerInit": {},
+	"OnBalancerClose": {},
+	"OnBalancerChooseEndpoint": {},
+	"OnBalancerClusterDiscoveryAttempt": {},
+	"OnBalancerUpdate": {},
+	"OnGetCredentials": {},
+}
+
+// WithDriverHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithDriverPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDriverHookPanicCallback(hook string, cb func(e interface{})) DriverComposeOption {
+	if _, has := driverHooks[hook]; !has {
+		panic("unknown Driver hook " + hook)
+	}
+
+	return func(o *driverComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Driver which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
@@ -39,11 +117,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnInit
 		h2 := x.OnInit
+		panicCallback := options.hookPanicCallback("OnInit")
 		ret.OnInit = func(d DriverInitStartInfo) func(DriverInitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -55,10 +134,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverInitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -74,11 +153,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnWith
 		h2 := x.OnWith
+		panicCallback := options.hookPanicCallback("OnWith")
 		ret.OnWith = func(d DriverWithStartInfo) func(DriverWithDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -90,10 +170,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverWithDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -109,11 +189,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnClose
 		h2 := x.OnClose
+		panicCallback := options.hookPanicCallback("OnClose")
 		ret.OnClose = func(d DriverCloseStartInfo) func(DriverCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -125,10 +206,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -144,11 +225,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnPoolNew
 		h2 := x.OnPoolNew
+		panicCallback := options.hookPanicCallback("OnPoolNew")
 		ret.OnPoolNew = func(d DriverConnPoolNewStartInfo) func(DriverConnPoolNewDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -160,10 +242,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnPoolNewDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -179,11 +261,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnPoolRelease
 		h2 := x.OnPoolRelease
+		panicCallback := options.hookPanicCallback("OnPoolRelease")
 		ret.OnPoolRelease = func(d DriverConnPoolReleaseStartInfo) func(DriverConnPoolReleaseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -195,10 +278,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnPoolReleaseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -214,11 +297,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnResolve
 		h2 := x.OnResolve
+		panicCallback := options.hookPanicCallback("OnResolve")
 		ret.OnResolve = func(d DriverResolveStartInfo) func(DriverResolveDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -230,10 +314,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverResolveDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -249,11 +333,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnStateChange
 		h2 := x.OnConnStateChange
+		panicCallback := options.hookPanicCallback("OnConnStateChange")
 		ret.OnConnStateChange = func(d DriverConnStateChangeStartInfo) func(DriverConnStateChangeDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -265,10 +350,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnStateChangeDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -284,11 +369,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnInvoke
 		h2 := x.OnConnInvoke
+		panicCallback := options.hookPanicCallback("OnConnInvoke")
 		ret.OnConnInvoke = func(d DriverConnInvokeStartInfo) func(DriverConnInvokeDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -300,10 +386,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnInvokeDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -319,11 +405,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnNewStream
 		h2 := x.OnConnNewStream
+		panicCallback := options.hookPanicCallback("OnConnNewStream")
 		ret.OnConnNewStream = func(d DriverConnNewStreamStartInfo) func(DriverConnNewStreamDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -335,10 +422,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnNewStreamDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -354,11 +441,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnStreamRecvMsg
 		h2 := x.OnConnStreamRecvMsg
+		panicCallback := options.hookPanicCallback("OnConnStreamRecvMsg")
 		ret.OnConnStreamRecvMsg = func(d DriverConnStreamRecvMsgStartInfo) func(DriverConnStreamRecvMsgDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -370,10 +458,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnStreamRecvMsgDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -389,11 +477,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnStreamSendMsg
 		h2 := x.OnConnStreamSendMsg
+		panicCallback := options.hookPanicCallback("OnConnStreamSendMsg")
 		ret.OnConnStreamSendMsg = func(d DriverConnStreamSendMsgStartInfo) func(DriverConnStreamSendMsgDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -405,10 +494,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnStreamSendMsgDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -424,11 +513,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnStreamCloseSend
 		h2 := x.OnConnStreamCloseSend
+		panicCallback := options.hookPanicCallback("OnConnStreamCloseSend")
 		ret.OnConnStreamCloseSend = func(d DriverConnStreamCloseSendStartInfo) func(DriverConnStreamCloseSendDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -440,10 +530,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnStreamCloseSendDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -459,11 +549,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnStreamFinish
 		h2 := x.OnConnStreamFinish
+		panicCallback := options.hookPanicCallback("OnConnStreamFinish")
 		ret.OnConnStreamFinish = func(info DriverConnStreamFinishInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -478,11 +569,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnDial
 		h2 := x.OnConnDial
+		panicCallback := options.hookPanicCallback("OnConnDial")
 		ret.OnConnDial = func(d DriverConnDialStartInfo) func(DriverConnDialDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -494,10 +586,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnDialDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -513,11 +605,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnBan
 		h2 := x.OnConnBan
+		panicCallback := options.hookPanicCallback("OnConnBan")
 		ret.OnConnBan = func(d DriverConnBanStartInfo) func(DriverConnBanDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -529,10 +622,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnBanDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -548,11 +641,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnAllow
 		h2 := x.OnConnAllow
+		panicCallback := options.hookPanicCallback("OnConnAllow")
 		ret.OnConnAllow = func(d DriverConnAllowStartInfo) func(DriverConnAllowDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -564,10 +658,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnAllowDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -583,11 +677,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnPark
 		h2 := x.OnConnPark
+		panicCallback := options.hookPanicCallback("OnConnPark")
 		ret.OnConnPark = func(d DriverConnParkStartInfo) func(DriverConnParkDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -599,10 +694,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnParkDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -618,11 +713,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnConnClose
 		h2 := x.OnConnClose
+		panicCallback := options.hookPanicCallback("OnConnClose")
 		ret.OnConnClose = func(d DriverConnCloseStartInfo) func(DriverConnCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -634,10 +730,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverConnCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -653,11 +749,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnRepeaterWakeUp
 		h2 := x.OnRepeaterWakeUp
+		panicCallback := options.hookPanicCallback("OnRepeaterWakeUp")
 		ret.OnRepeaterWakeUp = func(d DriverRepeaterWakeUpStartInfo) func(DriverRepeaterWakeUpDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -669,10 +766,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverRepeaterWakeUpDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -688,11 +785,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnBalancerInit
 		h2 := x.OnBalancerInit
+		panicCallback := options.hookPanicCallback("OnBalancerInit")
 		ret.OnBalancerInit = func(d DriverBalancerInitStartInfo) func(DriverBalancerInitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -704,10 +802,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverBalancerInitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -723,11 +821,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnBalancerClose
 		h2 := x.OnBalancerClose
+		panicCallback := options.hookPanicCallback("OnBalancerClose")
 		ret.OnBalancerClose = func(d DriverBalancerCloseStartInfo) func(DriverBalancerCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -739,10 +838,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverBalancerCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -758,11 +857,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnBalancerChooseEndpoint
 		h2 := x.OnBalancerChooseEndpoint
+		panicCallback := options.hookPanicCallback("OnBalancerChooseEndpoint")
 		ret.OnBalancerChooseEndpoint = func(d DriverBalancerChooseEndpointStartInfo) func(DriverBalancerChooseEndpointDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -774,10 +874,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverBalancerChooseEndpointDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -793,11 +893,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnBalancerClusterDiscoveryAttempt
 		h2 := x.OnBalancerClusterDiscoveryAttempt
+		panicCallback := options.hookPanicCallback("OnBalancerClusterDiscoveryAttempt")
 		ret.OnBalancerClusterDiscoveryAttempt = func(d DriverBalancerClusterDiscoveryAttemptStartInfo) func(DriverBalancerClusterDiscoveryAttemptDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -809,10 +910,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverBalancerClusterDiscoveryAttemptDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -828,11 +929,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnBalancerUpdate
 		h2 := x.OnBalancerUpdate
+		panicCallback := options.hookPanicCallback("OnBalancerUpdate")
 		ret.OnBalancerUpdate = func(d DriverBalancerUpdateStartInfo) func(DriverBalancerUpdateDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -844,10 +946,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverBalancerUpdateDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -863,11 +965,12 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	{
 		h1 := t.OnGetCredentials
 		h2 := x.OnGetCredentials
+		panicCallback := options.hookPanicCallback("OnGetCredentials")
 		ret.OnGetCredentials = func(d DriverGetCredentialsStartInfo) func(DriverGetCredentialsDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -879,10 +982,10 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 				r1 = h2(d)
 			}
 			return func(d DriverGetCredentialsDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -897,6 +1000,188 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 	}
 	return &ret
 }
+// ComposeAll returns a new Driver which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Driver) ComposeAll(others ...*Driver) *Driver {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Driver{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Driver) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnInit != nil {
+		return false
+	}
+	if t.OnWith != nil {
+		return false
+	}
+	if t.OnClose != nil {
+		return false
+	}
+	if t.OnPoolNew != nil {
+		return false
+	}
+	if t.OnPoolRelease != nil {
+		return false
+	}
+	if t.OnResolve != nil {
+		return false
+	}
+	if t.OnConnStateChange != nil {
+		return false
+	}
+	if t.OnConnInvoke != nil {
+		return false
+	}
+	if t.OnConnNewStream != nil {
+		return false
+	}
+	if t.OnConnStreamRecvMsg != nil {
+		return false
+	}
+	if t.OnConnStreamSendMsg != nil {
+		return false
+	}
+	if t.OnConnStreamCloseSend != nil {
+		return false
+	}
+	if t.OnConnStreamFinish != nil {
+		return false
+	}
+	if t.OnConnDial != nil {
+		return false
+	}
+	if t.OnConnBan != nil {
+		return false
+	}
+	if t.OnConnAllow != nil {
+		return false
+	}
+	if t.OnConnPark != nil {
+		return false
+	}
+	if t.OnConnClose != nil {
+		return false
+	}
+	if t.OnRepeaterWakeUp != nil {
+		return false
+	}
+	if t.OnBalancerInit != nil {
+		return false
+	}
+	if t.OnBalancerClose != nil {
+		return false
+	}
+	if t.OnBalancerChooseEndpoint != nil {
+		return false
+	}
+	if t.OnBalancerClusterDiscoveryAttempt != nil {
+		return false
+	}
+	if t.OnBalancerUpdate != nil {
+		return false
+	}
+	if t.OnGetCredentials != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Driver) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnInit != nil {
+		hooks = append(hooks, "OnInit")
+	}
+	if t.OnWith != nil {
+		hooks = append(hooks, "OnWith")
+	}
+	if t.OnClose != nil {
+		hooks = append(hooks, "OnClose")
+	}
+	if t.OnPoolNew != nil {
+		hooks = append(hooks, "OnPoolNew")
+	}
+	if t.OnPoolRelease != nil {
+		hooks = append(hooks, "OnPoolRelease")
+	}
+	if t.OnResolve != nil {
+		hooks = append(hooks, "OnResolve")
+	}
+	if t.OnConnStateChange != nil {
+		hooks = append(hooks, "OnConnStateChange")
+	}
+	if t.OnConnInvoke != nil {
+		hooks = append(hooks, "OnConnInvoke")
+	}
+	if t.OnConnNewStream != nil {
+		hooks = append(hooks, "OnConnNewStream")
+	}
+	if t.OnConnStreamRecvMsg != nil {
+		hooks = append(hooks, "OnConnStreamRecvMsg")
+	}
+	if t.OnConnStreamSendMsg != nil {
+		hooks = append(hooks, "OnConnStreamSendMsg")
+	}
+	if t.OnConnStreamCloseSend != nil {
+		hooks = append(hooks, "OnConnStreamCloseSend")
+	}
+	if t.OnConnStreamFinish != nil {
+		hooks = append(hooks, "OnConnStreamFinish")
+	}
+	if t.OnConnDial != nil {
+		hooks = append(hooks, "OnConnDial")
+	}
+	if t.OnConnBan != nil {
+		hooks = append(hooks, "OnConnBan")
+	}
+	if t.OnConnAllow != nil {
+		hooks = append(hooks, "OnConnAllow")
+	}
+	if t.OnConnPark != nil {
+		hooks = append(hooks, "OnConnPark")
+	}
+	if t.OnConnClose != nil {
+		hooks = append(hooks, "OnConnClose")
+	}
+	if t.OnRepeaterWakeUp != nil {
+		hooks = append(hooks, "OnRepeaterWakeUp")
+	}
+	if t.OnBalancerInit != nil {
+		hooks = append(hooks, "OnBalancerInit")
+	}
+	if t.OnBalancerClose != nil {
+		hooks = append(hooks, "OnBalancerClose")
+	}
+	if t.OnBalancerChooseEndpoint != nil {
+		hooks = append(hooks, "OnBalancerChooseEndpoint")
+	}
+	if t.OnBalancerClusterDiscoveryAttempt != nil {
+		hooks = append(hooks, "OnBalancerClusterDiscoveryAttempt")
+	}
+	if t.OnBalancerUpdate != nil {
+		hooks = append(hooks, "OnBalancerUpdate")
+	}
+	if t.OnGetCredentials != nil {
+		hooks = append(hooks, "OnGetCredentials")
+	}
+	return hooks
+}
 func (t *Driver) onInit(d DriverInitStartInfo) func(DriverInitDoneInfo) {
 	fn := t.OnInit
 	if fn == nil {
@@ -1426,9 +1711,9 @@ func DriverOnConnStreamCloseSend(t *Driver, c *context.Context, call call) func(
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DriverOnConnStreamFinish(t *Driver, c context.Context, call call, e error) {
+func DriverOnConnStreamFinish(t *Driver, ctx context.Context, call call, e error) {
 	var p DriverConnStreamFinishInfo
-	p.Context = c
+	p.Context = ctx
 	p.Call = call
 	p.Error = e
 	t.onConnStreamFinish(p)
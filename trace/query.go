@@ -2,6 +2,7 @@ package trace
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 )
@@ -72,6 +73,13 @@ type (
 		OnTxQueryResultSet func(QueryTxQueryResultSetStartInfo) func(QueryTxQueryResultSetDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnTxQueryRow func(QueryTxQueryRowStartInfo) func(QueryTxQueryRowDoneInfo)
+		// OnTxBegin is not called for lazy transactions which begins with the first query
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnTxBegin func(QueryTxBeginStartInfo) func(QueryTxBeginDoneInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnTxCommit func(QueryTxCommitStartInfo) func(QueryTxCommitDoneInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnTxRollback func(QueryTxRollbackStartInfo) func(QueryTxRollbackDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnResultNew func(QueryResultNewStartInfo) func(info QueryResultNewDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
@@ -322,10 +330,13 @@ type (
 		Session sessionInfo
 		Tx      txInfo
 		Query   string
+		// TxMode is a name of isolation mode of transaction (empty if mode is unknown)
+		TxMode string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxExecDoneInfo struct {
-		Error error
+		Error    error
+		Duration time.Duration
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxQueryStartInfo struct {
@@ -339,10 +350,13 @@ type (
 		Session sessionInfo
 		Tx      txInfo
 		Query   string
+		// TxMode is a name of isolation mode of transaction (empty if mode is unknown)
+		TxMode string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryTxQueryDoneInfo struct {
-		Error error
+		Error    error
+		Duration time.Duration
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QuerySessionAttachStartInfo struct {
@@ -374,6 +388,59 @@ type (
 		Tx    txInfo
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryTxBeginStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Session sessionInfo
+		TxMode  string
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryTxBeginDoneInfo struct {
+		Error    error
+		TxID     string
+		Duration time.Duration
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryTxCommitStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Session sessionInfo
+		Tx      txInfo
+		// TxMode is a name of isolation mode of transaction (empty if mode is unknown)
+		TxMode string
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryTxCommitDoneInfo struct {
+		Error    error
+		Duration time.Duration
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryTxRollbackStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Session sessionInfo
+		Tx      txInfo
+		// TxMode is a name of isolation mode of transaction (empty if mode is unknown)
+		TxMode string
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryTxRollbackDoneInfo struct {
+		Error    error
+		Duration time.Duration
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryResultNewStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
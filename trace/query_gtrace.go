@@ -4,13 +4,27 @@ package trace
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 )
 
 // queryComposeOptions is a holder of options
 type queryComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *queryComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Query."+hook, cb, o.panicLogger)
 }
 
 // QueryOption specified Query compose option
@@ -25,6 +39,80 @@ func WithQueryPanicCallback(cb func(e interface{})) QueryComposeOption {
 	}
 }
 
+// WithQueryPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithQueryPanicPolicy(policy PanicPolicy) QueryComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *queryComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithQueryPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithQueryPanicLogger(l PanicLogger) QueryComposeOption {
+	return func(o *queryComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// queryHooks is a set of names of Query hooks
+var queryHooks = map[string]struct{}{
+	"OnNew": {},
+	"OnClose": {},
+	"OnPoolNew": {},
+	"OnPoolClose": {},
+	"OnPoolTry": {},
+	"OnPoolWith": {},
+	"OnPoolPut": {},
+	"OnPoolGet": {},
+	"OnPoolChange": {},
+	"OnDo": {},
+	"OnDoTx": {},
+	"OnExec": {},
+	"OnQuery": {},
+	"OnQueryResultSet": {},
+	"OnQueryRow": {},
+	"OnSessionCreate": {},
+	"OnSessionAttach": {},
+	"OnSessionDelete": {},
+	"OnSessionExec": {},
+	"OnSessionQuery": {},
+	"OnSessionQueryResultSet": {},
+	"OnSessionQueryRow": {},
+	"OnSessionBegin": {},
+	"OnTxExec": {},
+	"OnTxQuery": {},
+	"OnTxQueryResultSet": {},
+	"OnTxQueryRow": {},
+	"OnTxBegin": {},
+	"OnTxCommit": {},
+	"OnTxRollback": {},
+	"OnResultNew": {},
+	"OnResultNextPart": {},
+	"OnResultNextResultSet": {},
+	"OnResultClose": {},
+}
+
+// WithQueryHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithQueryPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithQueryHookPanicCallback(hook string, cb func(e interface{})) QueryComposeOption {
+	if _, has := queryHooks[hook]; !has {
+		panic("unknown Query hook " + hook)
+	}
+
+	return func(o *queryComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Query which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
@@ -41,11 +129,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnNew
 		h2 := x.OnNew
+		panicCallback := options.hookPanicCallback("OnNew")
 		ret.OnNew = func(q QueryNewStartInfo) func(QueryNewDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -57,10 +146,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryNewDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -76,11 +165,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnClose
 		h2 := x.OnClose
+		panicCallback := options.hookPanicCallback("OnClose")
 		ret.OnClose = func(q QueryCloseStartInfo) func(QueryCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -92,10 +182,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -111,11 +201,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolNew
 		h2 := x.OnPoolNew
+		panicCallback := options.hookPanicCallback("OnPoolNew")
 		ret.OnPoolNew = func(q QueryPoolNewStartInfo) func(QueryPoolNewDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -127,10 +218,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryPoolNewDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -146,11 +237,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolClose
 		h2 := x.OnPoolClose
+		panicCallback := options.hookPanicCallback("OnPoolClose")
 		ret.OnPoolClose = func(q QueryPoolCloseStartInfo) func(QueryPoolCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -162,10 +254,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryPoolCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -181,11 +273,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolTry
 		h2 := x.OnPoolTry
+		panicCallback := options.hookPanicCallback("OnPoolTry")
 		ret.OnPoolTry = func(q QueryPoolTryStartInfo) func(QueryPoolTryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -197,10 +290,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryPoolTryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -216,11 +309,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolWith
 		h2 := x.OnPoolWith
+		panicCallback := options.hookPanicCallback("OnPoolWith")
 		ret.OnPoolWith = func(q QueryPoolWithStartInfo) func(QueryPoolWithDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -232,10 +326,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryPoolWithDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -251,11 +345,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolPut
 		h2 := x.OnPoolPut
+		panicCallback := options.hookPanicCallback("OnPoolPut")
 		ret.OnPoolPut = func(q QueryPoolPutStartInfo) func(QueryPoolPutDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -267,10 +362,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryPoolPutDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -286,11 +381,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolGet
 		h2 := x.OnPoolGet
+		panicCallback := options.hookPanicCallback("OnPoolGet")
 		ret.OnPoolGet = func(q QueryPoolGetStartInfo) func(QueryPoolGetDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -302,10 +398,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryPoolGetDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -321,11 +417,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnPoolChange
 		h2 := x.OnPoolChange
+		panicCallback := options.hookPanicCallback("OnPoolChange")
 		ret.OnPoolChange = func(q QueryPoolChange) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -340,11 +437,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnDo
 		h2 := x.OnDo
+		panicCallback := options.hookPanicCallback("OnDo")
 		ret.OnDo = func(q QueryDoStartInfo) func(QueryDoDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -356,10 +454,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryDoDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -375,11 +473,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnDoTx
 		h2 := x.OnDoTx
+		panicCallback := options.hookPanicCallback("OnDoTx")
 		ret.OnDoTx = func(q QueryDoTxStartInfo) func(QueryDoTxDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -391,10 +490,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryDoTxDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -410,11 +509,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnExec
 		h2 := x.OnExec
+		panicCallback := options.hookPanicCallback("OnExec")
 		ret.OnExec = func(q QueryExecStartInfo) func(QueryExecDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -426,10 +526,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryExecDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -445,11 +545,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnQuery
 		h2 := x.OnQuery
+		panicCallback := options.hookPanicCallback("OnQuery")
 		ret.OnQuery = func(q QueryQueryStartInfo) func(QueryQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -461,10 +562,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -480,11 +581,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnQueryResultSet
 		h2 := x.OnQueryResultSet
+		panicCallback := options.hookPanicCallback("OnQueryResultSet")
 		ret.OnQueryResultSet = func(q QueryQueryResultSetStartInfo) func(QueryQueryResultSetDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -496,10 +598,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryQueryResultSetDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -515,11 +617,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnQueryRow
 		h2 := x.OnQueryRow
+		panicCallback := options.hookPanicCallback("OnQueryRow")
 		ret.OnQueryRow = func(q QueryQueryRowStartInfo) func(QueryQueryRowDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -531,10 +634,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryQueryRowDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -550,11 +653,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionCreate
 		h2 := x.OnSessionCreate
+		panicCallback := options.hookPanicCallback("OnSessionCreate")
 		ret.OnSessionCreate = func(q QuerySessionCreateStartInfo) func(QuerySessionCreateDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -566,10 +670,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QuerySessionCreateDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -585,11 +689,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionAttach
 		h2 := x.OnSessionAttach
+		panicCallback := options.hookPanicCallback("OnSessionAttach")
 		ret.OnSessionAttach = func(q QuerySessionAttachStartInfo) func(QuerySessionAttachDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -601,10 +706,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QuerySessionAttachDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -620,11 +725,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionDelete
 		h2 := x.OnSessionDelete
+		panicCallback := options.hookPanicCallback("OnSessionDelete")
 		ret.OnSessionDelete = func(q QuerySessionDeleteStartInfo) func(QuerySessionDeleteDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -636,10 +742,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QuerySessionDeleteDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -655,11 +761,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionExec
 		h2 := x.OnSessionExec
+		panicCallback := options.hookPanicCallback("OnSessionExec")
 		ret.OnSessionExec = func(q QuerySessionExecStartInfo) func(QuerySessionExecDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -671,10 +778,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QuerySessionExecDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -690,11 +797,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionQuery
 		h2 := x.OnSessionQuery
+		panicCallback := options.hookPanicCallback("OnSessionQuery")
 		ret.OnSessionQuery = func(q QuerySessionQueryStartInfo) func(QuerySessionQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -706,10 +814,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QuerySessionQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -725,11 +833,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionQueryResultSet
 		h2 := x.OnSessionQueryResultSet
+		panicCallback := options.hookPanicCallback("OnSessionQueryResultSet")
 		ret.OnSessionQueryResultSet = func(q QuerySessionQueryResultSetStartInfo) func(QuerySessionQueryResultSetDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -741,10 +850,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QuerySessionQueryResultSetDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -760,11 +869,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionQueryRow
 		h2 := x.OnSessionQueryRow
+		panicCallback := options.hookPanicCallback("OnSessionQueryRow")
 		ret.OnSessionQueryRow = func(q QuerySessionQueryRowStartInfo) func(QuerySessionQueryRowDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -776,10 +886,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QuerySessionQueryRowDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -795,11 +905,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnSessionBegin
 		h2 := x.OnSessionBegin
+		panicCallback := options.hookPanicCallback("OnSessionBegin")
 		ret.OnSessionBegin = func(q QuerySessionBeginStartInfo) func(QuerySessionBeginDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -811,10 +922,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QuerySessionBeginDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -830,11 +941,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnTxExec
 		h2 := x.OnTxExec
+		panicCallback := options.hookPanicCallback("OnTxExec")
 		ret.OnTxExec = func(q QueryTxExecStartInfo) func(QueryTxExecDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -846,10 +958,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryTxExecDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -865,11 +977,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnTxQuery
 		h2 := x.OnTxQuery
+		panicCallback := options.hookPanicCallback("OnTxQuery")
 		ret.OnTxQuery = func(q QueryTxQueryStartInfo) func(QueryTxQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -881,10 +994,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryTxQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -900,11 +1013,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnTxQueryResultSet
 		h2 := x.OnTxQueryResultSet
+		panicCallback := options.hookPanicCallback("OnTxQueryResultSet")
 		ret.OnTxQueryResultSet = func(q QueryTxQueryResultSetStartInfo) func(QueryTxQueryResultSetDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -916,10 +1030,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryTxQueryResultSetDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -935,11 +1049,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnTxQueryRow
 		h2 := x.OnTxQueryRow
+		panicCallback := options.hookPanicCallback("OnTxQueryRow")
 		ret.OnTxQueryRow = func(q QueryTxQueryRowStartInfo) func(QueryTxQueryRowDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -951,10 +1066,118 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(q QueryTxQueryRowDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(q)
+				}
+				if r1 != nil {
+					r1(q)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnTxBegin
+		h2 := x.OnTxBegin
+		panicCallback := options.hookPanicCallback("OnTxBegin")
+		ret.OnTxBegin = func(q QueryTxBeginStartInfo) func(QueryTxBeginDoneInfo) {
+			if panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QueryTxBeginDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(q QueryTxBeginDoneInfo) {
+				if panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(q)
+				}
+				if r1 != nil {
+					r1(q)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnTxCommit
+		h2 := x.OnTxCommit
+		panicCallback := options.hookPanicCallback("OnTxCommit")
+		ret.OnTxCommit = func(q QueryTxCommitStartInfo) func(QueryTxCommitDoneInfo) {
+			if panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QueryTxCommitDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(q QueryTxCommitDoneInfo) {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(q)
+				}
+				if r1 != nil {
+					r1(q)
+				}
+			}
+		}
+	}
+	{
+		h1 := t.OnTxRollback
+		h2 := x.OnTxRollback
+		panicCallback := options.hookPanicCallback("OnTxRollback")
+		ret.OnTxRollback = func(q QueryTxRollbackStartInfo) func(QueryTxRollbackDoneInfo) {
+			if panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QueryTxRollbackDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(q QueryTxRollbackDoneInfo) {
+				if panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							panicCallback(e)
 						}
 					}()
 				}
@@ -970,11 +1193,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnResultNew
 		h2 := x.OnResultNew
+		panicCallback := options.hookPanicCallback("OnResultNew")
 		ret.OnResultNew = func(q QueryResultNewStartInfo) func(QueryResultNewDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -986,10 +1210,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryResultNewDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -1005,11 +1229,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnResultNextPart
 		h2 := x.OnResultNextPart
+		panicCallback := options.hookPanicCallback("OnResultNextPart")
 		ret.OnResultNextPart = func(q QueryResultNextPartStartInfo) func(QueryResultNextPartDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -1021,10 +1246,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryResultNextPartDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -1040,11 +1265,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnResultNextResultSet
 		h2 := x.OnResultNextResultSet
+		panicCallback := options.hookPanicCallback("OnResultNextResultSet")
 		ret.OnResultNextResultSet = func(q QueryResultNextResultSetStartInfo) func(QueryResultNextResultSetDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -1056,10 +1282,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryResultNextResultSetDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -1075,11 +1301,12 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	{
 		h1 := t.OnResultClose
 		h2 := x.OnResultClose
+		panicCallback := options.hookPanicCallback("OnResultClose")
 		ret.OnResultClose = func(q QueryResultCloseStartInfo) func(QueryResultCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -1091,10 +1318,10 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 				r1 = h2(q)
 			}
 			return func(info QueryResultCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -1109,6 +1336,242 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 	}
 	return &ret
 }
+// ComposeAll returns a new Query which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Query) ComposeAll(others ...*Query) *Query {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Query{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Query) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnNew != nil {
+		return false
+	}
+	if t.OnClose != nil {
+		return false
+	}
+	if t.OnPoolNew != nil {
+		return false
+	}
+	if t.OnPoolClose != nil {
+		return false
+	}
+	if t.OnPoolTry != nil {
+		return false
+	}
+	if t.OnPoolWith != nil {
+		return false
+	}
+	if t.OnPoolPut != nil {
+		return false
+	}
+	if t.OnPoolGet != nil {
+		return false
+	}
+	if t.OnPoolChange != nil {
+		return false
+	}
+	if t.OnDo != nil {
+		return false
+	}
+	if t.OnDoTx != nil {
+		return false
+	}
+	if t.OnExec != nil {
+		return false
+	}
+	if t.OnQuery != nil {
+		return false
+	}
+	if t.OnQueryResultSet != nil {
+		return false
+	}
+	if t.OnQueryRow != nil {
+		return false
+	}
+	if t.OnSessionCreate != nil {
+		return false
+	}
+	if t.OnSessionAttach != nil {
+		return false
+	}
+	if t.OnSessionDelete != nil {
+		return false
+	}
+	if t.OnSessionExec != nil {
+		return false
+	}
+	if t.OnSessionQuery != nil {
+		return false
+	}
+	if t.OnSessionQueryResultSet != nil {
+		return false
+	}
+	if t.OnSessionQueryRow != nil {
+		return false
+	}
+	if t.OnSessionBegin != nil {
+		return false
+	}
+	if t.OnTxExec != nil {
+		return false
+	}
+	if t.OnTxQuery != nil {
+		return false
+	}
+	if t.OnTxQueryResultSet != nil {
+		return false
+	}
+	if t.OnTxQueryRow != nil {
+		return false
+	}
+	if t.OnTxBegin != nil {
+		return false
+	}
+	if t.OnTxCommit != nil {
+		return false
+	}
+	if t.OnTxRollback != nil {
+		return false
+	}
+	if t.OnResultNew != nil {
+		return false
+	}
+	if t.OnResultNextPart != nil {
+		return false
+	}
+	if t.OnResultNextResultSet != nil {
+		return false
+	}
+	if t.OnResultClose != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Query) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnNew != nil {
+		hooks = append(hooks, "OnNew")
+	}
+	if t.OnClose != nil {
+		hooks = append(hooks, "OnClose")
+	}
+	if t.OnPoolNew != nil {
+		hooks = append(hooks, "OnPoolNew")
+	}
+	if t.OnPoolClose != nil {
+		hooks = append(hooks, "OnPoolClose")
+	}
+	if t.OnPoolTry != nil {
+		hooks = append(hooks, "OnPoolTry")
+	}
+	if t.OnPoolWith != nil {
+		hooks = append(hooks, "OnPoolWith")
+	}
+	if t.OnPoolPut != nil {
+		hooks = append(hooks, "OnPoolPut")
+	}
+	if t.OnPoolGet != nil {
+		hooks = append(hooks, "OnPoolGet")
+	}
+	if t.OnPoolChange != nil {
+		hooks = append(hooks, "OnPoolChange")
+	}
+	if t.OnDo != nil {
+		hooks = append(hooks, "OnDo")
+	}
+	if t.OnDoTx != nil {
+		hooks = append(hooks, "OnDoTx")
+	}
+	if t.OnExec != nil {
+		hooks = append(hooks, "OnExec")
+	}
+	if t.OnQuery != nil {
+		hooks = append(hooks, "OnQuery")
+	}
+	if t.OnQueryResultSet != nil {
+		hooks = append(hooks, "OnQueryResultSet")
+	}
+	if t.OnQueryRow != nil {
+		hooks = append(hooks, "OnQueryRow")
+	}
+	if t.OnSessionCreate != nil {
+		hooks = append(hooks, "OnSessionCreate")
+	}
+	if t.OnSessionAttach != nil {
+		hooks = append(hooks, "OnSessionAttach")
+	}
+	if t.OnSessionDelete != nil {
+		hooks = append(hooks, "OnSessionDelete")
+	}
+	if t.OnSessionExec != nil {
+		hooks = append(hooks, "OnSessionExec")
+	}
+	if t.OnSessionQuery != nil {
+		hooks = append(hooks, "OnSessionQuery")
+	}
+	if t.OnSessionQueryResultSet != nil {
+		hooks = append(hooks, "OnSessionQueryResultSet")
+	}
+	if t.OnSessionQueryRow != nil {
+		hooks = append(hooks, "OnSessionQueryRow")
+	}
+	if t.OnSessionBegin != nil {
+		hooks = append(hooks, "OnSessionBegin")
+	}
+	if t.OnTxExec != nil {
+		hooks = append(hooks, "OnTxExec")
+	}
+	if t.OnTxQuery != nil {
+		hooks = append(hooks, "OnTxQuery")
+	}
+	if t.OnTxQueryResultSet != nil {
+		hooks = append(hooks, "OnTxQueryResultSet")
+	}
+	if t.OnTxQueryRow != nil {
+		hooks = append(hooks, "OnTxQueryRow")
+	}
+	if t.OnTxBegin != nil {
+		hooks = append(hooks, "OnTxBegin")
+	}
+	if t.OnTxCommit != nil {
+		hooks = append(hooks, "OnTxCommit")
+	}
+	if t.OnTxRollback != nil {
+		hooks = append(hooks, "OnTxRollback")
+	}
+	if t.OnResultNew != nil {
+		hooks = append(hooks, "OnResultNew")
+	}
+	if t.OnResultNextPart != nil {
+		hooks = append(hooks, "OnResultNextPart")
+	}
+	if t.OnResultNextResultSet != nil {
+		hooks = append(hooks, "OnResultNextResultSet")
+	}
+	if t.OnResultClose != nil {
+		hooks = append(hooks, "OnResultClose")
+	}
+	return hooks
+}
 func (t *Query) onNew(q QueryNewStartInfo) func(info QueryNewDoneInfo) {
 	fn := t.OnNew
 	if fn == nil {
@@ -1506,6 +1969,51 @@ func (t *Query) onTxQueryRow(q QueryTxQueryRowStartInfo) func(QueryTxQueryRowDon
 	}
 	return res
 }
+func (t *Query) onTxBegin(q QueryTxBeginStartInfo) func(QueryTxBeginDoneInfo) {
+	fn := t.OnTxBegin
+	if fn == nil {
+		return func(QueryTxBeginDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QueryTxBeginDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Query) onTxCommit(q QueryTxCommitStartInfo) func(QueryTxCommitDoneInfo) {
+	fn := t.OnTxCommit
+	if fn == nil {
+		return func(QueryTxCommitDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QueryTxCommitDoneInfo) {
+			return
+		}
+	}
+	return res
+}
+func (t *Query) onTxRollback(q QueryTxRollbackStartInfo) func(QueryTxRollbackDoneInfo) {
+	fn := t.OnTxRollback
+	if fn == nil {
+		return func(QueryTxRollbackDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QueryTxRollbackDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Query) onResultNew(q QueryResultNewStartInfo) func(info QueryResultNewDoneInfo) {
 	fn := t.OnResultNew
 	if fn == nil {
@@ -1863,32 +2371,36 @@ func QueryOnSessionBegin(t *Query, c *context.Context, call call, session sessio
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxExec(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string) func(error) {
+func QueryOnTxExec(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string, txMode string) func(error, time.Duration) {
 	var p QueryTxExecStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Tx = tx
 	p.Query = query
+	p.TxMode = txMode
 	res := t.onTxExec(p)
-	return func(e error) {
+	return func(e error, d time.Duration) {
 		var p QueryTxExecDoneInfo
 		p.Error = e
+		p.Duration = d
 		res(p)
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnTxQuery(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string) func(error) {
+func QueryOnTxQuery(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, query string, txMode string) func(error, time.Duration) {
 	var p QueryTxQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Tx = tx
 	p.Query = query
+	p.TxMode = txMode
 	res := t.onTxQuery(p)
-	return func(e error) {
+	return func(e error, d time.Duration) {
 		var p QueryTxQueryDoneInfo
 		p.Error = e
+		p.Duration = d
 		res(p)
 	}
 }
@@ -1921,6 +2433,54 @@ func QueryOnTxQueryRow(t *Query, c *context.Context, call call, tx txInfo, query
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func QueryOnTxBegin(t *Query, c *context.Context, call call, session sessionInfo, txMode string) func(_ error, txID string, _ time.Duration) {
+	var p QueryTxBeginStartInfo
+	p.Context = c
+	p.Call = call
+	p.Session = session
+	p.TxMode = txMode
+	res := t.onTxBegin(p)
+	return func(e error, txID string, d time.Duration) {
+		var p QueryTxBeginDoneInfo
+		p.Error = e
+		p.TxID = txID
+		p.Duration = d
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func QueryOnTxCommit(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, txMode string) func(error, time.Duration) {
+	var p QueryTxCommitStartInfo
+	p.Context = c
+	p.Call = call
+	p.Session = session
+	p.Tx = tx
+	p.TxMode = txMode
+	res := t.onTxCommit(p)
+	return func(e error, d time.Duration) {
+		var p QueryTxCommitDoneInfo
+		p.Error = e
+		p.Duration = d
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func QueryOnTxRollback(t *Query, c *context.Context, call call, session sessionInfo, tx txInfo, txMode string) func(error, time.Duration) {
+	var p QueryTxRollbackStartInfo
+	p.Context = c
+	p.Call = call
+	p.Session = session
+	p.Tx = tx
+	p.TxMode = txMode
+	res := t.onTxRollback(p)
+	return func(e error, d time.Duration) {
+		var p QueryTxRollbackDoneInfo
+		p.Error = e
+		p.Duration = d
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func QueryOnResultNew(t *Query, c *context.Context, call call) func(error) {
 	var p QueryResultNewStartInfo
 	p.Context = c
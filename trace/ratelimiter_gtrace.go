@@ -4,7 +4,20 @@ package trace
 
 // ratelimiterComposeOptions is a holder of options
 type ratelimiterComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *ratelimiterComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Ratelimiter."+hook, cb, o.panicLogger)
 }
 
 // RatelimiterOption specified Ratelimiter compose option
@@ -19,6 +32,46 @@ func WithRatelimiterPanicCallback(cb func(e interface{})) RatelimiterComposeOpti
 	}
 }
 
+// WithRatelimiterPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithRatelimiterPanicPolicy(policy PanicPolicy) RatelimiterComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *ratelimiterComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithRatelimiterPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithRatelimiterPanicLogger(l PanicLogger) RatelimiterComposeOption {
+	return func(o *ratelimiterComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// ratelimiterHooks is a set of names of Ratelimiter hooks
+var ratelimiterHooks = map[string]struct{}{
+}
+
+// WithRatelimiterHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithRatelimiterPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithRatelimiterHookPanicCallback(hook string, cb func(e interface{})) RatelimiterComposeOption {
+	if _, has := ratelimiterHooks[hook]; !has {
+		panic("unknown Ratelimiter hook " + hook)
+	}
+
+	return func(o *ratelimiterComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Ratelimiter which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Ratelimiter) Compose(x *Ratelimiter, opts ...RatelimiterComposeOption) *Ratelimiter {
@@ -28,3 +81,35 @@ func (t *Ratelimiter) Compose(x *Ratelimiter, opts ...RatelimiterComposeOption)
 	var ret Ratelimiter
 	return &ret
 }
+// ComposeAll returns a new Ratelimiter which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Ratelimiter) ComposeAll(others ...*Ratelimiter) *Ratelimiter {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Ratelimiter{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Ratelimiter) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Ratelimiter) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	return hooks
+}
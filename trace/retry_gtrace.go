@@ -8,7 +8,20 @@ import (
 
 // retryComposeOptions is a holder of options
 type retryComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *retryComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Retry."+hook, cb, o.panicLogger)
 }
 
 // RetryOption specified Retry compose option
@@ -23,6 +36,47 @@ func WithRetryPanicCallback(cb func(e interface{})) RetryComposeOption {
 	}
 }
 
+// WithRetryPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithRetryPanicPolicy(policy PanicPolicy) RetryComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *retryComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithRetryPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithRetryPanicLogger(l PanicLogger) RetryComposeOption {
+	return func(o *retryComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// retryHooks is a set of names of Retry hooks
+var retryHooks = map[string]struct{}{
+	"OnRetry": {},
+}
+
+// WithRetryHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithRetryPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithRetryHookPanicCallback(hook string, cb func(e interface{})) RetryComposeOption {
+	if _, has := retryHooks[hook]; !has {
+		panic("unknown Retry hook " + hook)
+	}
+
+	return func(o *retryComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Retry which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
@@ -39,11 +93,12 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 	{
 		h1 := t.OnRetry
 		h2 := x.OnRetry
+		panicCallback := options.hookPanicCallback("OnRetry")
 		ret.OnRetry = func(r RetryLoopStartInfo) func(RetryLoopDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -55,10 +110,10 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 				r2 = h2(r)
 			}
 			return func(r RetryLoopDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -73,6 +128,44 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 	}
 	return &ret
 }
+// ComposeAll returns a new Retry which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Retry) ComposeAll(others ...*Retry) *Retry {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Retry{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Retry) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnRetry != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Retry) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnRetry != nil {
+		hooks = append(hooks, "OnRetry")
+	}
+	return hooks
+}
 func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopDoneInfo) {
 	fn := t.OnRetry
 	if fn == nil {
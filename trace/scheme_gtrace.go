@@ -8,7 +8,20 @@ import (
 
 // schemeComposeOptions is a holder of options
 type schemeComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *schemeComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Scheme."+hook, cb, o.panicLogger)
 }
 
 // SchemeOption specified Scheme compose option
@@ -23,6 +36,51 @@ func WithSchemePanicCallback(cb func(e interface{})) SchemeComposeOption {
 	}
 }
 
+// WithSchemePanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithSchemePanicPolicy(policy PanicPolicy) SchemeComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *schemeComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithSchemePanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithSchemePanicLogger(l PanicLogger) SchemeComposeOption {
+	return func(o *schemeComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// schemeHooks is a set of names of Scheme hooks
+var schemeHooks = map[string]struct{}{
+	"OnListDirectory": {},
+	"OnDescribePath": {},
+	"OnMakeDirectory": {},
+	"OnRemoveDirectory": {},
+	"OnModifyPermissions": {},
+}
+
+// WithSchemeHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithSchemePanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithSchemeHookPanicCallback(hook string, cb func(e interface{})) SchemeComposeOption {
+	if _, has := schemeHooks[hook]; !has {
+		panic("unknown Scheme hook " + hook)
+	}
+
+	return func(o *schemeComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Scheme which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
@@ -39,11 +97,12 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 	{
 		h1 := t.OnListDirectory
 		h2 := x.OnListDirectory
+		panicCallback := options.hookPanicCallback("OnListDirectory")
 		ret.OnListDirectory = func(s SchemeListDirectoryStartInfo) func(SchemeListDirectoryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -55,10 +114,10 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 				r1 = h2(s)
 			}
 			return func(s SchemeListDirectoryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -74,11 +133,12 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 	{
 		h1 := t.OnDescribePath
 		h2 := x.OnDescribePath
+		panicCallback := options.hookPanicCallback("OnDescribePath")
 		ret.OnDescribePath = func(s SchemeDescribePathStartInfo) func(SchemeDescribePathDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -90,10 +150,10 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 				r1 = h2(s)
 			}
 			return func(s SchemeDescribePathDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -109,11 +169,12 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 	{
 		h1 := t.OnMakeDirectory
 		h2 := x.OnMakeDirectory
+		panicCallback := options.hookPanicCallback("OnMakeDirectory")
 		ret.OnMakeDirectory = func(s SchemeMakeDirectoryStartInfo) func(SchemeMakeDirectoryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -125,10 +186,10 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 				r1 = h2(s)
 			}
 			return func(s SchemeMakeDirectoryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -144,11 +205,12 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 	{
 		h1 := t.OnRemoveDirectory
 		h2 := x.OnRemoveDirectory
+		panicCallback := options.hookPanicCallback("OnRemoveDirectory")
 		ret.OnRemoveDirectory = func(s SchemeRemoveDirectoryStartInfo) func(SchemeRemoveDirectoryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -160,10 +222,10 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 				r1 = h2(s)
 			}
 			return func(s SchemeRemoveDirectoryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -179,11 +241,12 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 	{
 		h1 := t.OnModifyPermissions
 		h2 := x.OnModifyPermissions
+		panicCallback := options.hookPanicCallback("OnModifyPermissions")
 		ret.OnModifyPermissions = func(s SchemeModifyPermissionsStartInfo) func(SchemeModifyPermissionsDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -195,10 +258,10 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 				r1 = h2(s)
 			}
 			return func(s SchemeModifyPermissionsDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -213,6 +276,68 @@ func (t *Scheme) Compose(x *Scheme, opts ...SchemeComposeOption) *Scheme {
 	}
 	return &ret
 }
+// ComposeAll returns a new Scheme which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Scheme) ComposeAll(others ...*Scheme) *Scheme {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Scheme{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Scheme) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnListDirectory != nil {
+		return false
+	}
+	if t.OnDescribePath != nil {
+		return false
+	}
+	if t.OnMakeDirectory != nil {
+		return false
+	}
+	if t.OnRemoveDirectory != nil {
+		return false
+	}
+	if t.OnModifyPermissions != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Scheme) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnListDirectory != nil {
+		hooks = append(hooks, "OnListDirectory")
+	}
+	if t.OnDescribePath != nil {
+		hooks = append(hooks, "OnDescribePath")
+	}
+	if t.OnMakeDirectory != nil {
+		hooks = append(hooks, "OnMakeDirectory")
+	}
+	if t.OnRemoveDirectory != nil {
+		hooks = append(hooks, "OnRemoveDirectory")
+	}
+	if t.OnModifyPermissions != nil {
+		hooks = append(hooks, "OnModifyPermissions")
+	}
+	return hooks
+}
 func (t *Scheme) onListDirectory(s SchemeListDirectoryStartInfo) func(SchemeListDirectoryDoneInfo) {
 	fn := t.OnListDirectory
 	if fn == nil {
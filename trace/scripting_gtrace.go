@@ -8,7 +8,20 @@ import (
 
 // scriptingComposeOptions is a holder of options
 type scriptingComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *scriptingComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Scripting."+hook, cb, o.panicLogger)
 }
 
 // ScriptingOption specified Scripting compose option
@@ -23,6 +36,50 @@ func WithScriptingPanicCallback(cb func(e interface{})) ScriptingComposeOption {
 	}
 }
 
+// WithScriptingPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithScriptingPanicPolicy(policy PanicPolicy) ScriptingComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *scriptingComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithScriptingPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithScriptingPanicLogger(l PanicLogger) ScriptingComposeOption {
+	return func(o *scriptingComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// scriptingHooks is a set of names of Scripting hooks
+var scriptingHooks = map[string]struct{}{
+	"OnExecute": {},
+	"OnStreamExecute": {},
+	"OnExplain": {},
+	"OnClose": {},
+}
+
+// WithScriptingHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithScriptingPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithScriptingHookPanicCallback(hook string, cb func(e interface{})) ScriptingComposeOption {
+	if _, has := scriptingHooks[hook]; !has {
+		panic("unknown Scripting hook " + hook)
+	}
+
+	return func(o *scriptingComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Scripting which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scripting {
@@ -39,11 +96,12 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 	{
 		h1 := t.OnExecute
 		h2 := x.OnExecute
+		panicCallback := options.hookPanicCallback("OnExecute")
 		ret.OnExecute = func(s ScriptingExecuteStartInfo) func(ScriptingExecuteDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -55,10 +113,10 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 				r1 = h2(s)
 			}
 			return func(s ScriptingExecuteDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -74,11 +132,12 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 	{
 		h1 := t.OnStreamExecute
 		h2 := x.OnStreamExecute
+		panicCallback := options.hookPanicCallback("OnStreamExecute")
 		ret.OnStreamExecute = func(s ScriptingStreamExecuteStartInfo) func(ScriptingStreamExecuteIntermediateInfo) func(ScriptingStreamExecuteDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -90,10 +149,10 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 				r1 = h2(s)
 			}
 			return func(s ScriptingStreamExecuteIntermediateInfo) func(ScriptingStreamExecuteDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -105,10 +164,10 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 					r3 = r1(s)
 				}
 				return func(s ScriptingStreamExecuteDoneInfo) {
-					if options.panicCallback != nil {
+					if panicCallback != nil {
 						defer func() {
 							if e := recover(); e != nil {
-								options.panicCallback(e)
+								panicCallback(e)
 							}
 						}()
 					}
@@ -125,11 +184,12 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 	{
 		h1 := t.OnExplain
 		h2 := x.OnExplain
+		panicCallback := options.hookPanicCallback("OnExplain")
 		ret.OnExplain = func(s ScriptingExplainStartInfo) func(ScriptingExplainDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -141,10 +201,10 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 				r1 = h2(s)
 			}
 			return func(s ScriptingExplainDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -160,11 +220,12 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 	{
 		h1 := t.OnClose
 		h2 := x.OnClose
+		panicCallback := options.hookPanicCallback("OnClose")
 		ret.OnClose = func(s ScriptingCloseStartInfo) func(ScriptingCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -176,10 +237,10 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 				r1 = h2(s)
 			}
 			return func(s ScriptingCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -194,6 +255,62 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 	}
 	return &ret
 }
+// ComposeAll returns a new Scripting which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Scripting) ComposeAll(others ...*Scripting) *Scripting {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Scripting{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Scripting) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnExecute != nil {
+		return false
+	}
+	if t.OnStreamExecute != nil {
+		return false
+	}
+	if t.OnExplain != nil {
+		return false
+	}
+	if t.OnClose != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Scripting) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnExecute != nil {
+		hooks = append(hooks, "OnExecute")
+	}
+	if t.OnStreamExecute != nil {
+		hooks = append(hooks, "OnStreamExecute")
+	}
+	if t.OnExplain != nil {
+		hooks = append(hooks, "OnExplain")
+	}
+	if t.OnClose != nil {
+		hooks = append(hooks, "OnClose")
+	}
+	return hooks
+}
 func (t *Scripting) onExecute(s ScriptingExecuteStartInfo) func(ScriptingExecuteDoneInfo) {
 	fn := t.OnExecute
 	if fn == nil {
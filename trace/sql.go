@@ -64,6 +64,12 @@ type (
 
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnDoTx func(DatabaseSQLDoTxStartInfo) func(DatabaseSQLDoTxIntermediateInfo) func(DatabaseSQLDoTxDoneInfo)
+
+		// OnSlowQuery is called with plan of statement which took longer than slow query threshold
+		// (see ydb.WithSlowQueryThreshold). Plan is explained in background after statement is done
+		//
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnSlowQuery func(DatabaseSQLSlowQueryInfo)
 	}
 
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
@@ -79,6 +85,7 @@ type (
 	DatabaseSQLConnectorConnectDoneInfo struct {
 		Error   error
 		Session sessionInfo
+		ConnID  string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLConnPingStartInfo struct {
@@ -378,4 +385,12 @@ type (
 		Attempts int
 		Error    error
 	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	DatabaseSQLSlowQueryInfo struct {
+		Query   string
+		Elapsed time.Duration
+		Ast     string
+		Plan    string
+		Error   error // error of explain, statement result is not affected
+	}
 )
@@ -10,7 +10,20 @@ import (
 
 // databaseSQLComposeOptions is a holder of options
 type databaseSQLComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *databaseSQLComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("DatabaseSQL."+hook, cb, o.panicLogger)
 }
 
 // DatabaseSQLOption specified DatabaseSQL compose option
@@ -25,6 +38,68 @@ func WithDatabaseSQLPanicCallback(cb func(e interface{})) DatabaseSQLComposeOpti
 	}
 }
 
+// WithDatabaseSQLPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDatabaseSQLPanicPolicy(policy PanicPolicy) DatabaseSQLComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *databaseSQLComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithDatabaseSQLPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDatabaseSQLPanicLogger(l PanicLogger) DatabaseSQLComposeOption {
+	return func(o *databaseSQLComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// databaseSQLHooks is a set of names of DatabaseSQL hooks
+var databaseSQLHooks = map[string]struct{}{
+	"OnConnectorConnect": {},
+	"OnConnPing": {},
+	"OnConnPrepare": {},
+	"OnConnClose": {},
+	"OnConnBegin": {},
+	"OnConnBeginTx": {},
+	"OnConnCheckNamedValue": {},
+	"OnConnQuery": {},
+	"OnConnExec": {},
+	"OnConnIsTableExists": {},
+	"OnConnIsColumnExists": {},
+	"OnConnGetIndexColumns": {},
+	"OnTxQuery": {},
+	"OnTxExec": {},
+	"OnTxPrepare": {},
+	"OnTxCommit": {},
+	"OnTxRollback": {},
+	"OnStmtQuery": {},
+	"OnStmtExec": {},
+	"OnStmtClose": {},
+	"OnDoTx": {},
+	"OnSlowQuery": {},
+}
+
+// WithDatabaseSQLHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithDatabaseSQLPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithDatabaseSQLHookPanicCallback(hook string, cb func(e interface{})) DatabaseSQLComposeOption {
+	if _, has := databaseSQLHooks[hook]; !has {
+		panic("unknown DatabaseSQL hook " + hook)
+	}
+
+	return func(o *databaseSQLComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new DatabaseSQL which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption) *DatabaseSQL {
@@ -41,11 +116,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnectorConnect
 		h2 := x.OnConnectorConnect
+		panicCallback := options.hookPanicCallback("OnConnectorConnect")
 		ret.OnConnectorConnect = func(d DatabaseSQLConnectorConnectStartInfo) func(DatabaseSQLConnectorConnectDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -57,10 +133,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnectorConnectDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -76,11 +152,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnPing
 		h2 := x.OnConnPing
+		panicCallback := options.hookPanicCallback("OnConnPing")
 		ret.OnConnPing = func(d DatabaseSQLConnPingStartInfo) func(DatabaseSQLConnPingDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -92,10 +169,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnPingDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -111,11 +188,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnPrepare
 		h2 := x.OnConnPrepare
+		panicCallback := options.hookPanicCallback("OnConnPrepare")
 		ret.OnConnPrepare = func(d DatabaseSQLConnPrepareStartInfo) func(DatabaseSQLConnPrepareDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -127,10 +205,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnPrepareDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -146,11 +224,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnClose
 		h2 := x.OnConnClose
+		panicCallback := options.hookPanicCallback("OnConnClose")
 		ret.OnConnClose = func(d DatabaseSQLConnCloseStartInfo) func(DatabaseSQLConnCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -162,10 +241,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -181,11 +260,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnBegin
 		h2 := x.OnConnBegin
+		panicCallback := options.hookPanicCallback("OnConnBegin")
 		ret.OnConnBegin = func(d DatabaseSQLConnBeginStartInfo) func(DatabaseSQLConnBeginDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -197,10 +277,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnBeginDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -216,11 +296,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnBeginTx
 		h2 := x.OnConnBeginTx
+		panicCallback := options.hookPanicCallback("OnConnBeginTx")
 		ret.OnConnBeginTx = func(d DatabaseSQLConnBeginTxStartInfo) func(DatabaseSQLConnBeginTxDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -232,10 +313,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnBeginTxDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -251,11 +332,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnCheckNamedValue
 		h2 := x.OnConnCheckNamedValue
+		panicCallback := options.hookPanicCallback("OnConnCheckNamedValue")
 		ret.OnConnCheckNamedValue = func(d DatabaseSQLConnCheckNamedValueStartInfo) func(DatabaseSQLConnCheckNamedValueDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -267,10 +349,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnCheckNamedValueDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -286,11 +368,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnQuery
 		h2 := x.OnConnQuery
+		panicCallback := options.hookPanicCallback("OnConnQuery")
 		ret.OnConnQuery = func(d DatabaseSQLConnQueryStartInfo) func(DatabaseSQLConnQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -302,10 +385,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -321,11 +404,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnExec
 		h2 := x.OnConnExec
+		panicCallback := options.hookPanicCallback("OnConnExec")
 		ret.OnConnExec = func(d DatabaseSQLConnExecStartInfo) func(DatabaseSQLConnExecDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -337,10 +421,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnExecDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -356,11 +440,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnIsTableExists
 		h2 := x.OnConnIsTableExists
+		panicCallback := options.hookPanicCallback("OnConnIsTableExists")
 		ret.OnConnIsTableExists = func(d DatabaseSQLConnIsTableExistsStartInfo) func(DatabaseSQLConnIsTableExistsDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -372,10 +457,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLConnIsTableExistsDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -391,11 +476,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnIsColumnExists
 		h2 := x.OnConnIsColumnExists
+		panicCallback := options.hookPanicCallback("OnConnIsColumnExists")
 		ret.OnConnIsColumnExists = func(info DatabaseSQLConnIsColumnExistsStartInfo) func(DatabaseSQLConnIsColumnExistsDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -407,10 +493,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(info)
 			}
 			return func(d DatabaseSQLConnIsColumnExistsDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -426,11 +512,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnConnGetIndexColumns
 		h2 := x.OnConnGetIndexColumns
+		panicCallback := options.hookPanicCallback("OnConnGetIndexColumns")
 		ret.OnConnGetIndexColumns = func(info DatabaseSQLConnGetIndexColumnsStartInfo) func(DatabaseSQLConnGetIndexColumnsDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -442,10 +529,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(info)
 			}
 			return func(d DatabaseSQLConnGetIndexColumnsDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -461,11 +548,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnTxQuery
 		h2 := x.OnTxQuery
+		panicCallback := options.hookPanicCallback("OnTxQuery")
 		ret.OnTxQuery = func(d DatabaseSQLTxQueryStartInfo) func(DatabaseSQLTxQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -477,10 +565,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLTxQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -496,11 +584,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnTxExec
 		h2 := x.OnTxExec
+		panicCallback := options.hookPanicCallback("OnTxExec")
 		ret.OnTxExec = func(d DatabaseSQLTxExecStartInfo) func(DatabaseSQLTxExecDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -512,10 +601,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLTxExecDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -531,11 +620,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnTxPrepare
 		h2 := x.OnTxPrepare
+		panicCallback := options.hookPanicCallback("OnTxPrepare")
 		ret.OnTxPrepare = func(d DatabaseSQLTxPrepareStartInfo) func(DatabaseSQLTxPrepareDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -547,10 +637,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLTxPrepareDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -566,11 +656,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnTxCommit
 		h2 := x.OnTxCommit
+		panicCallback := options.hookPanicCallback("OnTxCommit")
 		ret.OnTxCommit = func(d DatabaseSQLTxCommitStartInfo) func(DatabaseSQLTxCommitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -582,10 +673,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLTxCommitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -601,11 +692,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnTxRollback
 		h2 := x.OnTxRollback
+		panicCallback := options.hookPanicCallback("OnTxRollback")
 		ret.OnTxRollback = func(d DatabaseSQLTxRollbackStartInfo) func(DatabaseSQLTxRollbackDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -617,10 +709,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLTxRollbackDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -636,11 +728,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnStmtQuery
 		h2 := x.OnStmtQuery
+		panicCallback := options.hookPanicCallback("OnStmtQuery")
 		ret.OnStmtQuery = func(d DatabaseSQLStmtQueryStartInfo) func(DatabaseSQLStmtQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -652,10 +745,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLStmtQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -671,11 +764,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnStmtExec
 		h2 := x.OnStmtExec
+		panicCallback := options.hookPanicCallback("OnStmtExec")
 		ret.OnStmtExec = func(d DatabaseSQLStmtExecStartInfo) func(DatabaseSQLStmtExecDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -687,10 +781,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLStmtExecDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -706,11 +800,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnStmtClose
 		h2 := x.OnStmtClose
+		panicCallback := options.hookPanicCallback("OnStmtClose")
 		ret.OnStmtClose = func(d DatabaseSQLStmtCloseStartInfo) func(DatabaseSQLStmtCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -722,10 +817,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLStmtCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -741,11 +836,12 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 	{
 		h1 := t.OnDoTx
 		h2 := x.OnDoTx
+		panicCallback := options.hookPanicCallback("OnDoTx")
 		ret.OnDoTx = func(d DatabaseSQLDoTxStartInfo) func(DatabaseSQLDoTxIntermediateInfo) func(DatabaseSQLDoTxDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -757,10 +853,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 				r1 = h2(d)
 			}
 			return func(d DatabaseSQLDoTxIntermediateInfo) func(DatabaseSQLDoTxDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -772,10 +868,10 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 					r3 = r1(d)
 				}
 				return func(d DatabaseSQLDoTxDoneInfo) {
-					if options.panicCallback != nil {
+					if panicCallback != nil {
 						defer func() {
 							if e := recover(); e != nil {
-								options.panicCallback(e)
+								panicCallback(e)
 							}
 						}()
 					}
@@ -789,8 +885,192 @@ func (t *DatabaseSQL) Compose(x *DatabaseSQL, opts ...DatabaseSQLComposeOption)
 			}
 		}
 	}
+	{
+		h1 := t.OnSlowQuery
+		h2 := x.OnSlowQuery
+		panicCallback := options.hookPanicCallback("OnSlowQuery")
+		ret.OnSlowQuery = func(d DatabaseSQLSlowQueryInfo) {
+			if panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(d)
+			}
+			if h2 != nil {
+				h2(d)
+			}
+		}
+	}
 	return &ret
 }
+// ComposeAll returns a new DatabaseSQL which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *DatabaseSQL) ComposeAll(others ...*DatabaseSQL) *DatabaseSQL {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &DatabaseSQL{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *DatabaseSQL) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnConnectorConnect != nil {
+		return false
+	}
+	if t.OnConnPing != nil {
+		return false
+	}
+	if t.OnConnPrepare != nil {
+		return false
+	}
+	if t.OnConnClose != nil {
+		return false
+	}
+	if t.OnConnBegin != nil {
+		return false
+	}
+	if t.OnConnBeginTx != nil {
+		return false
+	}
+	if t.OnConnCheckNamedValue != nil {
+		return false
+	}
+	if t.OnConnQuery != nil {
+		return false
+	}
+	if t.OnConnExec != nil {
+		return false
+	}
+	if t.OnConnIsTableExists != nil {
+		return false
+	}
+	if t.OnConnIsColumnExists != nil {
+		return false
+	}
+	if t.OnConnGetIndexColumns != nil {
+		return false
+	}
+	if t.OnTxQuery != nil {
+		return false
+	}
+	if t.OnTxExec != nil {
+		return false
+	}
+	if t.OnTxPrepare != nil {
+		return false
+	}
+	if t.OnTxCommit != nil {
+		return false
+	}
+	if t.OnTxRollback != nil {
+		return false
+	}
+	if t.OnStmtQuery != nil {
+		return false
+	}
+	if t.OnStmtExec != nil {
+		return false
+	}
+	if t.OnStmtClose != nil {
+		return false
+	}
+	if t.OnDoTx != nil {
+		return false
+	}
+	if t.OnSlowQuery != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *DatabaseSQL) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnConnectorConnect != nil {
+		hooks = append(hooks, "OnConnectorConnect")
+	}
+	if t.OnConnPing != nil {
+		hooks = append(hooks, "OnConnPing")
+	}
+	if t.OnConnPrepare != nil {
+		hooks = append(hooks, "OnConnPrepare")
+	}
+	if t.OnConnClose != nil {
+		hooks = append(hooks, "OnConnClose")
+	}
+	if t.OnConnBegin != nil {
+		hooks = append(hooks, "OnConnBegin")
+	}
+	if t.OnConnBeginTx != nil {
+		hooks = append(hooks, "OnConnBeginTx")
+	}
+	if t.OnConnCheckNamedValue != nil {
+		hooks = append(hooks, "OnConnCheckNamedValue")
+	}
+	if t.OnConnQuery != nil {
+		hooks = append(hooks, "OnConnQuery")
+	}
+	if t.OnConnExec != nil {
+		hooks = append(hooks, "OnConnExec")
+	}
+	if t.OnConnIsTableExists != nil {
+		hooks = append(hooks, "OnConnIsTableExists")
+	}
+	if t.OnConnIsColumnExists != nil {
+		hooks = append(hooks, "OnConnIsColumnExists")
+	}
+	if t.OnConnGetIndexColumns != nil {
+		hooks = append(hooks, "OnConnGetIndexColumns")
+	}
+	if t.OnTxQuery != nil {
+		hooks = append(hooks, "OnTxQuery")
+	}
+	if t.OnTxExec != nil {
+		hooks = append(hooks, "OnTxExec")
+	}
+	if t.OnTxPrepare != nil {
+		hooks = append(hooks, "OnTxPrepare")
+	}
+	if t.OnTxCommit != nil {
+		hooks = append(hooks, "OnTxCommit")
+	}
+	if t.OnTxRollback != nil {
+		hooks = append(hooks, "OnTxRollback")
+	}
+	if t.OnStmtQuery != nil {
+		hooks = append(hooks, "OnStmtQuery")
+	}
+	if t.OnStmtExec != nil {
+		hooks = append(hooks, "OnStmtExec")
+	}
+	if t.OnStmtClose != nil {
+		hooks = append(hooks, "OnStmtClose")
+	}
+	if t.OnDoTx != nil {
+		hooks = append(hooks, "OnDoTx")
+	}
+	if t.OnSlowQuery != nil {
+		hooks = append(hooks, "OnSlowQuery")
+	}
+	return hooks
+}
 func (t *DatabaseSQL) onConnectorConnect(d DatabaseSQLConnectorConnectStartInfo) func(DatabaseSQLConnectorConnectDoneInfo) {
 	fn := t.OnConnectorConnect
 	if fn == nil {
@@ -1118,16 +1398,24 @@ func (t *DatabaseSQL) onDoTx(d DatabaseSQLDoTxStartInfo) func(DatabaseSQLDoTxInt
 		return res
 	}
 }
+func (t *DatabaseSQL) onSlowQuery(d DatabaseSQLSlowQueryInfo) {
+	fn := t.OnSlowQuery
+	if fn == nil {
+		return
+	}
+	fn(d)
+}
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnConnectorConnect(t *DatabaseSQL, c *context.Context, call call) func(_ error, session sessionInfo) {
+func DatabaseSQLOnConnectorConnect(t *DatabaseSQL, c *context.Context, call call) func(_ error, session sessionInfo, connID string) {
 	var p DatabaseSQLConnectorConnectStartInfo
 	p.Context = c
 	p.Call = call
 	res := t.onConnectorConnect(p)
-	return func(e error, session sessionInfo) {
+	return func(e error, session sessionInfo, connID string) {
 		var p DatabaseSQLConnectorConnectDoneInfo
 		p.Error = e
 		p.Session = session
+		p.ConnID = connID
 		res(p)
 	}
 }
@@ -1414,3 +1702,13 @@ func DatabaseSQLOnDoTx(t *DatabaseSQL, c *context.Context, call call, iD string,
 		}
 	}
 }
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DatabaseSQLOnSlowQuery(t *DatabaseSQL, query string, elapsed time.Duration, ast string, plan string, e error) {
+	var p DatabaseSQLSlowQueryInfo
+	p.Query = query
+	p.Elapsed = elapsed
+	p.Ast = ast
+	p.Plan = plan
+	p.Error = e
+	t.onSlowQuery(p)
+}
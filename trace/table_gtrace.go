@@ -8,7 +8,20 @@ import (
 
 // tableComposeOptions is a holder of options
 type tableComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *tableComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Table."+hook, cb, o.panicLogger)
 }
 
 // TableOption specified Table compose option
@@ -23,6 +36,73 @@ func WithTablePanicCallback(cb func(e interface{})) TableComposeOption {
 	}
 }
 
+// WithTablePanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithTablePanicPolicy(policy PanicPolicy) TableComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *tableComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithTablePanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithTablePanicLogger(l PanicLogger) TableComposeOption {
+	return func(o *tableComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// tableHooks is a set of names of Table hooks
+var tableHooks = map[string]struct{}{
+	"OnInit": {},
+	"OnClose": {},
+	"OnDo": {},
+	"OnDoTx": {},
+	"OnBulkUpsert": {},
+	"OnCreateSession": {},
+	"OnSessionNew": {},
+	"OnSessionDelete": {},
+	"OnSessionKeepAlive": {},
+	"OnSessionBulkUpsert": {},
+	"OnSessionQueryPrepare": {},
+	"OnSessionQueryExecute": {},
+	"OnSessionQueryExplain": {},
+	"OnSessionQueryStreamExecute": {},
+	"OnSessionQueryStreamRead": {},
+	"OnTxBegin": {},
+	"OnTxExecute": {},
+	"OnTxExecuteStatement": {},
+	"OnTxCommit": {},
+	"OnTxRollback": {},
+	"OnPoolPut": {},
+	"OnPoolGet": {},
+	"OnPoolWith": {},
+	"OnPoolStateChange": {},
+	"OnPoolSessionAdd": {},
+	"OnPoolSessionRemove": {},
+	"OnPoolWait": {},
+}
+
+// WithTableHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithTablePanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithTableHookPanicCallback(hook string, cb func(e interface{})) TableComposeOption {
+	if _, has := tableHooks[hook]; !has {
+		panic("unknown Table hook " + hook)
+	}
+
+	return func(o *tableComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Table which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
@@ -39,11 +119,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnInit
 		h2 := x.OnInit
+		panicCallback := options.hookPanicCallback("OnInit")
 		ret.OnInit = func(t TableInitStartInfo) func(TableInitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -55,10 +136,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableInitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -74,11 +155,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnClose
 		h2 := x.OnClose
+		panicCallback := options.hookPanicCallback("OnClose")
 		ret.OnClose = func(t TableCloseStartInfo) func(TableCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -90,10 +172,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -109,11 +191,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnDo
 		h2 := x.OnDo
+		panicCallback := options.hookPanicCallback("OnDo")
 		ret.OnDo = func(t TableDoStartInfo) func(TableDoDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -125,10 +208,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableDoDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -144,11 +227,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnDoTx
 		h2 := x.OnDoTx
+		panicCallback := options.hookPanicCallback("OnDoTx")
 		ret.OnDoTx = func(t TableDoTxStartInfo) func(TableDoTxDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -160,10 +244,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableDoTxDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -179,11 +263,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnBulkUpsert
 		h2 := x.OnBulkUpsert
+		panicCallback := options.hookPanicCallback("OnBulkUpsert")
 		ret.OnBulkUpsert = func(t TableBulkUpsertStartInfo) func(TableBulkUpsertDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -195,10 +280,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableBulkUpsertDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -214,11 +299,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnCreateSession
 		h2 := x.OnCreateSession
+		panicCallback := options.hookPanicCallback("OnCreateSession")
 		ret.OnCreateSession = func(t TableCreateSessionStartInfo) func(TableCreateSessionDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -230,10 +316,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableCreateSessionDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -249,11 +335,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionNew
 		h2 := x.OnSessionNew
+		panicCallback := options.hookPanicCallback("OnSessionNew")
 		ret.OnSessionNew = func(t TableSessionNewStartInfo) func(TableSessionNewDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -265,10 +352,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableSessionNewDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -284,11 +371,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionDelete
 		h2 := x.OnSessionDelete
+		panicCallback := options.hookPanicCallback("OnSessionDelete")
 		ret.OnSessionDelete = func(t TableSessionDeleteStartInfo) func(TableSessionDeleteDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -300,10 +388,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableSessionDeleteDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -319,11 +407,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionKeepAlive
 		h2 := x.OnSessionKeepAlive
+		panicCallback := options.hookPanicCallback("OnSessionKeepAlive")
 		ret.OnSessionKeepAlive = func(t TableKeepAliveStartInfo) func(TableKeepAliveDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -335,10 +424,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableKeepAliveDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -354,11 +443,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionBulkUpsert
 		h2 := x.OnSessionBulkUpsert
+		panicCallback := options.hookPanicCallback("OnSessionBulkUpsert")
 		ret.OnSessionBulkUpsert = func(t TableSessionBulkUpsertStartInfo) func(TableSessionBulkUpsertDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -370,10 +460,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableSessionBulkUpsertDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -389,11 +479,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionQueryPrepare
 		h2 := x.OnSessionQueryPrepare
+		panicCallback := options.hookPanicCallback("OnSessionQueryPrepare")
 		ret.OnSessionQueryPrepare = func(t TablePrepareDataQueryStartInfo) func(TablePrepareDataQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -405,10 +496,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TablePrepareDataQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -424,11 +515,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionQueryExecute
 		h2 := x.OnSessionQueryExecute
+		panicCallback := options.hookPanicCallback("OnSessionQueryExecute")
 		ret.OnSessionQueryExecute = func(t TableExecuteDataQueryStartInfo) func(TableExecuteDataQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -440,10 +532,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableExecuteDataQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -459,11 +551,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionQueryExplain
 		h2 := x.OnSessionQueryExplain
+		panicCallback := options.hookPanicCallback("OnSessionQueryExplain")
 		ret.OnSessionQueryExplain = func(t TableExplainQueryStartInfo) func(TableExplainQueryDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -475,10 +568,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableExplainQueryDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -494,11 +587,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionQueryStreamExecute
 		h2 := x.OnSessionQueryStreamExecute
+		panicCallback := options.hookPanicCallback("OnSessionQueryStreamExecute")
 		ret.OnSessionQueryStreamExecute = func(t TableSessionQueryStreamExecuteStartInfo) func(TableSessionQueryStreamExecuteDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -510,10 +604,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableSessionQueryStreamExecuteDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -529,11 +623,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnSessionQueryStreamRead
 		h2 := x.OnSessionQueryStreamRead
+		panicCallback := options.hookPanicCallback("OnSessionQueryStreamRead")
 		ret.OnSessionQueryStreamRead = func(t TableSessionQueryStreamReadStartInfo) func(TableSessionQueryStreamReadDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -545,10 +640,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableSessionQueryStreamReadDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -564,11 +659,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnTxBegin
 		h2 := x.OnTxBegin
+		panicCallback := options.hookPanicCallback("OnTxBegin")
 		ret.OnTxBegin = func(t TableTxBeginStartInfo) func(TableTxBeginDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -580,10 +676,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableTxBeginDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -599,11 +695,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnTxExecute
 		h2 := x.OnTxExecute
+		panicCallback := options.hookPanicCallback("OnTxExecute")
 		ret.OnTxExecute = func(t TableTransactionExecuteStartInfo) func(TableTransactionExecuteDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -615,10 +712,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableTransactionExecuteDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -634,11 +731,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnTxExecuteStatement
 		h2 := x.OnTxExecuteStatement
+		panicCallback := options.hookPanicCallback("OnTxExecuteStatement")
 		ret.OnTxExecuteStatement = func(t TableTransactionExecuteStatementStartInfo) func(TableTransactionExecuteStatementDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -650,10 +748,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableTransactionExecuteStatementDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -669,11 +767,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnTxCommit
 		h2 := x.OnTxCommit
+		panicCallback := options.hookPanicCallback("OnTxCommit")
 		ret.OnTxCommit = func(t TableTxCommitStartInfo) func(TableTxCommitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -685,10 +784,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableTxCommitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -704,11 +803,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnTxRollback
 		h2 := x.OnTxRollback
+		panicCallback := options.hookPanicCallback("OnTxRollback")
 		ret.OnTxRollback = func(t TableTxRollbackStartInfo) func(TableTxRollbackDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -720,10 +820,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TableTxRollbackDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -739,11 +839,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolPut
 		h2 := x.OnPoolPut
+		panicCallback := options.hookPanicCallback("OnPoolPut")
 		ret.OnPoolPut = func(t TablePoolPutStartInfo) func(TablePoolPutDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -755,10 +856,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TablePoolPutDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -774,11 +875,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolGet
 		h2 := x.OnPoolGet
+		panicCallback := options.hookPanicCallback("OnPoolGet")
 		ret.OnPoolGet = func(t TablePoolGetStartInfo) func(TablePoolGetDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -790,10 +892,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TablePoolGetDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -809,11 +911,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolWith
 		h2 := x.OnPoolWith
+		panicCallback := options.hookPanicCallback("OnPoolWith")
 		ret.OnPoolWith = func(t TablePoolWithStartInfo) func(TablePoolWithDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -825,10 +928,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TablePoolWithDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -844,11 +947,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolStateChange
 		h2 := x.OnPoolStateChange
+		panicCallback := options.hookPanicCallback("OnPoolStateChange")
 		ret.OnPoolStateChange = func(t TablePoolStateChangeInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -863,11 +967,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolSessionAdd
 		h2 := x.OnPoolSessionAdd
+		panicCallback := options.hookPanicCallback("OnPoolSessionAdd")
 		ret.OnPoolSessionAdd = func(info TablePoolSessionAddInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -882,11 +987,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolSessionRemove
 		h2 := x.OnPoolSessionRemove
+		panicCallback := options.hookPanicCallback("OnPoolSessionRemove")
 		ret.OnPoolSessionRemove = func(info TablePoolSessionRemoveInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -901,11 +1007,12 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	{
 		h1 := t.OnPoolWait
 		h2 := x.OnPoolWait
+		panicCallback := options.hookPanicCallback("OnPoolWait")
 		ret.OnPoolWait = func(t TablePoolWaitStartInfo) func(TablePoolWaitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -917,10 +1024,10 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 				r1 = h2(t)
 			}
 			return func(t TablePoolWaitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -935,6 +1042,200 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 	}
 	return &ret
 }
+// ComposeAll returns a new Table which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Table) ComposeAll(others ...*Table) *Table {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Table{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Table) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnInit != nil {
+		return false
+	}
+	if t.OnClose != nil {
+		return false
+	}
+	if t.OnDo != nil {
+		return false
+	}
+	if t.OnDoTx != nil {
+		return false
+	}
+	if t.OnBulkUpsert != nil {
+		return false
+	}
+	if t.OnCreateSession != nil {
+		return false
+	}
+	if t.OnSessionNew != nil {
+		return false
+	}
+	if t.OnSessionDelete != nil {
+		return false
+	}
+	if t.OnSessionKeepAlive != nil {
+		return false
+	}
+	if t.OnSessionBulkUpsert != nil {
+		return false
+	}
+	if t.OnSessionQueryPrepare != nil {
+		return false
+	}
+	if t.OnSessionQueryExecute != nil {
+		return false
+	}
+	if t.OnSessionQueryExplain != nil {
+		return false
+	}
+	if t.OnSessionQueryStreamExecute != nil {
+		return false
+	}
+	if t.OnSessionQueryStreamRead != nil {
+		return false
+	}
+	if t.OnTxBegin != nil {
+		return false
+	}
+	if t.OnTxExecute != nil {
+		return false
+	}
+	if t.OnTxExecuteStatement != nil {
+		return false
+	}
+	if t.OnTxCommit != nil {
+		return false
+	}
+	if t.OnTxRollback != nil {
+		return false
+	}
+	if t.OnPoolPut != nil {
+		return false
+	}
+	if t.OnPoolGet != nil {
+		return false
+	}
+	if t.OnPoolWith != nil {
+		return false
+	}
+	if t.OnPoolStateChange != nil {
+		return false
+	}
+	if t.OnPoolSessionAdd != nil {
+		return false
+	}
+	if t.OnPoolSessionRemove != nil {
+		return false
+	}
+	if t.OnPoolWait != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Table) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnInit != nil {
+		hooks = append(hooks, "OnInit")
+	}
+	if t.OnClose != nil {
+		hooks = append(hooks, "OnClose")
+	}
+	if t.OnDo != nil {
+		hooks = append(hooks, "OnDo")
+	}
+	if t.OnDoTx != nil {
+		hooks = append(hooks, "OnDoTx")
+	}
+	if t.OnBulkUpsert != nil {
+		hooks = append(hooks, "OnBulkUpsert")
+	}
+	if t.OnCreateSession != nil {
+		hooks = append(hooks, "OnCreateSession")
+	}
+	if t.OnSessionNew != nil {
+		hooks = append(hooks, "OnSessionNew")
+	}
+	if t.OnSessionDelete != nil {
+		hooks = append(hooks, "OnSessionDelete")
+	}
+	if t.OnSessionKeepAlive != nil {
+		hooks = append(hooks, "OnSessionKeepAlive")
+	}
+	if t.OnSessionBulkUpsert != nil {
+		hooks = append(hooks, "OnSessionBulkUpsert")
+	}
+	if t.OnSessionQueryPrepare != nil {
+		hooks = append(hooks, "OnSessionQueryPrepare")
+	}
+	if t.OnSessionQueryExecute != nil {
+		hooks = append(hooks, "OnSessionQueryExecute")
+	}
+	if t.OnSessionQueryExplain != nil {
+		hooks = append(hooks, "OnSessionQueryExplain")
+	}
+	if t.OnSessionQueryStreamExecute != nil {
+		hooks = append(hooks, "OnSessionQueryStreamExecute")
+	}
+	if t.OnSessionQueryStreamRead != nil {
+		hooks = append(hooks, "OnSessionQueryStreamRead")
+	}
+	if t.OnTxBegin != nil {
+		hooks = append(hooks, "OnTxBegin")
+	}
+	if t.OnTxExecute != nil {
+		hooks = append(hooks, "OnTxExecute")
+	}
+	if t.OnTxExecuteStatement != nil {
+		hooks = append(hooks, "OnTxExecuteStatement")
+	}
+	if t.OnTxCommit != nil {
+		hooks = append(hooks, "OnTxCommit")
+	}
+	if t.OnTxRollback != nil {
+		hooks = append(hooks, "OnTxRollback")
+	}
+	if t.OnPoolPut != nil {
+		hooks = append(hooks, "OnPoolPut")
+	}
+	if t.OnPoolGet != nil {
+		hooks = append(hooks, "OnPoolGet")
+	}
+	if t.OnPoolWith != nil {
+		hooks = append(hooks, "OnPoolWith")
+	}
+	if t.OnPoolStateChange != nil {
+		hooks = append(hooks, "OnPoolStateChange")
+	}
+	if t.OnPoolSessionAdd != nil {
+		hooks = append(hooks, "OnPoolSessionAdd")
+	}
+	if t.OnPoolSessionRemove != nil {
+		hooks = append(hooks, "OnPoolSessionRemove")
+	}
+	if t.OnPoolWait != nil {
+		hooks = append(hooks, "OnPoolWait")
+	}
+	return hooks
+}
 func (t *Table) onInit(t1 TableInitStartInfo) func(TableInitDoneInfo) {
 	fn := t.OnInit
 	if fn == nil {
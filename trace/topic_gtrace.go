@@ -8,7 +8,20 @@ import (
 
 // topicComposeOptions is a holder of options
 type topicComposeOptions struct {
-	panicCallback func(e interface{})
+	panicCallback      func(e interface{})
+	hookPanicCallbacks map[string]func(e interface{})
+	panicPolicy        PanicPolicy
+	panicLogger        PanicLogger
+}
+
+// hookPanicCallback returns panic handler of hook with given name. Handler calls panic callback
+// of hook (or the common one) and handles panic according to panic policy
+func (o *topicComposeOptions) hookPanicCallback(hook string) func(e interface{}) {
+	cb, has := o.hookPanicCallbacks[hook]
+	if !has {
+		cb = o.panicCallback
+	}
+	return o.panicPolicy.hookPanicCallback("Topic."+hook, cb, o.panicLogger)
 }
 
 // TopicOption specified Topic compose option
@@ -23,6 +36,76 @@ func WithTopicPanicCallback(cb func(e interface{})) TopicComposeOption {
 	}
 }
 
+// WithTopicPanicPolicy specified handling of recovered panic after call of panic callback.
+// Unknown panic policy is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithTopicPanicPolicy(policy PanicPolicy) TopicComposeOption {
+	policy.mustBeKnown()
+
+	return func(o *topicComposeOptions) {
+		o.panicPolicy = policy
+	}
+}
+
+// WithTopicPanicLogger specified logger of panics recovered with PanicPolicyLogAndContinue policy
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithTopicPanicLogger(l PanicLogger) TopicComposeOption {
+	return func(o *topicComposeOptions) {
+		o.panicLogger = l
+	}
+}
+
+// topicHooks is a set of names of Topic hooks
+var topicHooks = map[string]struct{}{
+	"OnReaderStart": {},
+	"OnReaderReconnect": {},
+	"OnReaderReconnectRequest": {},
+	"OnReaderPartitionReadStartResponse": {},
+	"OnReaderPartitionReadStopResponse": {},
+	"OnReaderCommit": {},
+	"OnReaderSendCommitMessage": {},
+	"OnReaderCommittedNotify": {},
+	"OnReaderClose": {},
+	"OnReaderInit": {},
+	"OnReaderError": {},
+	"OnReaderUpdateToken": {},
+	"OnReaderPopBatchTx": {},
+	"OnReaderStreamPopBatchTx": {},
+	"OnReaderUpdateOffsetsInTransaction": {},
+	"OnReaderTransactionCompleted": {},
+	"OnReaderTransactionRollback": {},
+	"OnReaderSentDataRequest": {},
+	"OnReaderReceiveDataResponse": {},
+	"OnReaderReadMessages": {},
+	"OnReaderUnknownGrpcMessage": {},
+	"OnWriterReconnect": {},
+	"OnWriterInitStream": {},
+	"OnWriterClose": {},
+	"OnWriterBeforeCommitTransaction": {},
+	"OnWriterAfterFinishTransaction": {},
+	"OnWriterCompressMessages": {},
+	"OnWriterSendMessages": {},
+	"OnWriterReceiveResult": {},
+	"OnWriterReadUnknownGrpcMessage": {},
+}
+
+// WithTopicHookPanicCallback specified behavior on panic in hook with given name
+// It overrides callback specified by WithTopicPanicCallback for this hook
+// Unknown hook name is rejected with panic on make of option
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func WithTopicHookPanicCallback(hook string, cb func(e interface{})) TopicComposeOption {
+	if _, has := topicHooks[hook]; !has {
+		panic("unknown Topic hook " + hook)
+	}
+
+	return func(o *topicComposeOptions) {
+		if o.hookPanicCallbacks == nil {
+			o.hookPanicCallbacks = make(map[string]func(e interface{}))
+		}
+		o.hookPanicCallbacks[hook] = cb
+	}
+}
+
 // Compose returns a new Topic which has functional fields composed both from t and x.
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
@@ -39,11 +122,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderStart
 		h2 := x.OnReaderStart
+		panicCallback := options.hookPanicCallback("OnReaderStart")
 		ret.OnReaderStart = func(info TopicReaderStartInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -58,11 +142,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderReconnect
 		h2 := x.OnReaderReconnect
+		panicCallback := options.hookPanicCallback("OnReaderReconnect")
 		ret.OnReaderReconnect = func(t TopicReaderReconnectStartInfo) func(TopicReaderReconnectDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -74,10 +159,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderReconnectDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -93,11 +178,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderReconnectRequest
 		h2 := x.OnReaderReconnectRequest
+		panicCallback := options.hookPanicCallback("OnReaderReconnectRequest")
 		ret.OnReaderReconnectRequest = func(t TopicReaderReconnectRequestInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -112,11 +198,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderPartitionReadStartResponse
 		h2 := x.OnReaderPartitionReadStartResponse
+		panicCallback := options.hookPanicCallback("OnReaderPartitionReadStartResponse")
 		ret.OnReaderPartitionReadStartResponse = func(t TopicReaderPartitionReadStartResponseStartInfo) func(TopicReaderPartitionReadStartResponseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -128,10 +215,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderPartitionReadStartResponseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -147,11 +234,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderPartitionReadStopResponse
 		h2 := x.OnReaderPartitionReadStopResponse
+		panicCallback := options.hookPanicCallback("OnReaderPartitionReadStopResponse")
 		ret.OnReaderPartitionReadStopResponse = func(t TopicReaderPartitionReadStopResponseStartInfo) func(TopicReaderPartitionReadStopResponseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -163,10 +251,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderPartitionReadStopResponseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -182,11 +270,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderCommit
 		h2 := x.OnReaderCommit
+		panicCallback := options.hookPanicCallback("OnReaderCommit")
 		ret.OnReaderCommit = func(t TopicReaderCommitStartInfo) func(TopicReaderCommitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -198,10 +287,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderCommitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -217,11 +306,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderSendCommitMessage
 		h2 := x.OnReaderSendCommitMessage
+		panicCallback := options.hookPanicCallback("OnReaderSendCommitMessage")
 		ret.OnReaderSendCommitMessage = func(t TopicReaderSendCommitMessageStartInfo) func(TopicReaderSendCommitMessageDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -233,10 +323,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderSendCommitMessageDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -252,11 +342,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderCommittedNotify
 		h2 := x.OnReaderCommittedNotify
+		panicCallback := options.hookPanicCallback("OnReaderCommittedNotify")
 		ret.OnReaderCommittedNotify = func(t TopicReaderCommittedNotifyInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -271,11 +362,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderClose
 		h2 := x.OnReaderClose
+		panicCallback := options.hookPanicCallback("OnReaderClose")
 		ret.OnReaderClose = func(t TopicReaderCloseStartInfo) func(TopicReaderCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -287,10 +379,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -306,11 +398,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderInit
 		h2 := x.OnReaderInit
+		panicCallback := options.hookPanicCallback("OnReaderInit")
 		ret.OnReaderInit = func(t TopicReaderInitStartInfo) func(TopicReaderInitDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -322,10 +415,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderInitDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -341,11 +434,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderError
 		h2 := x.OnReaderError
+		panicCallback := options.hookPanicCallback("OnReaderError")
 		ret.OnReaderError = func(t TopicReaderErrorInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -360,11 +454,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderUpdateToken
 		h2 := x.OnReaderUpdateToken
+		panicCallback := options.hookPanicCallback("OnReaderUpdateToken")
 		ret.OnReaderUpdateToken = func(o OnReadUpdateTokenStartInfo) func(OnReadUpdateTokenMiddleTokenReceivedInfo) func(OnReadStreamUpdateTokenDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -376,10 +471,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(o)
 			}
 			return func(o OnReadUpdateTokenMiddleTokenReceivedInfo) func(OnReadStreamUpdateTokenDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -391,10 +486,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 					r3 = r1(o)
 				}
 				return func(o OnReadStreamUpdateTokenDoneInfo) {
-					if options.panicCallback != nil {
+					if panicCallback != nil {
 						defer func() {
 							if e := recover(); e != nil {
-								options.panicCallback(e)
+								panicCallback(e)
 							}
 						}()
 					}
@@ -411,11 +506,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderPopBatchTx
 		h2 := x.OnReaderPopBatchTx
+		panicCallback := options.hookPanicCallback("OnReaderPopBatchTx")
 		ret.OnReaderPopBatchTx = func(t TopicReaderPopBatchTxStartInfo) func(TopicReaderPopBatchTxDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -427,10 +523,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderPopBatchTxDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -446,11 +542,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderStreamPopBatchTx
 		h2 := x.OnReaderStreamPopBatchTx
+		panicCallback := options.hookPanicCallback("OnReaderStreamPopBatchTx")
 		ret.OnReaderStreamPopBatchTx = func(t TopicReaderStreamPopBatchTxStartInfo) func(TopicReaderStreamPopBatchTxDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -462,10 +559,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderStreamPopBatchTxDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -481,11 +578,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderUpdateOffsetsInTransaction
 		h2 := x.OnReaderUpdateOffsetsInTransaction
+		panicCallback := options.hookPanicCallback("OnReaderUpdateOffsetsInTransaction")
 		ret.OnReaderUpdateOffsetsInTransaction = func(t TopicReaderOnUpdateOffsetsInTransactionStartInfo) func(TopicReaderOnUpdateOffsetsInTransactionDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -497,10 +595,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderOnUpdateOffsetsInTransactionDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -516,11 +614,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderTransactionCompleted
 		h2 := x.OnReaderTransactionCompleted
+		panicCallback := options.hookPanicCallback("OnReaderTransactionCompleted")
 		ret.OnReaderTransactionCompleted = func(t TopicReaderTransactionCompletedStartInfo) func(TopicReaderTransactionCompletedDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -532,10 +631,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderTransactionCompletedDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -551,11 +650,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderTransactionRollback
 		h2 := x.OnReaderTransactionRollback
+		panicCallback := options.hookPanicCallback("OnReaderTransactionRollback")
 		ret.OnReaderTransactionRollback = func(t TopicReaderTransactionRollbackStartInfo) func(TopicReaderTransactionRollbackDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -567,10 +667,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderTransactionRollbackDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -586,11 +686,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderSentDataRequest
 		h2 := x.OnReaderSentDataRequest
+		panicCallback := options.hookPanicCallback("OnReaderSentDataRequest")
 		ret.OnReaderSentDataRequest = func(t TopicReaderSentDataRequestInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -605,11 +706,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderReceiveDataResponse
 		h2 := x.OnReaderReceiveDataResponse
+		panicCallback := options.hookPanicCallback("OnReaderReceiveDataResponse")
 		ret.OnReaderReceiveDataResponse = func(t TopicReaderReceiveDataResponseStartInfo) func(TopicReaderReceiveDataResponseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -621,10 +723,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderReceiveDataResponseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -640,11 +742,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderReadMessages
 		h2 := x.OnReaderReadMessages
+		panicCallback := options.hookPanicCallback("OnReaderReadMessages")
 		ret.OnReaderReadMessages = func(t TopicReaderReadMessagesStartInfo) func(TopicReaderReadMessagesDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -656,10 +759,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicReaderReadMessagesDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -675,11 +778,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnReaderUnknownGrpcMessage
 		h2 := x.OnReaderUnknownGrpcMessage
+		panicCallback := options.hookPanicCallback("OnReaderUnknownGrpcMessage")
 		ret.OnReaderUnknownGrpcMessage = func(o OnReadUnknownGrpcMessageInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -694,11 +798,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterReconnect
 		h2 := x.OnWriterReconnect
+		panicCallback := options.hookPanicCallback("OnWriterReconnect")
 		ret.OnWriterReconnect = func(t TopicWriterReconnectStartInfo) func(TopicWriterReconnectDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -710,10 +815,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicWriterReconnectDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -729,11 +834,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterInitStream
 		h2 := x.OnWriterInitStream
+		panicCallback := options.hookPanicCallback("OnWriterInitStream")
 		ret.OnWriterInitStream = func(t TopicWriterInitStreamStartInfo) func(TopicWriterInitStreamDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -745,10 +851,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicWriterInitStreamDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -764,11 +870,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterClose
 		h2 := x.OnWriterClose
+		panicCallback := options.hookPanicCallback("OnWriterClose")
 		ret.OnWriterClose = func(t TopicWriterCloseStartInfo) func(TopicWriterCloseDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -780,10 +887,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicWriterCloseDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -799,11 +906,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterBeforeCommitTransaction
 		h2 := x.OnWriterBeforeCommitTransaction
+		panicCallback := options.hookPanicCallback("OnWriterBeforeCommitTransaction")
 		ret.OnWriterBeforeCommitTransaction = func(t TopicOnWriterBeforeCommitTransactionStartInfo) func(TopicOnWriterBeforeCommitTransactionDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -815,10 +923,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicOnWriterBeforeCommitTransactionDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -834,11 +942,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterAfterFinishTransaction
 		h2 := x.OnWriterAfterFinishTransaction
+		panicCallback := options.hookPanicCallback("OnWriterAfterFinishTransaction")
 		ret.OnWriterAfterFinishTransaction = func(t TopicOnWriterAfterFinishTransactionStartInfo) func(TopicOnWriterAfterFinishTransactionDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -850,10 +959,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicOnWriterAfterFinishTransactionDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -869,11 +978,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterCompressMessages
 		h2 := x.OnWriterCompressMessages
+		panicCallback := options.hookPanicCallback("OnWriterCompressMessages")
 		ret.OnWriterCompressMessages = func(t TopicWriterCompressMessagesStartInfo) func(TopicWriterCompressMessagesDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -885,10 +995,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicWriterCompressMessagesDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -904,11 +1014,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterSendMessages
 		h2 := x.OnWriterSendMessages
+		panicCallback := options.hookPanicCallback("OnWriterSendMessages")
 		ret.OnWriterSendMessages = func(t TopicWriterSendMessagesStartInfo) func(TopicWriterSendMessagesDoneInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -920,10 +1031,10 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 				r1 = h2(t)
 			}
 			return func(t TopicWriterSendMessagesDoneInfo) {
-				if options.panicCallback != nil {
+				if panicCallback != nil {
 					defer func() {
 						if e := recover(); e != nil {
-							options.panicCallback(e)
+							panicCallback(e)
 						}
 					}()
 				}
@@ -939,11 +1050,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterReceiveResult
 		h2 := x.OnWriterReceiveResult
+		panicCallback := options.hookPanicCallback("OnWriterReceiveResult")
 		ret.OnWriterReceiveResult = func(t TopicWriterResultMessagesInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -958,11 +1070,12 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	{
 		h1 := t.OnWriterReadUnknownGrpcMessage
 		h2 := x.OnWriterReadUnknownGrpcMessage
+		panicCallback := options.hookPanicCallback("OnWriterReadUnknownGrpcMessage")
 		ret.OnWriterReadUnknownGrpcMessage = func(t TopicOnWriterReadUnknownGrpcMessageInfo) {
-			if options.panicCallback != nil {
+			if panicCallback != nil {
 				defer func() {
 					if e := recover(); e != nil {
-						options.panicCallback(e)
+						panicCallback(e)
 					}
 				}()
 			}
@@ -976,6 +1089,218 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 	}
 	return &ret
 }
+// ComposeAll returns a new Topic which has functional fields composed from t and others in order,
+// as a chain of Compose calls. Nil traces are skipped.
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Topic) ComposeAll(others ...*Topic) *Topic {
+	ret := t
+	for _, x := range others {
+		if x != nil {
+			ret = ret.Compose(x)
+		}
+	}
+	if ret == nil {
+		return &Topic{}
+	}
+	return ret
+}
+// IsEmpty checks whether t has no hooks. Nil t is empty
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Topic) IsEmpty() bool {
+	if t == nil {
+		return true
+	}
+	if t.OnReaderStart != nil {
+		return false
+	}
+	if t.OnReaderReconnect != nil {
+		return false
+	}
+	if t.OnReaderReconnectRequest != nil {
+		return false
+	}
+	if t.OnReaderPartitionReadStartResponse != nil {
+		return false
+	}
+	if t.OnReaderPartitionReadStopResponse != nil {
+		return false
+	}
+	if t.OnReaderCommit != nil {
+		return false
+	}
+	if t.OnReaderSendCommitMessage != nil {
+		return false
+	}
+	if t.OnReaderCommittedNotify != nil {
+		return false
+	}
+	if t.OnReaderClose != nil {
+		return false
+	}
+	if t.OnReaderInit != nil {
+		return false
+	}
+	if t.OnReaderError != nil {
+		return false
+	}
+	if t.OnReaderUpdateToken != nil {
+		return false
+	}
+	if t.OnReaderPopBatchTx != nil {
+		return false
+	}
+	if t.OnReaderStreamPopBatchTx != nil {
+		return false
+	}
+	if t.OnReaderUpdateOffsetsInTransaction != nil {
+		return false
+	}
+	if t.OnReaderTransactionCompleted != nil {
+		return false
+	}
+	if t.OnReaderTransactionRollback != nil {
+		return false
+	}
+	if t.OnReaderSentDataRequest != nil {
+		return false
+	}
+	if t.OnReaderReceiveDataResponse != nil {
+		return false
+	}
+	if t.OnReaderReadMessages != nil {
+		return false
+	}
+	if t.OnReaderUnknownGrpcMessage != nil {
+		return false
+	}
+	if t.OnWriterReconnect != nil {
+		return false
+	}
+	if t.OnWriterInitStream != nil {
+		return false
+	}
+	if t.OnWriterClose != nil {
+		return false
+	}
+	if t.OnWriterBeforeCommitTransaction != nil {
+		return false
+	}
+	if t.OnWriterAfterFinishTransaction != nil {
+		return false
+	}
+	if t.OnWriterCompressMessages != nil {
+		return false
+	}
+	if t.OnWriterSendMessages != nil {
+		return false
+	}
+	if t.OnWriterReceiveResult != nil {
+		return false
+	}
+	if t.OnWriterReadUnknownGrpcMessage != nil {
+		return false
+	}
+	return true
+}
+// DefinedHooks returns names of hooks which are set in t
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (t *Topic) DefinedHooks() []string {
+	if t == nil {
+		return nil
+	}
+	var hooks []string
+	if t.OnReaderStart != nil {
+		hooks = append(hooks, "OnReaderStart")
+	}
+	if t.OnReaderReconnect != nil {
+		hooks = append(hooks, "OnReaderReconnect")
+	}
+	if t.OnReaderReconnectRequest != nil {
+		hooks = append(hooks, "OnReaderReconnectRequest")
+	}
+	if t.OnReaderPartitionReadStartResponse != nil {
+		hooks = append(hooks, "OnReaderPartitionReadStartResponse")
+	}
+	if t.OnReaderPartitionReadStopResponse != nil {
+		hooks = append(hooks, "OnReaderPartitionReadStopResponse")
+	}
+	if t.OnReaderCommit != nil {
+		hooks = append(hooks, "OnReaderCommit")
+	}
+	if t.OnReaderSendCommitMessage != nil {
+		hooks = append(hooks, "OnReaderSendCommitMessage")
+	}
+	if t.OnReaderCommittedNotify != nil {
+		hooks = append(hooks, "OnReaderCommittedNotify")
+	}
+	if t.OnReaderClose != nil {
+		hooks = append(hooks, "OnReaderClose")
+	}
+	if t.OnReaderInit != nil {
+		hooks = append(hooks, "OnReaderInit")
+	}
+	if t.OnReaderError != nil {
+		hooks = append(hooks, "OnReaderError")
+	}
+	if t.OnReaderUpdateToken != nil {
+		hooks = append(hooks, "OnReaderUpdateToken")
+	}
+	if t.OnReaderPopBatchTx != nil {
+		hooks = append(hooks, "OnReaderPopBatchTx")
+	}
+	if t.OnReaderStreamPopBatchTx != nil {
+		hooks = append(hooks, "OnReaderStreamPopBatchTx")
+	}
+	if t.OnReaderUpdateOffsetsInTransaction != nil {
+		hooks = append(hooks, "OnReaderUpdateOffsetsInTransaction")
+	}
+	if t.OnReaderTransactionCompleted != nil {
+		hooks = append(hooks, "OnReaderTransactionCompleted")
+	}
+	if t.OnReaderTransactionRollback != nil {
+		hooks = append(hooks, "OnReaderTransactionRollback")
+	}
+	if t.OnReaderSentDataRequest != nil {
+		hooks = append(hooks, "OnReaderSentDataRequest")
+	}
+	if t.OnReaderReceiveDataResponse != nil {
+		hooks = append(hooks, "OnReaderReceiveDataResponse")
+	}
+	if t.OnReaderReadMessages != nil {
+		hooks = append(hooks, "OnReaderReadMessages")
+	}
+	if t.OnReaderUnknownGrpcMessage != nil {
+		hooks = append(hooks, "OnReaderUnknownGrpcMessage")
+	}
+	if t.OnWriterReconnect != nil {
+		hooks = append(hooks, "OnWriterReconnect")
+	}
+	if t.OnWriterInitStream != nil {
+		hooks = append(hooks, "OnWriterInitStream")
+	}
+	if t.OnWriterClose != nil {
+		hooks = append(hooks, "OnWriterClose")
+	}
+	if t.OnWriterBeforeCommitTransaction != nil {
+		hooks = append(hooks, "OnWriterBeforeCommitTransaction")
+	}
+	if t.OnWriterAfterFinishTransaction != nil {
+		hooks = append(hooks, "OnWriterAfterFinishTransaction")
+	}
+	if t.OnWriterCompressMessages != nil {
+		hooks = append(hooks, "OnWriterCompressMessages")
+	}
+	if t.OnWriterSendMessages != nil {
+		hooks = append(hooks, "OnWriterSendMessages")
+	}
+	if t.OnWriterReceiveResult != nil {
+		hooks = append(hooks, "OnWriterReceiveResult")
+	}
+	if t.OnWriterReadUnknownGrpcMessage != nil {
+		hooks = append(hooks, "OnWriterReadUnknownGrpcMessage")
+	}
+	return hooks
+}
 func (t *Topic) onReaderStart(info TopicReaderStartInfo) {
 	fn := t.OnReaderStart
 	if fn == nil {
@@ -4,6 +4,9 @@ import (
 	"context"
 	"sync/atomic"
 
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -67,3 +70,58 @@ func (d *Driver) With(ctx context.Context, opts ...Option) (*Driver, error) {
 
 	return child, nil
 }
+
+// withoutConnPool detaches driver from conn pool of parent driver
+func withoutConnPool() Option {
+	return func(ctx context.Context, d *Driver) error {
+		if d.pool == nil {
+			return nil
+		}
+		pool := d.pool
+		d.pool = nil
+
+		return pool.Release(ctx)
+	}
+}
+
+// withGrpcDialOptions makes child Driver with the same options as parent but with own conn pool
+// and balancer which dial connections with additional grpc dial options
+func (d *Driver) withGrpcDialOptions(ctx context.Context, dialOpts ...grpc.DialOption) (_ *Driver, err error) {
+	id := nextID.Add(1)
+
+	child, err := driverFromOptions(
+		ctx,
+		append(
+			append(
+				make([]Option, 0, len(d.opts)+3), //nolint:gomnd
+				d.opts...,
+			),
+			withoutConnPool(),
+			With(config.WithGrpcOptions(dialOpts...)),
+			withOnClose(func(child *Driver) {
+				d.childrenMtx.Lock()
+				defer d.childrenMtx.Unlock()
+
+				delete(d.children, id)
+			}),
+		)...,
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if err = child.connect(ctx); err != nil {
+		if child.pool != nil {
+			_ = child.pool.Release(ctx)
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	d.childrenMtx.Lock()
+	defer d.childrenMtx.Unlock()
+
+	d.children[id] = child
+
+	return child, nil
+}